@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"backend/internal/db"
+	"backend/internal/importer"
+	"backend/internal/validation"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	filePath := flag.String("file", "", "path to the SWIFT code spreadsheet export (CSV or TSV)")
+	fileType := flag.String("type", "swift-codes", "content of the file: swift-codes or bank-codes (national bank code to SWIFT code mapping)")
+	delimiter := flag.String("delimiter", "auto", "column delimiter: auto, comma or tab")
+	batchSize := flag.Int("batch-size", 500, "number of rows upserted per transaction")
+	dryRun := flag.Bool("dry-run", false, "validate the file without writing to the database")
+	flag.Parse()
+
+	if *filePath == "" && flag.NArg() > 0 {
+		*filePath = flag.Arg(0)
+	}
+	if *filePath == "" {
+		fmt.Fprintln(os.Stderr, "usage: importer [-type swift-codes|bank-codes] [-delimiter auto|comma|tab] [-batch-size N] [-dry-run] -file <export.csv>")
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Println("warning: could not load .env file, using system env variables")
+	}
+	validation.ConfigurePunctuation(os.Getenv("NAME_PUNCTUATION"), os.Getenv("ADDRESS_PUNCTUATION"))
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalf("failed to open file: %v", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	var comma rune
+	switch strings.ToLower(*delimiter) {
+	case "comma", ",":
+		comma = ','
+	case "tab", "\\t":
+		comma = '\t'
+	case "auto":
+		if strings.EqualFold(filepath.Ext(*filePath), ".tsv") {
+			comma = '\t'
+		} else {
+			comma = importer.DetectDelimiter(reader)
+		}
+	default:
+		log.Fatalf("unsupported delimiter %q", *delimiter)
+	}
+
+	var parse func() (int, []importer.Result, error)
+	var write func(database *sql.DB) ([]importer.Result, error)
+	switch *fileType {
+	case "swift-codes":
+		var rows []importer.Row
+		parse = func() (int, []importer.Result, error) {
+			var results []importer.Result
+			var err error
+			rows, results, err = importer.Parse(reader, comma)
+			return len(rows), results, err
+		}
+		write = func(database *sql.DB) ([]importer.Result, error) {
+			return importer.Import(database, rows, *batchSize)
+		}
+	case "bank-codes":
+		var rows []importer.BankCodeRow
+		parse = func() (int, []importer.Result, error) {
+			var results []importer.Result
+			var err error
+			rows, results, err = importer.ParseBankCodes(reader, comma)
+			return len(rows), results, err
+		}
+		write = func(database *sql.DB) ([]importer.Result, error) {
+			return importer.ImportBankCodes(database, rows, *batchSize)
+		}
+	default:
+		log.Fatalf("unsupported file type %q", *fileType)
+	}
+
+	validRows, results, err := parse()
+	if err != nil {
+		log.Fatalf("failed to parse file: %v", err)
+	}
+
+	var importErr error
+	if !*dryRun {
+		database, err := db.InitDB(os.Getenv("POSTGRES_URL"), false)
+		if err != nil {
+			log.Fatalf("failed to initialize database: %v", err)
+		}
+		defer database.Close()
+
+		imported, err := write(database)
+		results = append(results, imported...)
+		importErr = err
+	}
+
+	printReport(results)
+
+	if *dryRun {
+		fmt.Printf("dry run: %d valid rows not written\n", validRows)
+	}
+	if importErr != nil {
+		log.Fatalf("import stopped: %v", importErr)
+	}
+}
+
+// printReport prints one line per row followed by totals.
+func printReport(results []importer.Result) {
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Line < results[j].Line
+	})
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "LINE\tSWIFT CODE\tSTATUS\tREASON")
+	for _, result := range results {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", result.Line, result.SwiftCode, result.Status, result.Reason)
+	}
+	tw.Flush()
+
+	summary := importer.Summarize(results)
+	fmt.Printf("\ninserted: %d, updated: %d, skipped: %d, rejected: %d\n",
+		summary.Inserted, summary.Updated, summary.Skipped, summary.Rejected)
+}
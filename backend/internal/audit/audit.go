@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"time"
+
+	"backend/internal/models"
+)
+
+// Sink is an append-only log of the write requests made to the API.
+type Sink interface {
+	// Record appends an entry; its ID is assigned by the sink.
+	Record(entry models.AuditEntry) error
+	// List returns the entries matching the filter, newest first.
+	List(filter Filter) ([]models.AuditEntry, error)
+}
+
+// Filter narrows a listing of audit entries. Zero values are not applied.
+type Filter struct {
+	From   time.Time // entries recorded at or after this time
+	To     time.Time // entries recorded before this time
+	Actor  string
+	Before int64 // entries with a smaller ID, the last ID of the previous page
+	Limit  int
+}
+
+// matches reports whether an entry passes the filter, apart from the limit.
+func (f Filter) matches(entry models.AuditEntry) bool {
+	switch {
+	case !f.From.IsZero() && entry.Time.Before(f.From):
+		return false
+	case !f.To.IsZero() && !entry.Time.Before(f.To):
+		return false
+	case f.Actor != "" && entry.Actor != f.Actor:
+		return false
+	case f.Before > 0 && entry.ID >= f.Before:
+		return false
+	}
+	return true
+}
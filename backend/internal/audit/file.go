@@ -0,0 +1,114 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"backend/internal/models"
+)
+
+// maxLineSize is the longest entry read back from a file.
+const maxLineSize = 1 << 20
+
+// File is the Sink kept in a JSON-lines file, one entry per line. Entries are only ever appended.
+type File struct {
+	mu     sync.Mutex
+	file   *os.File
+	lastID int64
+}
+
+var _ Sink = (*File)(nil)
+
+// OpenFile opens the audit file at path, creating it when missing, and continues the IDs of its entries.
+func OpenFile(path string) (*File, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o640)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &File{file: file}
+	err = f.scan(func(entry models.AuditEntry) {
+		if entry.ID > f.lastID {
+			f.lastID = entry.ID
+		}
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+// Close closes the audit file.
+func (f *File) Close() error {
+	return f.file.Close()
+}
+
+// scan calls fn with every entry of the file, oldest first.
+func (f *File) scan(fn func(entry models.AuditEntry)) error {
+	if _, err := f.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	scanner := bufio.NewScanner(f.file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry models.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return fmt.Errorf("%s line %d: %v", f.file.Name(), line, err)
+		}
+		fn(entry)
+	}
+	return scanner.Err()
+}
+
+// Record appends an entry as a single line.
+func (f *File) Record(entry models.AuditEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	entry.ID = f.lastID + 1
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	if _, err := f.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	f.lastID = entry.ID
+	return nil
+}
+
+// List reads the entries of the file matching the filter, newest first.
+func (f *File) List(filter Filter) ([]models.AuditEntry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var matching []models.AuditEntry
+	err := f.scan(func(entry models.AuditEntry) {
+		if filter.matches(entry) {
+			matching = append(matching, entry)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []models.AuditEntry{}
+	for i := len(matching) - 1; i >= 0; i-- {
+		if filter.Limit > 0 && len(entries) == filter.Limit {
+			break
+		}
+		entries = append(entries, matching[i])
+	}
+	return entries, nil
+}
@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"backend/internal/models"
+)
+
+// Postgres is the Sink kept in the append-only audit_log table.
+type Postgres struct {
+	DB *sql.DB
+}
+
+var _ Sink = (*Postgres)(nil)
+
+// NewPostgres creates a sink using the given database connection.
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{DB: db}
+}
+
+// Record inserts an entry into the audit_log table.
+func (p *Postgres) Record(entry models.AuditEntry) error {
+	var fieldErrors []byte
+	if len(entry.Errors) > 0 {
+		var err error
+		if fieldErrors, err = json.Marshal(entry.Errors); err != nil {
+			return err
+		}
+	}
+
+	_, err := p.DB.Exec(`
+		INSERT INTO audit_log (logged_at, actor, claimed_actor, ip, request_id, method, path, status, detail, errors)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+	`, entry.Time, entry.Actor, entry.ClaimedActor, entry.IP, entry.RequestID, entry.Method, entry.Path, entry.Status, entry.Detail, fieldErrors)
+	return err
+}
+
+// List returns the entries of the audit_log table matching the filter, newest first.
+func (p *Postgres) List(filter Filter) ([]models.AuditEntry, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(format string, value any) {
+		args = append(args, value)
+		conditions = append(conditions, fmt.Sprintf(format, len(args)))
+	}
+	if !filter.From.IsZero() {
+		addCondition("logged_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		addCondition("logged_at < $%d", filter.To)
+	}
+	if filter.Actor != "" {
+		addCondition("actor = $%d", filter.Actor)
+	}
+	if filter.Before > 0 {
+		addCondition("id < $%d", filter.Before)
+	}
+
+	query := `SELECT id, logged_at, actor, claimed_actor, ip, request_id, method, path, status, detail, errors FROM audit_log`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC"
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := p.DB.Query(query+";", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []models.AuditEntry{}
+	for rows.Next() {
+		var entry models.AuditEntry
+		var fieldErrors []byte
+		if err := rows.Scan(&entry.ID, &entry.Time, &entry.Actor, &entry.ClaimedActor, &entry.IP, &entry.RequestID,
+			&entry.Method, &entry.Path, &entry.Status, &entry.Detail, &fieldErrors); err != nil {
+			return nil, err
+		}
+		if fieldErrors != nil {
+			if err := json.Unmarshal(fieldErrors, &entry.Errors); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,282 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken is returned when a bearer token is malformed, expired or not signed by a trusted key.
+var ErrInvalidToken = errors.New("invalid bearer token")
+
+// tokenLeeway is the clock skew tolerated when checking the times of a token.
+const tokenLeeway = 30 * time.Second
+
+// maxJWKSSize is the largest key set read from a file or URL.
+const maxJWKSSize = 1 << 20
+
+// defaultRoleClaim lists the roles of a token unless TokenConfig names another claim.
+const defaultRoleClaim = "roles"
+
+var (
+	hmacMethods      = []string{"HS256", "HS384", "HS512"}
+	publicKeyMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+	jwksClient       = &http.Client{Timeout: 10 * time.Second}
+)
+
+// TokenConfig sets what a JWT must contain besides a valid signature and expiry.
+type TokenConfig struct {
+	Issuer    string          // the required iss claim, unchecked when empty
+	Audience  string          // a required member of the aud claim, unchecked when empty
+	RoleClaim string          // the dotted path of the claim listing roles, such as realm_access.roles; roles when empty
+	RoleMap   map[string]Role // claim values granting a role besides the role names themselves
+}
+
+// TokenVerifier verifies JWT bearer tokens signed either with a shared HMAC secret or with
+// the keys of a JSON Web Key Set, and maps their claims to an Identity.
+type TokenVerifier struct {
+	config  TokenConfig
+	methods []string
+	secret  []byte
+
+	mu   sync.RWMutex
+	keys map[string]any // key ID -> *rsa.PublicKey or *ecdsa.PublicKey
+}
+
+// NewHMACVerifier creates a verifier of tokens signed with HS256, HS384 or HS512 and the given secret.
+func NewHMACVerifier(secret []byte, config TokenConfig) *TokenVerifier {
+	return &TokenVerifier{config: config, methods: hmacMethods, secret: secret}
+}
+
+// NewJWKSVerifier creates a verifier of tokens signed with RSA or ECDSA keys of a JSON Web Key Set.
+func NewJWKSVerifier(jwks []byte, config TokenConfig) (*TokenVerifier, error) {
+	v := &TokenVerifier{config: config, methods: publicKeyMethods}
+	if err := v.SetJWKS(jwks); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// LoadJWKS reads a JSON Web Key Set from a local file, or fetches it when source is an http(s) URL.
+func LoadJWKS(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "https://") && !strings.HasPrefix(source, "http://") {
+		return os.ReadFile(source)
+	}
+
+	resp, err := jwksClient.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", source, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxJWKSSize))
+}
+
+// jsonWebKey holds the members of a JSON Web Key used for RSA and EC public keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// SetJWKS replaces the trusted keys with the signing keys of a JSON Web Key Set.
+// Encryption keys and key types other than RSA and EC are ignored.
+func (v *TokenVerifier) SetJWKS(jwks []byte) error {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &set); err != nil {
+		return fmt.Errorf("invalid JWKS: %v", err)
+	}
+
+	keys := make(map[string]any)
+	for i, key := range set.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		var publicKey any
+		var err error
+		switch key.Kty {
+		case "RSA":
+			publicKey, err = rsaPublicKey(key)
+		case "EC":
+			publicKey, err = ecPublicKey(key)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("invalid JWKS key %d: %v", i, err)
+		}
+		keys[key.Kid] = publicKey
+	}
+	if len(keys) == 0 {
+		return errors.New("the key set has no RSA or EC signing keys")
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.mu.Unlock()
+	return nil
+}
+
+// decodeBigInt decodes an unpadded base64url big-endian integer.
+func decodeBigInt(value string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("invalid base64url integer %q", value)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func rsaPublicKey(key jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(key.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(key.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("unsupported RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func ecPublicKey(key jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch key.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", key.Crv)
+	}
+	x, err := decodeBigInt(key.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(key.Y)
+	if err != nil {
+		return nil, err
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("the point is not on curve %s", key.Crv)
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// keyFor returns the key verifying a token: the shared secret, or the key named by its kid header.
+// A token without kid is verified with the only key of a single-key set.
+func (v *TokenVerifier) keyFor(token *jwt.Token) (any, error) {
+	if v.secret != nil {
+		return v.secret, nil
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	kid, _ := token.Header["kid"].(string)
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(v.keys) == 1 {
+		for _, key := range v.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// Verify checks the signature, expiry, issuer and audience of a token and returns the caller it names.
+// The sub claim becomes the name of the identity and the role claim its highest role.
+func (v *TokenVerifier) Verify(token string) (Identity, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(tokenLeeway),
+	}
+	if v.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(v.config.Issuer))
+	}
+	if v.config.Audience != "" {
+		options = append(options, jwt.WithAudience(v.config.Audience))
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := jwt.NewParser(options...).ParseWithClaims(token, claims, v.keyFor); err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	subject, _ := claims["sub"].(string)
+	name, err := cleanName(subject)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: the sub claim must have 1 to 255 characters", ErrInvalidToken)
+	}
+	return Identity{Name: name, Role: v.roleOf(claims)}, nil
+}
+
+// roleOf returns the highest role granted by the values of the role claim, which is either
+// a list of strings or a string of space separated values.
+func (v *TokenVerifier) roleOf(claims jwt.MapClaims) Role {
+	path := v.config.RoleClaim
+	if path == "" {
+		path = defaultRoleClaim
+	}
+
+	var value any = map[string]any(claims)
+	for _, member := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return RoleNone
+		}
+		value = object[member]
+	}
+
+	var values []string
+	switch value := value.(type) {
+	case string:
+		values = strings.Fields(value)
+	case []any:
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	role := RoleNone
+	for _, value := range values {
+		granted, ok := v.config.RoleMap[value]
+		if !ok {
+			granted, _ = ParseRole(value)
+		}
+		if granted > role {
+			role = granted
+		}
+	}
+	return role
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"backend/internal/models"
+)
+
+// KeyPrefix starts every API key, telling it apart from other credentials.
+const KeyPrefix = "swk_"
+
+// displayedPrefix is the length of the start of a key kept to identify it in listings.
+const displayedPrefix = len(KeyPrefix) + 8
+
+var (
+	// ErrNotFound is returned when an API key does not exist.
+	ErrNotFound = errors.New("API key not found")
+	// ErrInvalidKey is returned when a presented API key is unknown or revoked.
+	ErrInvalidKey = errors.New("invalid API key")
+	// ErrDuplicateKey is returned when an API key is added twice.
+	ErrDuplicateKey = errors.New("API key already exists")
+	// ErrInvalidName is returned when an API key is created with an empty or too long name.
+	ErrInvalidName = errors.New("API key name must have 1 to 255 characters")
+)
+
+// KeyStore keeps the hashes of the API keys allowed to call the API.
+type KeyStore interface {
+	// CreateKey generates and stores a new key granting a role, returning it in plain text together
+	// with its record. The plain text is not kept and cannot be shown again.
+	CreateKey(name string, role Role) (string, models.APIKey, error)
+	// ListKeys returns all keys, revoked ones included, oldest first.
+	ListKeys() ([]models.APIKey, error)
+	// RevokeKey revokes a key, returning ErrNotFound when it does not exist. Revoking a revoked key has no effect.
+	RevokeKey(id int64) error
+	// Authenticate returns the key matching a plain text key, or ErrInvalidKey when it is unknown or revoked.
+	Authenticate(key string) (models.APIKey, error)
+}
+
+// GenerateKey returns a new random API key.
+func GenerateKey() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return KeyPrefix + hex.EncodeToString(b[:]), nil
+}
+
+// HashKey returns the hex encoded SHA-256 hash under which a key is stored.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// prefixOf returns the start of a key shown in listings.
+func prefixOf(key string) string {
+	if len(key) > displayedPrefix {
+		return key[:displayedPrefix]
+	}
+	return key
+}
+
+// checkKeyRole rejects keys granting no role.
+func checkKeyRole(role Role) error {
+	if role < RoleReader || role > RoleAdmin {
+		return fmt.Errorf("invalid API key role %v", role)
+	}
+	return nil
+}
+
+// cleanName trims a key name, which becomes the actor of the requests made with the key.
+func cleanName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > 255 {
+		return "", ErrInvalidName
+	}
+	return name, nil
+}
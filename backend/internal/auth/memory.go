@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"backend/internal/models"
+)
+
+// Memory is the KeyStore kept in process memory, used with in-memory storage.
+type Memory struct {
+	mu     sync.RWMutex
+	keys   []models.APIKey
+	hashes map[string]int // key hash -> index into keys
+}
+
+var _ KeyStore = (*Memory)(nil)
+
+// NewMemory creates an empty key store.
+func NewMemory() *Memory {
+	return &Memory{hashes: make(map[string]int)}
+}
+
+// AddKey stores a key chosen by the caller, such as one passed in the configuration.
+func (m *Memory) AddKey(name, key string, role Role) (models.APIKey, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return models.APIKey{}, err
+	}
+	if err := checkKeyRole(role); err != nil {
+		return models.APIKey{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hash := HashKey(key)
+	if _, exists := m.hashes[hash]; exists {
+		return models.APIKey{}, ErrDuplicateKey
+	}
+	record := models.APIKey{ID: int64(len(m.keys) + 1), Name: name, Prefix: prefixOf(key), Role: role.String(), CreatedAt: time.Now()}
+	m.hashes[hash] = len(m.keys)
+	m.keys = append(m.keys, record)
+	return record, nil
+}
+
+// CreateKey stores a newly generated key.
+func (m *Memory) CreateKey(name string, role Role) (string, models.APIKey, error) {
+	key, err := GenerateKey()
+	if err != nil {
+		return "", models.APIKey{}, err
+	}
+	record, err := m.AddKey(name, key, role)
+	if err != nil {
+		return "", models.APIKey{}, err
+	}
+	return key, record, nil
+}
+
+// ListKeys returns copies of all keys.
+func (m *Memory) ListKeys() ([]models.APIKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return append([]models.APIKey{}, m.keys...), nil
+}
+
+// RevokeKey sets the revocation time of a key unless it is already revoked.
+func (m *Memory) RevokeKey(id int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if id < 1 || id > int64(len(m.keys)) {
+		return ErrNotFound
+	}
+	if key := &m.keys[id-1]; key.RevokedAt == nil {
+		now := time.Now()
+		key.RevokedAt = &now
+	}
+	return nil
+}
+
+// Authenticate looks up a key by its hash.
+func (m *Memory) Authenticate(key string) (models.APIKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	i, exists := m.hashes[HashKey(key)]
+	if !exists || m.keys[i].RevokedAt != nil {
+		return models.APIKey{}, ErrInvalidKey
+	}
+	return m.keys[i], nil
+}
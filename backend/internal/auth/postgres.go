@@ -0,0 +1,99 @@
+package auth
+
+import (
+	"database/sql"
+	"errors"
+
+	"backend/internal/models"
+)
+
+// Postgres is the KeyStore kept in the api_keys table.
+type Postgres struct {
+	DB *sql.DB
+}
+
+var _ KeyStore = (*Postgres)(nil)
+
+// NewPostgres creates a key store using the given database connection.
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{DB: db}
+}
+
+// CreateKey inserts the hash of a newly generated key.
+func (p *Postgres) CreateKey(name string, role Role) (string, models.APIKey, error) {
+	name, err := cleanName(name)
+	if err != nil {
+		return "", models.APIKey{}, err
+	}
+	if err := checkKeyRole(role); err != nil {
+		return "", models.APIKey{}, err
+	}
+	key, err := GenerateKey()
+	if err != nil {
+		return "", models.APIKey{}, err
+	}
+
+	record := models.APIKey{Name: name, Prefix: prefixOf(key), Role: role.String()}
+	err = p.DB.QueryRow(`
+		INSERT INTO api_keys (name, prefix, key_hash, role)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at;
+	`, record.Name, record.Prefix, HashKey(key), record.Role).Scan(&record.ID, &record.CreatedAt)
+	if err != nil {
+		return "", models.APIKey{}, err
+	}
+	return key, record, nil
+}
+
+// ListKeys returns all rows of the api_keys table.
+func (p *Postgres) ListKeys() ([]models.APIKey, error) {
+	rows, err := p.DB.Query(`SELECT id, name, prefix, role, created_at, revoked_at FROM api_keys ORDER BY id;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []models.APIKey{}
+	for rows.Next() {
+		var key models.APIKey
+		if err := rows.Scan(&key.ID, &key.Name, &key.Prefix, &key.Role, &key.CreatedAt, &key.RevokedAt); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+// RevokeKey sets the revocation time of a key unless it is already revoked.
+func (p *Postgres) RevokeKey(id int64) error {
+	var exists bool
+	err := p.DB.QueryRow(`
+		WITH revoked AS (
+			UPDATE api_keys SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL
+		)
+		SELECT EXISTS (SELECT 1 FROM api_keys WHERE id = $1);
+	`, id).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Authenticate looks up a key by its hash.
+func (p *Postgres) Authenticate(key string) (models.APIKey, error) {
+	var record models.APIKey
+	err := p.DB.QueryRow(`
+		SELECT id, name, prefix, role, created_at, revoked_at FROM api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL;
+	`, HashKey(key)).Scan(&record.ID, &record.Name, &record.Prefix, &record.Role, &record.CreatedAt, &record.RevokedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.APIKey{}, ErrInvalidKey
+	}
+	if err != nil {
+		return models.APIKey{}, err
+	}
+	return record, nil
+}
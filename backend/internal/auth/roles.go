@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Role grants access to the API; every role includes the permissions of the lower ones.
+type Role int
+
+const (
+	// RoleNone grants nothing.
+	RoleNone Role = iota
+	// RoleReader reads SWIFT codes, banks and countries.
+	RoleReader
+	// RoleEditor also creates, changes and restores SWIFT codes and their clearing codes.
+	RoleEditor
+	// RoleAdmin also deletes SWIFT codes and reads the audit log.
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:   "none",
+	RoleReader: "reader",
+	RoleEditor: "editor",
+	RoleAdmin:  "admin",
+}
+
+// String returns the name of a role.
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("Role(%d)", int(r))
+}
+
+// Allows reports whether the role includes the permissions of another one.
+func (r Role) Allows(required Role) bool {
+	return r >= required
+}
+
+// ParseRole returns the role named reader, editor or admin, ignoring case.
+func ParseRole(name string) (Role, error) {
+	for role, roleName := range roleNames {
+		if role != RoleNone && strings.EqualFold(strings.TrimSpace(name), roleName) {
+			return role, nil
+		}
+	}
+	return RoleNone, fmt.Errorf("unknown role %q – it must be reader, editor or admin", name)
+}
+
+// Identity is an authenticated caller.
+type Identity struct {
+	Name string // the actor recorded for the caller's changes
+	Role Role
+}
@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"backend/internal/validation"
+)
+
+// migrationSteps are Go steps run after the script of a migration, in the same transaction, for
+// changes that need the text rules of the validation package.
+var migrationSteps = map[int]func(ctx context.Context, tx *sql.Tx) error{
+	2: backfillSearchKeys,
+}
+
+// legacyBank is a bank row written before the search keys were introduced.
+type legacyBank struct {
+	id, name, countryID string
+}
+
+// backfillSearchKeys fills in the search keys and ASCII names of the banks and the ASCII addresses of
+// the SWIFT codes the way the API computes them, then restores unique_bank on the search keys. Banks of
+// a country whose names only differ in case or accents share a key, so their SWIFT codes are moved to
+// the first of them and the others are removed.
+func backfillSearchKeys(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, `SELECT id, name, country_id FROM banks ORDER BY country_id, name, id`)
+	if err != nil {
+		return err
+	}
+	var banks []legacyBank
+	for rows.Next() {
+		var bank legacyBank
+		if err := rows.Scan(&bank.id, &bank.name, &bank.countryID); err != nil {
+			rows.Close()
+			return err
+		}
+		banks = append(banks, bank)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	kept := make(map[[2]string]string, len(banks))
+	for _, bank := range banks {
+		key := validation.SearchKey(bank.name)
+		if keptID, ok := kept[[2]string{bank.countryID, key}]; ok {
+			if _, err := tx.ExecContext(ctx, `UPDATE swift_codes SET bank_id = $1 WHERE bank_id = $2`, keptID, bank.id); err != nil {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, `DELETE FROM banks WHERE id = $1`, bank.id); err != nil {
+				return err
+			}
+			continue
+		}
+		kept[[2]string{bank.countryID, key}] = bank.id
+
+		ascii := validation.Transliterate(validation.NormalizeText(bank.name))
+		if _, err := tx.ExecContext(ctx, `UPDATE banks SET name_key = $2, name_ascii = $3 WHERE id = $1`, bank.id, key, ascii); err != nil {
+			return err
+		}
+	}
+
+	rows, err = tx.QueryContext(ctx, `SELECT id, address FROM swift_codes WHERE address_ascii = ''`)
+	if err != nil {
+		return err
+	}
+	addresses := map[string]string{}
+	for rows.Next() {
+		var id, address string
+		if err := rows.Scan(&id, &address); err != nil {
+			rows.Close()
+			return err
+		}
+		addresses[id] = address
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for id, address := range addresses {
+		if _, err := tx.ExecContext(ctx, `UPDATE swift_codes SET address_ascii = $2 WHERE id = $1`, id, validation.Transliterate(address)); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		ALTER TABLE banks ALTER COLUMN name_key SET NOT NULL, ALTER COLUMN name_ascii SET NOT NULL;
+		ALTER TABLE banks ADD CONSTRAINT unique_bank UNIQUE (name_key, country_id);
+	`)
+	return err
+}
@@ -11,7 +11,8 @@ import (
 
 // initdb attempts to connect to the database up to 5 times,
 // logging actual errors and returning a reference or failure.
-func InitDB(dataSourceName string) (*sql.DB, error) {
+// With autoMigrate the pending schema migrations are applied once connected.
+func InitDB(dataSourceName string, autoMigrate bool) (*sql.DB, error) {
 	var database *sql.DB
 	var err error
 
@@ -26,6 +27,11 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 		err = database.Ping()
 		if err == nil {
 			log.Println("successfully connected to the database")
+			if autoMigrate {
+				if err := migrateOnStart(database); err != nil {
+					return nil, err
+				}
+			}
 			return database, nil
 		}
 
@@ -35,3 +41,16 @@ func InitDB(dataSourceName string) (*sql.DB, error) {
 
 	return nil, fmt.Errorf("could not connect to database after 5 attempts: %v", err)
 }
+
+// migrateOnStart applies the pending migrations, closing the database when they fail.
+func migrateOnStart(database *sql.DB) error {
+	applied, err := MigrateUp(database)
+	for _, migration := range applied {
+		log.Printf("applied migration %d_%s", migration.Version, migration.Name)
+	}
+	if err != nil {
+		database.Close()
+		return err
+	}
+	return nil
+}
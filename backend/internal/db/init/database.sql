@@ -1,2017 +0,0 @@
---
--- PostgreSQL database dump
---
-
--- Dumped from database version 17rc1
--- Dumped by pg_dump version 17rc1
-
--- Started on 2025-02-06 20:48:43
-
-SET statement_timeout = 0;
-SET lock_timeout = 0;
-SET idle_in_transaction_session_timeout = 0;
-SET client_encoding = 'UTF8';
-SET standard_conforming_strings = on;
-SELECT pg_catalog.set_config('search_path', '', false);
-SET check_function_bodies = false;
-SET xmloption = content;
-SET client_min_messages = warning;
-SET row_security = off;
-
---
--- TOC entry 10 (class 2615 OID 25112)
--- Name: public; Type: SCHEMA; Schema: -; Owner: postgres
---
-
--- *not* creating schema, since initdb creates it
-
-
-ALTER SCHEMA public OWNER TO postgres;
-
---
--- TOC entry 4893 (class 0 OID 0)
--- Dependencies: 10
--- Name: SCHEMA public; Type: COMMENT; Schema: -; Owner: postgres
---
-
-COMMENT ON SCHEMA public IS '';
-
-
---
--- TOC entry 2 (class 3079 OID 25113)
--- Name: uuid-ossp; Type: EXTENSION; Schema: -; Owner: -
---
-
-CREATE EXTENSION IF NOT EXISTS "uuid-ossp" WITH SCHEMA public;
-
-
---
--- TOC entry 4895 (class 0 OID 0)
--- Dependencies: 2
--- Name: EXTENSION "uuid-ossp"; Type: COMMENT; Schema: -; Owner: 
---
-
-COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UUIDs)';
-
-
---
--- TOC entry 235 (class 1255 OID 33048)
--- Name: delete_swift_code(character varying); Type: FUNCTION; Schema: public; Owner: postgres
---
-
-CREATE FUNCTION public.delete_swift_code(swift_code_input character varying) RETURNS boolean
-    LANGUAGE plpgsql
-    AS $$
-DECLARE
-    bank_id_var UUID;
-    country_id_var UUID;
-BEGIN
-    DELETE FROM swift_codes
-    WHERE swift_code = swift_code_input
-    RETURNING bank_id INTO bank_id_var;
-
-    IF bank_id_var IS NULL THEN
-        RETURN FALSE;
-    END IF;
-
-    IF NOT EXISTS (SELECT 1 FROM swift_codes WHERE bank_id = bank_id_var) THEN
-        SELECT country_id INTO country_id_var FROM banks WHERE id = bank_id_var;
-        DELETE FROM banks WHERE id = bank_id_var;
-
-        IF NOT EXISTS (SELECT 1 FROM banks WHERE country_id = country_id_var) THEN
-            DELETE FROM countries WHERE id = country_id_var;
-        END IF;
-    END IF;
-
-    RETURN TRUE;
-END;
-$$;
-
-
-ALTER FUNCTION public.delete_swift_code(swift_code_input character varying) OWNER TO postgres;
-
-SET default_tablespace = '';
-
-SET default_table_access_method = heap;
-
---
--- TOC entry 222 (class 1259 OID 25124)
--- Name: banks; Type: TABLE; Schema: public; Owner: postgres
---
-
-CREATE TABLE public.banks (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    name character varying(255) NOT NULL,
-    country_id uuid NOT NULL
-);
-
-
-ALTER TABLE public.banks OWNER TO postgres;
-
---
--- TOC entry 223 (class 1259 OID 25128)
--- Name: countries; Type: TABLE; Schema: public; Owner: postgres
---
-
-CREATE TABLE public.countries (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    iso2_code character varying(2) NOT NULL,
-    name character varying(100) NOT NULL
-);
-
-
-ALTER TABLE public.countries OWNER TO postgres;
-
---
--- TOC entry 224 (class 1259 OID 25132)
--- Name: swift_codes; Type: TABLE; Schema: public; Owner: postgres
---
-
-CREATE TABLE public.swift_codes (
-    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
-    swift_code character varying(11) NOT NULL,
-    bank_id uuid NOT NULL,
-    is_headquarter boolean NOT NULL,
-    address character varying(255) NOT NULL
-);
-
-
-ALTER TABLE public.swift_codes OWNER TO postgres;
-
---
--- TOC entry 4885 (class 0 OID 25124)
--- Dependencies: 222
--- Data for Name: banks; Type: TABLE DATA; Schema: public; Owner: postgres
---
-
-COPY public.banks (id, name, country_id) FROM stdin;
-2f8dfa08-8b09-47a1-b16d-bd1cba4a2e9a	4 SQ INTERNATIONAL SCC LTD	946573ce-5155-4405-a246-65b6a3d90a20
-c358258f-1ed7-413c-a8ec-e79bf478d238	A2A INTERNATIONAL HOLDINGS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-c62a6732-c3cd-4796-bdfc-8a8e77f5a7d3	A3E CAPITAL SICAV P.L.C.	946573ce-5155-4405-a246-65b6a3d90a20
-71e79e80-bcad-4ea9-982e-ccc237868a4b	ABLV BANK, AS IN LIQUIDATION	c90259ac-8f05-467b-bdad-8e0549913bcc
-900a798e-9476-4e90-a6cd-c629ea88198c	ABV INVESTMENTS LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-bb578c31-c7a3-4a6a-a886-239b0d673569	ACE EUROPE SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-a7332dcc-03b5-484e-8340-cbf66b507a1f	ACTIVA ASSET MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-fa17dab4-2078-4d6a-b4be-9840ad67d728	ADAMANT CAPITAL PARTNERS AD	f2a8c291-89bb-4079-8618-980bebe0d294
-83a8dd56-ebaf-43aa-8d12-c8545909d94c	ADMINISTRADORA DE FONDOS DE PENSIONES CAPITAL S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-96d62063-ad5e-40b1-a8dc-f6095521e977	ADMINISTRADORA DE FONDOS DE PENSIONES PROVIDA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-2dedc756-957d-460f-b13a-2d3e731dea22	ADMINISTRADORA GENERAL DE FONDOS SURA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-ea885cf1-1fdb-463a-82d8-adc4b05e5bbc	AFEX AGENTES DE VALORES LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8980487f-0022-41ad-82fd-a25892fe1c9c	AFINIDAD A.F.A.P.S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-c5720be5-def3-442d-b1db-10af91fce085	AFP CUPRUM, S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-7594958f-b615-4343-a59b-09ae6fbe3e02	AIB BANK NV	48c73c0d-d359-4845-a1f6-8cbf182c9917
-1a09b7d4-16b1-49c6-8c98-3be9ee50db30	AION BANK S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ac5f6c7d-e1d1-471b-9ed9-1a52b5b2686f	AKBANK T.A.S. (MALTA BRANCH)	946573ce-5155-4405-a246-65b6a3d90a20
-ae4b35dd-5149-445a-99b5-10ca0741112a	AKCIJU SABIEDRIBA 'LATGALES FINANSU KOMPANIJA'	c90259ac-8f05-467b-bdad-8e0549913bcc
-b8598090-973f-4561-8fe7-d4a0b13b047a	AKCIJU SABIEDRIBA NASDAQ RIGA	c90259ac-8f05-467b-bdad-8e0549913bcc
-5fecb5e5-57a1-40ea-9a51-9bb4e090a9b2	ALARIC CAPITAL	f2a8c291-89bb-4079-8618-980bebe0d294
-59232f1a-5575-4607-bf9c-0c24943e468b	ALBANIAN SECURITIES REGISTER ALREG	a9025df0-6675-45e2-8354-5d1e073a8579
-31562ef7-c3f3-45f3-b962-8c03da92181c	ALFAPAY	c90259ac-8f05-467b-bdad-8e0549913bcc
-72fc966e-4de8-433b-939a-c653b08238f6	ALIOR BANK SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7a303a69-d608-4794-ae8c-d276932c1c8d	ALLIANZ BANK BULGARIA AD	f2a8c291-89bb-4079-8618-980bebe0d294
-4baaa691-b010-4d96-8599-b6dc9d9d6feb	ALMAR S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-dfb6d170-a482-4833-b2fb-3c60e251406d	ALPHA FX EUROPE LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-d893a61f-9941-4466-a06c-07c2ab1ea025	AMAGIS CAPITAL FUNDS SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-0a71d09e-805d-4d3e-b2b6-c82c1666ea4e	AMER SPORTS GLOBAL BUSINESS SERVICES SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-af146cc4-58b1-4623-bcf4-d047a2cfa6b2	AMERICAN BANK OF INVESTMENTS S.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-faffc990-f051-4f81-b4cf-16a1b85768a2	AMICORP FUND SERVICES MALTA LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-5e22ee49-c695-46fc-90e4-5bdddaf02aac	ANDARIA FINANCIAL SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-cd17ef70-51f3-4c58-9da6-e7921dc6e692	ANDBANC MONACO SAM	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-8e2a14cf-dc5a-470d-a3c4-9377ec40d2e4	ANTEL	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-b05b30d6-1c13-4728-b8f4-9aeef133c0e4	APRIL MEDITERRANEAN LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-f4a25b84-f212-44cf-9353-ba8ee224bfaa	APS BANK PLC.	946573ce-5155-4405-a246-65b6a3d90a20
-90986ed2-dcab-4df8-aaf7-16050988ee51	AQA UCITS FUNDS SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-e1b93249-09a8-4c12-a4b5-4aa0dd8a503a	ARCELORMITTAL POLAND SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b097c875-fb64-4828-827e-c4c8e27f960b	ARCUS ASSET MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-c8c64fc5-ae9f-4220-9403-1576a073aff5	ARUBA BANK, LTD	48c73c0d-d359-4845-a1f6-8cbf182c9917
-cb70415c-0554-4160-9a9b-00628dc03973	AS BALTIJAS PRIVATBANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-a80f79d3-df54-47bd-a0f7-890572bdb5a5	AS INBANK SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e38a1386-c73a-4fc6-95f1-d3bd84c0d4c4	AS INDEXO BANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-13f4c7bd-8e16-4db5-86ad-d702f6dba11f	AS INDUSTRA BANK	c90259ac-8f05-467b-bdad-8e0549913bcc
-595a6441-faf8-487e-8ee9-33b548c7a48c	AS LPB BANK	c90259ac-8f05-467b-bdad-8e0549913bcc
-7e4c616b-7b15-4f97-a0cd-b1946d94c6b3	AS MAGNETIQ BANK	c90259ac-8f05-467b-bdad-8e0549913bcc
-7090928e-dbce-4287-85c0-e07ad06cb390	ASTRA ASSET MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-e441afa7-3b43-49e6-b42c-6efc2581f328	AURORA SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-2c2ab63d-cdef-4e31-883b-e09a639d3520	AUSTRIAN BULGARIAN INVESTMENT GROUP AD	f2a8c291-89bb-4079-8618-980bebe0d294
-9ef81bf6-7473-409e-b839-99234b746bd0	AVAL IN JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-2a1d755b-5fed-4137-ac52-2450c2a808e1	AVC FINANCE PLC	f2a8c291-89bb-4079-8618-980bebe0d294
-f3dace05-8885-4877-980d-d1050146c675	AVIVA INVESTORS POLAND SPOLKA AKCYJNA W LIKWIDACJI	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6e05af92-5068-48a8-8c3d-8ed59dd578d8	AXERIA RE LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-abff3eef-5569-4100-a6e9-b1fc2d273d3f	BALKAN ADVISORY COMPANY-IP EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-9e007332-69a5-49e1-bf16-ae9875f81e85	BALLINGER EU LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-a37b0c96-d892-4d07-bf62-a1b968b36793	BALLINGER MARKETS LTD.	946573ce-5155-4405-a246-65b6a3d90a20
-74bc69fc-8c6e-491f-917e-47d1c3193695	BANCA POPOLARE DI SONDRIO (SUISSE) SA	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-da4a34b2-a400-4f13-b41c-4576d49f25b6	BANCHILE CORREDORES DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-e4e2b095-9df9-470b-874d-8944edd223ce	BANCO BICE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-337eb7af-ee6a-4e0f-9b6a-3983928f9026	BANCO BILBAO VIZCAYA ARGENTARIA URUGUAY S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-b07732a0-dc06-4417-82b8-0a600aadf06d	BANCO BTG PACTUAL CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-6ab0012f-dc44-4692-b4b9-009bfe340c11	BANCO CENTRAL DE CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	BANCO CENTRAL DEL URUGUAY	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-da006820-c933-45c0-b566-720642c0d353	BANCO CONSORCIO	3f4cdf53-1468-4cff-9951-f55b22587ce4
-2c752332-bdad-4663-b45e-485223f63fe5	BANCO DE CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8896f848-e2e1-4268-b3ba-97013ed4a453	BANCO DE CREDITO E INVERSIONES	3f4cdf53-1468-4cff-9951-f55b22587ce4
-00d85d29-2784-4dec-90bf-e816c3a2ea31	BANCO DE LA NACION ARGENTINA	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-82c7602c-55bd-4185-9f2d-9f26a990bb79	BANCO DE LA PROVINCIA DE BUENOS AIRES	3f4cdf53-1468-4cff-9951-f55b22587ce4
-a3dbdcb5-95ce-42a4-bdc6-c7eab9d7981d	BANCO DE LA REPUBLICA ORIENTAL DEL URUGUAY	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-cc2895cb-654d-42b0-a804-896641cecf0d	BANCO DE PREVISION SOCIAL	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-235cc660-27aa-425a-8113-7df2d26fe711	BANCO DE SEGUROS DEL ESTADO	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-e9e1ad16-9edf-46c1-a850-94e8140c1c05	BANCO DEL ESTADO DE CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-210aa149-49d4-48d5-94a5-0adca312f380	BANCO DI CARIBE (ARUBA) N.V	48c73c0d-d359-4845-a1f6-8cbf182c9917
-ff7483e7-36c2-4d4c-b35d-37cf705f57cf	BANCO FALABELLA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-b10c5215-52f0-4b44-8306-20637d97ac34	BANCO HIPOTECARIO DEL URUGUAY	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-23418226-afe1-4798-931b-b108f0f6962e	BANCO INTERNACIONAL	3f4cdf53-1468-4cff-9951-f55b22587ce4
-ca1d73eb-aebd-42bb-9856-ab2ecea8725e	BANCO ITAU	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-bd5d26ca-429a-4245-bb2d-ebf61ddeee62	BANCO ITAU CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-9e405125-82fd-44ec-8059-88c59a048302	BANCO RIPLEY	3f4cdf53-1468-4cff-9951-f55b22587ce4
-f9428069-ca1e-4553-bdb4-9a1a5558c5db	BANCO SANTANDER	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-ebf2f1b9-3f7b-4943-9319-6ad231040a41	BANCO SANTANDER CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-2209d9ab-ed2b-4f56-8417-f7ee372a2e33	BANCO SECURITY	3f4cdf53-1468-4cff-9951-f55b22587ce4
-f4fffe4c-fe7d-40f0-b7a0-b55064042ba5	BANDES URUGUAY S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-84737404-3cc8-4da1-a4d8-b98cb4e9fe34	BANK BPH SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-60042e48-0739-449b-9a6f-eaaf2f346ec0	BANK GOSPODARSTWA KRAJOWEGO	267c8e25-7b4f-458e-b91f-3d56352fc23c
-fdbac88b-82fc-4955-be56-a19569794e55	BANK HANDLOWY W WARSZAWIE SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-40789083-212a-4e1b-b7e2-ad99e151d85b	BANK INICJATYW SPOLECZNO-EKONOMICZNYCH S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-699b4296-5963-4123-bcee-51d3daa110a6	BANK JULIUS BAER (MONACO) S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-d70edbe9-ef80-4f54-87f6-2b85c67dfc66	BANK LEUMI ROMANIA-SOFIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-cf3d7775-6f23-4200-9b71-379f745c8375	BANK MILLENNIUM S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-36c81cfb-9393-4bde-8fbe-76b95ced5552	BANK NOWY SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-cac3d68c-b522-449c-b72b-9bd70169f229	BANK OCHRONY SRODOWISKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e7ec208a-64bf-496d-a5aa-a9b650a7eab9	BANK OF ALBANIA	a9025df0-6675-45e2-8354-5d1e073a8579
-a6ae1666-40ff-4608-ba6c-fd6002172ed0	BANK OF AMERICA, S.A. SANTIAGO	3f4cdf53-1468-4cff-9951-f55b22587ce4
-7f496f67-08ac-47eb-ad82-8523bad56633	BANK OF CHINA (EUROPE) S.A. POLAND BRANCH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6834183f-1870-413f-b8a4-983057862c6c	BANK OF CHINA, AGENCIA EN CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-78ad8c60-ac26-4a88-b546-c470c7ffdce6	BANK OF LATVIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-06c08718-c9c2-491e-8e6c-6fc2a1882bbd	BANK OF VALLETTA P.L.C.	946573ce-5155-4405-a246-65b6a3d90a20
-d0a8ce23-d0de-47ae-9953-7e394dbca981	BANK PICTET AND CIE (EUROPE) AG, SUCCURSALE DE MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-91d89728-c686-4607-b879-3d5b1f1bccbc	BANK POLSKA KASA OPIEKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6a65c5b2-079b-4b19-a5c6-bd33c4681d91	BANK POLSKA KASA OPIEKI SA - BANK PEKAO SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9557c312-e82e-4a36-9dab-71c42f287c6a	BANK POLSKIEJ SPOLDZIELCZOSCI SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-01b150bd-4722-4898-aabc-e85594674178	BANK SPOLDZIELCZY 'WSPOLNA PRACA' W KUTNIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8a2e6f8a-ef5c-46f3-8dda-a1e0be8aad83	BANK SPOLDZIELCZY MAZOWSZE W PLOCKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1a623637-8383-402c-836f-42e90090f857	BANK SPOLDZIELCZY RZEMIOSLA W KRAKOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d16d162a-7417-4ce7-95b8-ebf604da9c5d	BANK SPOLDZIELCZY RZEMIOSLA W LODZI	267c8e25-7b4f-458e-b91f-3d56352fc23c
-0f916396-443a-4b9c-bed4-9078b0eeccf2	BANK SPOLDZIELCZY TOWARZYSTWO OSZCZEDNOSCIOWO POZYCZKOWE PA CO BANK W PABIANICACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-379441e4-4480-48f3-a824-66d0109b6533	BANK SPOLDZIELCZY W BELCHATOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7fc1789f-fd35-4652-9b00-72d347276bd3	BANK SPOLDZIELCZY W BELSKU DUZYM	267c8e25-7b4f-458e-b91f-3d56352fc23c
-fac71579-1dc2-4673-b353-38366b07d0b8	BANK SPOLDZIELCZY W BIALOBRZEGACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4b5ef67e-2ee8-466e-a580-1addd2d280af	BANK SPOLDZIELCZY W BIALYMSTOKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4cc09a98-b601-4538-aced-b706ee3f9a2a	BANK SPOLDZIELCZY W BIEZUNIU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-67d55863-5785-457d-aa32-496ffc4d1670	BANK SPOLDZIELCZY W BRANSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9d8965d5-a5cb-420d-b28d-44dc0445e11d	BANK SPOLDZIELCZY W BRODNICY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ff05de4a-93ab-4c3d-ab3d-fa77e0fa457b	BANK SPOLDZIELCZY W BYDGOSZCZY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-08e5b887-c433-4a6a-a4c7-ddaed14dd36c	BANK SPOLDZIELCZY W CHYNOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-25bcc898-d0ab-4a3f-840b-cb334d170652	BANK SPOLDZIELCZY W DZIALDOWIE Z/S W LIDZBARKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-59a2a766-91c8-4af8-982f-0c8067c29436	BANK SPOLDZIELCZY W GABINIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e0b58216-1fe9-4e01-94b7-0a1b85d4e8e8	BANK SPOLDZIELCZY W GASOCINIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-0baf3246-744d-4bda-b2bb-4aca807a5a8b	BANK SPOLDZIELCZY W GLINOJECKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4e0933a4-4221-4514-a742-8d73bf5ca474	BANK SPOLDZIELCZY W GLOWACZOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8fba61dd-3cfc-4c65-a838-5c072b968e91	BANK SPOLDZIELCZY W GLOWNIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-94e657ea-4e26-4413-a408-5ce2007f0c2d	BANK SPOLDZIELCZY W GOWOROWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6c204b51-885c-4a21-8295-1aa633491474	BANK SPOLDZIELCZY W GROJCU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5713e166-1688-47a8-a052-0e92df66db36	BANK SPOLDZIELCZY W GRUDUSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9fd46997-c9aa-4565-8cec-d0e23a4cf604	BANK SPOLDZIELCZY W HAJNOWCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e5ff80b2-e603-4d1c-9526-9216198394a9	BANK SPOLDZIELCZY W HALINOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-79146e5f-4149-4175-9f42-5fd5c5159861	BANK SPOLDZIELCZY W ILOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-fc4f86f1-7578-4632-a537-5be3e0470ab1	BANK SPOLDZIELCZY W KADZIDLE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d0286c1e-ecc0-4cf2-8dc9-160b67546143	BANK SPOLDZIELCZY W KLESZCZOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-bef58c6f-5ac3-4f2e-8f4d-5aae6af09524	BANK SPOLDZIELCZY W KROSNIEWICACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-678e7b3d-78e5-48ff-933b-c4e9e6673926	BANK SPOLDZIELCZY W LAPACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-3ed47b8d-594d-4d34-a203-5eabcde694d4	BANK SPOLDZIELCZY W LESZNOWOLI	267c8e25-7b4f-458e-b91f-3d56352fc23c
-77753321-b610-47d3-97f6-5e14beb37c34	BANK SPOLDZIELCZY W LIPNIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-955c9f7c-345d-4ab4-90a4-a6bdf8eb20f1	BANK SPOLDZIELCZY W LIPSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-71e89fd7-5b59-48e8-8552-5d3fb04b0e43	BANK SPOLDZIELCZY W MALBORKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-2fcbbfb7-2f4b-45a2-92be-f21052081a7e	BANK SPOLDZIELCZY W MLAWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-a6992074-d577-474f-83fe-8daafb2ae58f	BANK SPOLDZIELCZY W MSZCZONOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-a6b0cd69-635d-4de9-8a29-e6da67d33dc9	BANK SPOLDZIELCZY W NASIELSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-93ff1fe4-ed61-4647-94d9-1518fdadd184	BANK SPOLDZIELCZY W NOWYM STAWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5d04a346-b26c-4b54-a4bd-b5d58ee8bf78	BANK SPOLDZIELCZY W OPOCZNIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-008a551b-c4e1-4bf9-9f4e-40256d3c79f4	BANK SPOLDZIELCZY W PIONKACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d4cf53f1-383a-4854-a322-9a2494bedaed	BANK SPOLDZIELCZY W PRZEDBORZU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-264aa5a2-1f4d-49bf-b3ec-edfe98cb1cb4	BANK SPOLDZIELCZY W PRZYSUSZE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-040cc211-6b26-4ff7-be95-f5322b740283	BANK SPOLDZIELCZY W PULTUSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6e411f64-909b-4de3-b548-1ee110040fc5	BANK SPOLDZIELCZY W RACIAZU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6964c2a9-f622-4ab8-8279-2a802560ce94	BANK SPOLDZIELCZY W SKARYSZEWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4af4217c-2bfc-48c1-b949-39447a182c5a	BANK SPOLDZIELCZY W SOKOLACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-755aefc0-0d21-4997-b832-40c4f9c9e603	BANK SPOLDZIELCZY W STAREJ BIALEJ	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d1c81528-24b1-424f-8e3f-1c3a4f2d040c	BANK SPOLDZIELCZY W STRYKOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8e035646-adba-4018-a621-59f26fb05334	BANK SPOLDZIELCZY W STRZYZOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e1a9db00-3222-4407-89b5-6d5dac167c6d	BANK SPOLDZIELCZY W TARNOBRZEGU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-355c6bc8-63e6-4e22-ad72-516ad6c8fdeb	BANK SPOLDZIELCZY W TERESINIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ea51eca9-3bb0-4d1b-8593-e04d6e2313fa	BANK SPOLDZIELCZY W WARCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-29412c62-e5da-4f59-9d40-94c394a5729f	BANK SPOLDZIELCZY W WASEWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-91a9525b-4ae8-4775-bd71-18dacb7ab994	BANK SPOLDZIELCZY W WISKITKACH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-af5d3fe6-4689-4df8-9254-6297981543d9	BANK SPOLDZIELCZY W ZUROMINIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5d285e48-2083-4c4b-9fd6-dbc404abde35	BANK SPOLDZIELCZY W ZWOLENIU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-59f38c61-d2d6-49d8-bdc3-4dd2d0fac019	BANK SPOLDZIELCZY ZIEMI LECZYCKIEJ W LECZYCY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d3bb3ce4-328c-4391-b245-b1e26fa4dac8	BANK SPOLDZIELCZY ZIEMI LOWICKIEJ W LOWICZU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e38213af-a805-4490-a5c1-e53f97a32bc9	BANK SPOLDZIELCZY ZIEMI PIOTRKOWSKIEJ	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8ccb5d98-ab4e-44e9-a382-99d88ae60211	BANK SPOWDZIELCZY W CZERSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-55009faa-261a-4dc3-9c85-17f0ca6712dc	BANKA KOMBETARE TREGTARE SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-f3aef98e-ca83-4758-9c8a-7d63766f7c64	BANKA OTP ALBANIA SH.A	a9025df0-6675-45e2-8354-5d1e073a8579
-a786549b-f754-4231-940d-e2d53f98e988	BANKA OTP ALBANIA SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-281b3b4b-d032-49ca-bffe-dc549527670a	BANQUE HAVILLAND (MONACO) S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-79d96c38-7447-400b-abe6-83163b9c4bde	BANQUE HERITAGE (URUGUAY) S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-b780e60f-a770-4263-8126-971fe048819b	BANQUE J. SAFRA SARASIN (MONACO) SA	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-8bbb7968-0d75-4f8f-a90e-cc1dc845c25e	BANQUE MARTIN MAUREL	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-56d91e62-748e-4a24-aa51-31a06a2d5fa3	BANQUE POPULAIRE MEDITERRANEE	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-84643d9f-c2a6-4772-967d-b5b938efd067	BANQUE RICHELIEU MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-8b9e1499-d421-4815-834c-9cd0b95f87e1	BARCLAYS BANK PLC MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-1226fdf7-370a-4c7d-a213-b8bfdc990a7f	BARCLAYS BANK S.A	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-107c2c5f-a56b-44f3-b246-fd8ad05dc44f	BAT CHILE S.A	3f4cdf53-1468-4cff-9951-f55b22587ce4
-bfb01a9c-c753-470b-9766-e39858939125	BBVA CORREDORES DE BOLSA LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-f5ca72bd-f77f-449d-b0a0-90fdbb84680e	BCI CORREDOR DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-fe3a3154-6ec8-4dc3-9c07-0ac5ead90628	BCRA - CREDIT RATING AGENCY	f2a8c291-89bb-4079-8618-980bebe0d294
-16fff5e5-fec3-4d14-bda4-c20f69a132a5	BENCHMARK FINANCE	f2a8c291-89bb-4079-8618-980bebe0d294
-78a8e402-83dc-4bdd-899e-7a8b3483b686	BESINS HEALTHCARE MONACO SAM	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-1c86535f-e8c0-43e4-8bc9-5b1d8b340d61	BIGBANK AS - BRANCH BULGARIA	f2a8c291-89bb-4079-8618-980bebe0d294
-2a88cc34-84bb-40bd-b9b1-a16c8768cf99	BIGBANK AS LATVIJAS FILIALE	c90259ac-8f05-467b-bdad-8e0549913bcc
-c2f3223d-94c8-45d9-8df7-51eba83ba6ca	BLUOR BANK AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-24095eca-ef31-4239-b102-eda868e8a974	BNF BANK PLC (FORMERLY BANIF BANK MALTA)	946573ce-5155-4405-a246-65b6a3d90a20
-77048edf-088c-4d40-8113-8cbaeab9e669	BNP PARIBAS	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-e0f621a6-1d86-4f32-9a1c-c8a0167a7a80	BNP PARIBAS BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9948b30d-745b-411d-b458-4ec6c49687d6	BNP PARIBAS S.A.-SOFIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-b6a1f4d7-8f7c-4ad3-b148-8f5a5a667cb8	BNP PARIBAS S.A. BRANCH IN POLAND	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1d0070eb-00b4-4d8a-bcf0-95b62e59606a	BNP PARIBAS SECURITIES POLAND	267c8e25-7b4f-458e-b91f-3d56352fc23c
-156a67e4-9515-42cc-a670-f97d50f69e4c	BNP PARIBAS WEALTH MANAGEMENT MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-fa876a98-fc93-48fa-ad6b-fb21e28e7bc7	BOLSA DE SANTIAGO	3f4cdf53-1468-4cff-9951-f55b22587ce4
-266648d7-063d-44b2-a71b-691b91a2d981	BOLSA DE VALORES DE MONTEVIDEO S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-5ff3b011-89b4-4f59-aed8-ada047b064d9	BONDSPOT S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1484c75d-ddc5-438c-b8c4-75dbf10c111a	BORICA AD	f2a8c291-89bb-4079-8618-980bebe0d294
-a76d0da9-cf0d-4c78-be42-dadd4e8217b9	BOV ASSET MANAGEMENT LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-478039f7-8896-4e1a-8742-b403092f2100	BOV FUND SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-8f6d8be2-4b46-48fe-954c-3184173976d6	BRANIEWSKO - PASLECKI BANK SPOLDZIELCZY Z SIEDZIBAW PASLEKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1aed086f-f27e-43bc-b7f4-d362d12da092	BRITISH AMERICAN TOBACCO - ALBANIA SH.P.K.	a9025df0-6675-45e2-8354-5d1e073a8579
-a3291dfb-cd05-460f-a423-b170aabd9f80	BRITISH AMERICAN TOBACCO CHILE OPERACIONES S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-4c352901-4ae8-47e0-a42f-dddc07b64a8b	BTG PACTUAL CHILE S.A. CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8fccd80e-8683-4928-b2a4-735e188fbe4a	BTG PACTUAL CHILE SA CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-aa0ba58d-4541-4c7e-89ab-c09fba26f078	BULBROKERS	f2a8c291-89bb-4079-8618-980bebe0d294
-52ba4fff-75ab-4cb6-b657-a3d4e42e6a6a	BULBROKERS EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-a5a2ac68-3cb0-4e5b-ad9c-638de4a37354	BULEX INVEST PLC	f2a8c291-89bb-4079-8618-980bebe0d294
-9bdf3264-88e9-4035-b044-6019f52fbb07	BULGARIAN-AMERICAN CREDIT BANK	f2a8c291-89bb-4079-8618-980bebe0d294
-022ab7f8-130c-4444-aa34-e2e71ae4ad59	BULGARIAN DEVELOPMENT BANK EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-d4567382-7623-4762-99bb-0e33870bbcb1	BULGARIAN NATIONAL BANK	f2a8c291-89bb-4079-8618-980bebe0d294
-3e40d333-92a3-4714-bc8e-0b43bf6b2f0b	BULGARIAN NATIONAL BANK - BGN	f2a8c291-89bb-4079-8618-980bebe0d294
-cfc4536a-55f0-433f-85fa-5c2b93fd90c0	BULGARIAN STOCK EXCHANGE	f2a8c291-89bb-4079-8618-980bebe0d294
-cb29ed95-f50d-4cde-bac8-275b24fa24c1	CA AUTO BANK SPA SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9314fa70-596b-49f0-8103-f3ca5387e441	CAISSE D'EPARGNE ET DE PREVOYANCE COTE D'AZUR - AGENCE DE MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-6c19a1c0-c938-49a2-a7f3-3c9575f8fd40	CAIXABANK, S.A. (SPOLKA AKCYJNA) ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4cb06b2b-59e5-4731-a8ba-16d9a74812a8	CAJA DE JUBILACIONES Y PENSIONES BANCARIAS	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-d7681cf5-32f3-4513-93b5-feca762895b6	CAJA DE PROFESIONALES UNIVERSITARIOS	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-a5dd93b9-b6b1-4aab-8b2b-c8cee9bed4ea	CAJA NOTARIAL DE JUBILACIONES Y PENSIONES	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-09f2c701-b0f2-4ea9-a620-1863fd3a5a3b	CAPITAL INVEST JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-5c3b8a1b-ed6c-4ed9-a7dc-c5ec5c5ab85a	CAPITAL MARKETS JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-5e023531-b23b-4ac0-9ef6-70e601bc767d	CAPITALIA LUXEMBOURG S.A.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-040ff9b6-b28f-4c08-b59b-9a8f2bf6032a	CAPMAN ASSET MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-dbb67ab3-8074-47f7-b6bd-ae3f96bacd54	CAPMAN INC.	f2a8c291-89bb-4079-8618-980bebe0d294
-58663c86-fbdb-44eb-9816-6f471b95cd85	CARIBBEAN MERCANTILE BANK N.V.	48c73c0d-d359-4845-a1f6-8cbf182c9917
-994b800d-9b01-4fb0-87e7-b25558fc9911	CASA DE MONEDA DE CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-0c78b09a-77fd-4f1b-9947-3b55ce92cd75	CASPAR ASSET MANAGEMENT SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-864e0cfb-05ed-4e98-9705-507e64f40249	CCB ASSETS MANAGEMENT EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-19ca4e9d-29af-4f6e-b184-158521c5c5fd	CCLV, CONTRAPARTE CENTRAL S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-dc17b03b-f814-4c0b-a43f-e58ffd9cb74b	CEEVO FINANCIAL SERVICES (MALTA) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-c65f8c5e-3a9f-4678-8545-0b5503b652e8	CENTRAL BANK OF MALTA	946573ce-5155-4405-a246-65b6a3d90a20
-fda4fffc-41b8-44f2-babf-627f25d54901	CENTRAL COOPERATIVE BANK PLC	f2a8c291-89bb-4079-8618-980bebe0d294
-5b1d1706-c235-4510-a5e7-19474637faf0	CENTRAL DEPOSITORY AD	f2a8c291-89bb-4079-8618-980bebe0d294
-6c92ded4-47ef-48f1-ad28-65ce2ef5cc32	CENTRAL SECURITIES DEPOSITORY, THE	946573ce-5155-4405-a246-65b6a3d90a20
-21a30c16-0db9-4c00-b8a0-e33ebe4bbeaf	CENTRALE BANK VAN ARUBA	48c73c0d-d359-4845-a1f6-8cbf182c9917
-1d1440de-ab50-4ead-bca2-87317212e9bc	CENTRO DE COMPENSACION AUTOMATIZADO S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-64643800-f336-448b-b830-068efc3c77e1	CENTRO FINANCIERO COOPERATIVO	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-b7ff3069-5ad2-4f5c-9d75-9db7060fd5d5	CFM INDOSUEZ WEALTH	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-2f7015e2-11e0-430f-bad3-af1f632c92c0	CGM-AZIMUT MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-e9dacb00-da7a-4159-bf31-606dabf2278e	CHINA CONSTRUCTION BANK(EUROPE)WARSAW BRANCH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e989b108-0e8a-4975-aef7-5b2aefbd6b3e	CHINA CONSTRUCTION BANK,  AGENCIA EN CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-83bdf44b-2cdf-4d23-a3ef-fff6be9640a8	CINKCIARZ.PL SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-74259f96-4c8f-429f-b59d-69a8f22bdb51	CITADELE BANKA, AKCIJU SABIEDRIBA	c90259ac-8f05-467b-bdad-8e0549913bcc
-9038ccf5-b7fb-4ecb-ba3c-b0bfa96ca48f	CITCO CUSTODY LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-68c3ff55-3943-48b6-a4a9-fecc6a1dd074	CITIBANK EUROPE PLC, BULGARIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-9bc30334-19bb-46d7-bd57-49ccdeec36a8	CITIBANK N.A. URUGUAY	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-605b0c1b-d629-47aa-a9a5-fef43795769a	CM-CIC MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-4bca4f0f-944b-494a-b9fb-cc503f9e2e8c	CMB MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-e676bacf-cc4f-4f0d-a310-203396bc1624	COMBANC S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-1514d150-8641-459a-bf17-4627015d405f	COMDER CONTRAPARTE CENTRAL S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-005c4593-0eb7-4eee-8ed3-2aa1f6c04031	COMMBANK EUROPE LTD	946573ce-5155-4405-a246-65b6a3d90a20
-7f6ee1dc-e3fd-44cc-8a5a-eac64d009b7d	COMPASS INVEST JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-ef7146ac-3ea6-4620-9e54-0b1cf5cb9df6	CONCORD ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-f283f101-8dff-4e0e-a250-9950cbaccf47	CONOTOXIA SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e25347fe-8e99-4357-a65c-42c6d741dc1f	CONSORCIO CORREDORES DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-bafb0438-7771-4a33-87a7-83ce01b98260	CONVERA MALTA FINANCIAL LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-ace98c76-24e4-47ef-84ee-1e0d5c344b2a	COPAB	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-4e9ed172-48e6-484d-8d5d-b7ae24515adf	CORPORACION FOMENTO DE LA PRODUCCION	3f4cdf53-1468-4cff-9951-f55b22587ce4
-5be17037-edb3-4815-bcad-67be9f208946	CORPORACION NACIONAL DEL COBRE DE CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-0b5bbf4a-c131-486a-8f09-e1b1f811a4e4	CREDICORP CAPITAL ASSET MANAGEMENT S.A.ADMINISTRADORA GENERAL DE FONDO	3f4cdf53-1468-4cff-9951-f55b22587ce4
-144100c9-796b-46aa-a650-1ab92914eecc	CREDINS BANK S.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-80277956-a33b-4cd1-808a-21e3d13b402e	CREDIT AGRICOLE BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e24c6670-6842-48a3-82ce-d689c9f4eb3e	CREDIT AGRICOLE MONACO (CRCA PROVENCE COTE D'AZUR MONACO)	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-e2e7065b-3b4f-4ed1-a07c-2347fcea6d48	CREDIT DU NORD	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-1dd1730e-3a9c-48c6-b535-bfe58f82583a	CREDIT EUROPE BANK N.V. MALTA BRANCH	946573ce-5155-4405-a246-65b6a3d90a20
-3538a195-c238-46b5-a5e9-277f17dbabcb	CREDIT MOBILIER DE MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-36b71e58-47f8-44eb-9c59-145f927cb304	CREDIT SUISSE ASSET MANAGEMENT	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-9ae25edc-32bf-4005-8848-ee78336ac93c	CREDORAX BANK LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-b4641c52-9972-4920-ad93-598f84c6a4ae	CRYSTAL FINANCE INVESTMENTS LTD.	946573ce-5155-4405-a246-65b6a3d90a20
-aadc0543-7b08-4a2a-ae94-bc00dd24e760	CTBS S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-67c2d64f-7c2e-4053-9a16-4c1ca54acd71	CULTURA LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-48d4f5f0-83f3-44f6-bfc9-36b320bb7dbe	CURMI AND PARTNERS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-9867b1da-bff7-4adf-90be-3fa7b33e7f09	CURRENCY ONE S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-be2ccee5-ea81-4cf6-a1f9-443f97c8aa66	D COMMERCE BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-70e6d307-c7e2-4a91-8898-e72ea2e1c816	D.B.R. INVESTMENTS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-a1287539-02f9-4459-8060-52f67a924695	DANSKE BANK A/S S.A. ODZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-30fbaabb-c669-45fe-a08d-af8b35b32780	DATECS PAYMENT TECHNOLOGY EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-5bd962d4-7bb2-4494-b193-7d2b68653707	DE NOVO EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-612cb31b-a857-48d0-af25-e4611dbcc07e	DELTASTOCK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-3950f25f-ea80-4000-8537-598d147121ba	DEPOSITARIO CENTRAL DE VALORES	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-a6f0bdef-89f8-434f-b559-5d771361f626	DEPOSITO CENTRAL DE VALORES S.A. DEPOSITO DE VALORES	3f4cdf53-1468-4cff-9951-f55b22587ce4
-d6c0dc31-9f27-46d0-b04b-4c5f52d73042	DERIV INVESTMENTS (EUROPE) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-7ab83814-97cb-429d-bd9f-dd268838db54	DEUTSCHE BANK (URUGUAY) SOCIEDAD ANONIMA INSTITUCION FINANCIERA EXTERNA	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-bee6256a-ffab-4c06-95e4-b07fb9fb3061	DEUTSCHE BANK POLSKA S.A	267c8e25-7b4f-458e-b91f-3d56352fc23c
-39c57dab-e24c-489f-a2a3-635e10fedb93	DEUTSCHE BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8e2ece47-6ba8-41d5-a315-d4a2eef36ea8	DEUTSCHE SECURITIES SPA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-b94c0904-39d7-4ca5-8e45-e5fda03928a6	DIETSMANN MONTE CARLO SAM	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-26628782-78d8-4d68-b8c0-46fdd4c99143	DILINGOVA FINANSOVA KOMPANIA AD	f2a8c291-89bb-4079-8618-980bebe0d294
-b0de223d-e1b1-4271-b745-86437d15e2be	DNB BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-149e40e3-2989-46f8-9b8e-c08c90324086	DNB BANK POLSKA SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-3db61e09-061a-43dd-bf51-c7ceba95fcc5	DOLFIN ASSET SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-99e7ec90-4c6f-4769-b601-d9d0c688cf4c	DOM INWESTYCYJNY NEHREBETIUS S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-88ceb6cd-3713-4c6f-8b72-af5b1bd60083	DOM INWESTYCYJNY XELION SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7676d701-5228-4e9f-b566-1129adac13cb	DOM MAKLERSKI AFS SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9f3636c7-2fab-4fdd-90df-8fff0ff44e54	DOM MAKLERSKI BANKU BPS S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9c5c9e17-28f4-4da6-835b-bd3c3d3d66c8	DOM MAKLERSKI BANKU HANDLOWEGO SPOWKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9e6b7333-3157-4384-b810-87f35d899049	DOM MAKLERSKI BANKU OCHRONY SRODOWISKA SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-0a495ca8-ea17-44b6-bf90-a5d7e5cf6f8c	DOM MAKLERSKI BDM S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d8292258-139a-4b1a-8663-62b0409c531e	DOM MAKLERSKI NAVIGATOR SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-469b9413-9787-485e-94bd-7197228fb234	DOM MAKLERSKI PENETRATOR SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-59b91139-efaf-40d8-a6da-dd5dcf885670	DOM MAKLERSKI TMS BROKERS S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-3db4b087-da98-49a1-b940-20bd230f1267	DORADZTWO DLA POLSKICH PRZEDSIEBIORSTW SECURITIES S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7f3006e7-552b-4e87-a399-8350138bb1ea	DOXA ADVISORS S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-8ae57dbe-d6ac-42da-be0d-5563dfe7a33b	DSK BANK (FORMERLY STATE SAVINGS BANK)	f2a8c291-89bb-4079-8618-980bebe0d294
-714e2c18-7581-4027-aeb4-785087a73226	DUKASCOPY EUROPE IBS AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-f3ecd8ac-0fd0-44cf-957e-dd64fd07aa8e	E LATS, SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-c2ec522b-6f50-440a-b8b3-1c3ccf334185	EASY PAYMENT SERVICES LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-c6822902-2ddf-4710-9602-9d313fd07f1f	EASYPAY AD	f2a8c291-89bb-4079-8618-980bebe0d294
-0e7b72ac-e7c0-462b-8a4c-ced8177d67da	EBURY PARTNERS UK LIMITED	267c8e25-7b4f-458e-b91f-3d56352fc23c
-dcbd8c84-8aee-4541-b2c3-a3e411453154	ECCM BANK PLC	946573ce-5155-4405-a246-65b6a3d90a20
-b94350ae-2097-4367-86f4-f3a05ba2b5f4	ECONT FINANCIAL SERVICES LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-cfd6ce7e-4d8c-417e-9615-6d83d9ce0587	EDMOND DE ROTHSCHILD-MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-72c77558-9e70-440c-8060-ff0d6a32f795	EF ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-dc78d155-719a-4d13-be4c-a4e01446799b	EFG BANK (MONACO)	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-461d65bf-6261-421d-9edc-b4a739b861d6	EFT GLOBAL LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-8f68c503-f0b6-4dcd-8ccf-219d6e19219c	EIGER SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-86094322-85de-4334-9f42-754f6e8df967	ELANA FUND MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-f58531ea-4022-4299-bece-8732968e0949	ELANA TRADING INC	f2a8c291-89bb-4079-8618-980bebe0d294
-c79cb8ea-133b-4423-94aa-d7ecc0b2c308	EMONEY PLC	946573ce-5155-4405-a246-65b6a3d90a20
-8b0fe19b-01b3-40f2-a876-8ea780808381	EMP SYSTEMS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-2e242295-94e0-463d-8053-cd816ff3503f	ENEL CHILE S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-a494f716-29ea-4f58-ba01-35c559068a3a	ENGLAND.PL SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-a047308b-d99c-4099-a74f-4b17a2e65010	ERSTE SECURITIES POLSKA' SPOWKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b7b980a4-0d85-40d4-863d-cbffd85f25a5	EURO-FINANCE LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-ea535af6-65b6-4cce-92cc-90ecd5f2c94c	EUROAMERICA CORREDORES DE BOLSA S.A	3f4cdf53-1468-4cff-9951-f55b22587ce4
-9973c9cd-d327-49d2-93f2-c0f20a8f365d	EUROBANK BULGARIA AD	f2a8c291-89bb-4079-8618-980bebe0d294
-dce712c1-edea-4d04-a3d5-28f7509d044b	EUROCAM SA	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-54d25409-0d29-4981-9813-a958e647f68a	EUROCHANGE FINANCIAL SERVICES LTD	946573ce-5155-4405-a246-65b6a3d90a20
-d22bee31-b94f-4f95-a8a3-665a8ca9ce01	EUROPEAN DEPOSITARY BANK SA MALTA BRANCH	946573ce-5155-4405-a246-65b6a3d90a20
-e0d09e3f-ba8f-49bd-ae4f-1ab2626f5824	EURORATING SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8e8dc8b4-8e6c-4344-b76c-5f3e3aad9bdc	EVEREST NETWORK LTD.	946573ce-5155-4405-a246-65b6a3d90a20
-f63c571e-fccd-4c7a-8105-55a20b82bcb7	EXPAT ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-0d63e2a6-7d97-4c35-afc2-4764217ed772	EXPAT ASSET MANAGEMENT EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-721f90d5-f965-4c03-a581-937d76ac523c	EXPRESS BANK SPOLDZIELCZY W RZESZOWIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d04186d6-82f4-46d2-90b1-4484b25c434d	FCM BANK LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-c15545ad-b220-40ef-a3cd-b1b74190d326	FENIGE SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7556cc79-7676-4204-bd98-ca317a785dfb	FEXSERV FINANCIAL SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-8c567e76-8544-48bf-8529-ecc8a6e47483	FFBH ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-c72d5465-19b0-4152-9ae4-708ea62f00fd	FH EVER INC.	f2a8c291-89bb-4079-8618-980bebe0d294
-bb68df58-117b-4a0a-8bb0-0093938db103	FH FINA-S AD	f2a8c291-89bb-4079-8618-980bebe0d294
-9fccb0ef-c127-4551-af46-77acfffcc1f6	FIMBANK PLC	946573ce-5155-4405-a246-65b6a3d90a20
-f03174e0-7f09-47f1-b5cd-5854acd8ecc1	FINACOM INVESTMENT HOUSE LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-df1df1da-6720-4488-a882-61415875e576	FINANCE INCORPORATED LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-977e9fc6-8b27-4b4a-912a-5be418b3c15e	FINANCIAL PLANNING SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-661ff74c-fc1e-4276-8c18-7af2627973aa	FINANZAS Y NEGOCIOS S.A. CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-bd04d104-4ed4-4046-af91-a98b0c63c8b0	FINCO TREASURY MANAGEMENT LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-a877aa5d-91ee-417a-9766-a7c26a015045	FINDUCTIVE LTD.	946573ce-5155-4405-a246-65b6a3d90a20
-8a709130-77b1-4ac2-b480-7693015b746c	FINTECOM SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-af4ef4a5-34b4-4ede-9f3d-b4617c04770e	FINXP LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-70075c84-8df8-483d-877e-0e7d2bb18cb2	FIRST FINANCIAL BROKERAGE HOUSE LTD.	f2a8c291-89bb-4079-8618-980bebe0d294
-6d28abea-ca1f-4720-8027-8c3a97d3c650	FIRST INTERNATIONAL TRADERS DOM MAKLERSKI SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b8cdbae7-6e16-48dd-95cc-f9963ace4ca5	FIRST INVESTMENT BANK-ALBANIA SH.A	a9025df0-6675-45e2-8354-5d1e073a8579
-5051310f-3799-4ec0-843e-7fb2857b8d5c	FIRST INVESTMENT BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-4bc576c8-3f74-4533-8a4d-b66f4d45cbb8	FITCH CHILE CLASIFICADORA DE REISGO LTD	3f4cdf53-1468-4cff-9951-f55b22587ce4
-90eeb31a-8b10-44b3-98f1-c205213adf4d	FITCH POLSKA SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5a514657-264b-496f-ac5a-f1abaabd1894	FITCH URUGUAY CALIFICADORA RIESGO S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-5ae085ec-889e-4890-a6a0-a4b2118dc913	FOCAL POINT INVESTMENTS JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-ae7267ed-2f51-4fd0-9bdd-52ca0b5720bb	GALPERIN SOLUTION LIMITED LIABILITY COMPANY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c3077843-f2b9-4fcf-9ca0-66f563d37c82	GARANTI BANK, MALTA BRANCH	946573ce-5155-4405-a246-65b6a3d90a20
-be1ac731-0eb0-43cc-ab21-62a77851ce6a	GBM CORREDORES DE BOLSA LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-e7e0bcbe-5199-46ae-882f-2e7a38496a06	GIELDA PAPIEROW WARTOSCIOWYCH W WARSZAWIE SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4d4c29fe-2a23-46f8-be62-e8207ef2393a	GLOBAL MARKETS	f2a8c291-89bb-4079-8618-980bebe0d294
-21be3530-5a6e-4fcb-b966-78f793efae8b	GLOBAL SHARES EXECUTION SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-e36c1d13-8c14-4d80-bf9d-0db3a7d749ef	GLOBALCAPITAL FINANCIAL MANAGEMENT LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-b69efdac-bc2b-48e0-b8cc-b059deb71aac	GOLDEN LION CAPITAL	f2a8c291-89bb-4079-8618-980bebe0d294
-38d902a3-c0ef-4fd7-b1bb-cb0a6b213528	GOLDMAN SACHS CHILE LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-4b053a80-e76c-4273-aa8f-f6ee65d6b8e9	GOLDMAN SACHS INTERNATIONAL WARSAW BRANCH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-624c2d39-2d84-4610-bbef-69b8265c9ffd	GOLDMAN SACHS POLAND SERVICES SPOLKA Z OGRANICZONA ODPOWIEDZ	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4aed0303-2703-43af-8721-5ecf6134f76f	GRAND CAPITAL LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-c170f90a-4a2f-4900-a2ab-fd66e37fa96f	GROWTH INVESTMENTS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-638d38ae-33c6-46a4-9c02-2c599fe1a60d	GUAVAPAY POLAND SPOLKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-75a9951f-523c-4972-99e1-a398ebe619fd	HAITONG BANK S.A., SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1604bc47-c02c-41d8-ab66-94324bf04564	HEKA FUNDS SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-f8fda268-0790-43b4-9804-d954209562fe	HOGG CAPITAL INVESTMENTS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-b8d2014a-8807-46a0-83bd-0c4db7359f2b	HSBC BANK (CHILE)	3f4cdf53-1468-4cff-9951-f55b22587ce4
-b7792c26-60ce-4e4e-85ac-a8ef6fc8f4e5	HSBC BANK (URUGUAY) S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-463fec50-d84c-4309-abaf-078fc671d064	HSBC BANK MALTA P.L.C.	946573ce-5155-4405-a246-65b6a3d90a20
-48dd41fc-a63a-4c05-a0f5-65285c05c12d	HSBC CONTINENTAL EUROPE (SA) ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-cfd06003-1a40-4e63-9c09-c50d4545cd15	HSBC GLOBAL ASSET MANAGEMENT (MALTA) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-a27ed204-2599-4f55-a553-ade9d42100b3	ICARD AD	f2a8c291-89bb-4079-8618-980bebe0d294
-71e0b7c2-4e8a-45a7-b6e6-603395542af6	ICBC POLAND BRANCH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8d8016d6-86b5-465c-b137-a0eb2b657276	IDM SPOLKA AKCYJNA W UPADLOSCI UKLADOWEJ	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5e90f040-01e5-4c89-8d9f-cc92ec158388	IGORIA TRADE S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9b50f167-d9f9-40d2-bf24-ec3e96b23a75	IIG BANK (MALTA) LTD	946573ce-5155-4405-a246-65b6a3d90a20
-8fbcb753-1aeb-4e9b-aff6-4231b0bf13e1	IMTRADEX INTERNATIONAL N.V.	48c73c0d-d359-4845-a1f6-8cbf182c9917
-9d772d8b-5ca9-4301-abdf-f42c649d0522	INC RATING SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-bc4233be-6c7b-4fe6-b301-4c2c6e21e73f	INDEXO BANKA AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-8cfaa75c-2f7e-4db1-9bf3-54ed4d6de93d	INDUMEX S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-7218abf5-5ce9-457a-a8ce-6a19d4a8f018	ING BANK HIPOTECZNY S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-95d123a1-93c1-49dd-a842-7a8bb54be5ce	ING BANK N.V. SOFIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-1620593c-6035-41cb-a507-493c87264e22	ING BANK SLASKI SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8860bb41-b3e0-4042-aec8-d8e6a6059ca1	INSIGNIA CARDS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-cd9d56a7-22c8-4caa-997a-106ba867c0f2	INTEGER GROUP SERVICES SPOLKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1d48e084-9188-41cf-8152-bb9d1500c0c4	INTEGRACION A.F.A.P. S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-3872fa15-4d83-4a5c-a0bc-d35aadd7d71d	INTERBANK ARUBA NV	48c73c0d-d359-4845-a1f6-8cbf182c9917
-83b9f5ec-0bca-43cc-a2a5-4b2e71db6ef3	INTERBANK FINANCIAL SERVICES SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-dba4b9dc-3af6-44fb-a202-85c0e0e231fb	INTERNATIONAL ASSET BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-73494a3d-6c19-4de7-9d25-ead9e3ddbd00	INTESA SANPAOLO BANK ALBANIA SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-21be23f7-842e-4c03-9bbc-aae85ba53eb7	INTESA SANPAOLO SPA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-a0ba05ad-8817-433b-a507-ab56531c7cfa	INVEST CAPITAL JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-182cc296-933e-4928-9bc0-973649de3b5c	INVEST FUND MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-3ceaa5f8-9d28-4249-b0b3-788e6d460187	INVESTBANK JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-e003fc97-3f12-4d81-b527-1d25a1a8b0c1	IP FAVORIT CLS	f2a8c291-89bb-4079-8618-980bebe0d294
-adf43859-6908-4c2f-8183-c65560af1002	IP INTERCAPITAL MARKETS AD	f2a8c291-89bb-4079-8618-980bebe0d294
-852b3d79-08f2-42c4-b74b-ba2ff0324d13	IPAGOO LLP, SPOLKA PARTNERSKA ODDZIAL	267c8e25-7b4f-458e-b91f-3d56352fc23c
-283a0069-ab8c-4643-95b7-661581439c0d	IPOPEMA SECURITIES S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-4f341c38-5e17-4431-abf3-960963ba0a3f	ITAU CHILE ADMINISTRADORA GENERAL DE FONDOS S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-89b670a3-71e2-4da1-9ddf-e9b523d47d90	ITAU CORPBANCA CORREDORES DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-a09bc7dc-3227-468b-9ba2-e62adaedac4e	IXARIS FINANCIAL SERVICES MALTA LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-387782f2-fb14-4c5e-8f97-71a139ba551d	IZOLA BANK PLC	946573ce-5155-4405-a246-65b6a3d90a20
-91153ccc-8290-49be-a794-29d44bac9533	J.P. MORGAN CORREDORES DE BOLSA SPA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-924faac6-0e09-4d7b-a3a8-d86fc52ce9bc	JESMOND MIZZI FINANCIAL ADVISORS LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-13403250-1055-4c40-85ff-19e9e24db349	JESMOND MIZZI FINANCIAL SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-8d7be73d-f277-4e28-bd0d-dc1ab83e745d	JOOL PAY SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-6da719ac-60b7-48ec-9903-e66d4497e419	JPMORGAN CHASE BANK, N.A., SANTIAGO BRANCH	3f4cdf53-1468-4cff-9951-f55b22587ce4
-f8e9f760-6b7d-4bb3-b2fa-c400563a8a43	JSC CITADELE BANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-f7ce1efe-04d4-4c7d-8ef0-3805f50d2739	KAIZEN GAMING INTERNATIONAL LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-63247028-d910-4fa3-9513-7bda951543f1	KAROLL	f2a8c291-89bb-4079-8618-980bebe0d294
-c47b2e3d-2018-4dd8-a9e6-ccffcd56ca4b	KAROLL CAPITAL MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-4a7c6cb9-718d-433c-87e8-8424cb693048	KDPW CCP	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6773a18d-7d8b-406a-b388-9e0a01d54a01	KDPW S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-28657009-5e88-4c45-be25-99020012213e	KOMPANIA PIWOWARSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-37dcdaa1-7875-4640-877e-67ca0ff33be5	KOOPERATIVA KRAJAIZDEVU SABIEDRIBA ALFABETA	c90259ac-8f05-467b-bdad-8e0549913bcc
-b3fe929e-9031-40cc-a028-fbb1f03772b0	KRAJOWA IZBA ROZLICZENIOWA S.A. (KIR S.A.)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-73f2b215-acca-471d-831f-4827f378e140	KRAJOWA SPOLDZIELCZA KASA OSZCZEDNOSCIOWO-KREDYTOWA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e5a5e820-0e81-4a9e-883f-f5e591f6e29e	KRAJOWY DEPOZYT PAPIEROW WARTOSCIOWYCH SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8e9ff8d5-7a2a-4c1f-af24-c1242e7e6ecb	KRAKOWSKI BANK SPOLDZIELCZY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e60ecfbc-38df-4926-8f70-7eda3749aef6	KURPIOWSKI BANK SPOLDZIELCZY W MYSZYNCU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ca7d63ff-9bee-4065-b3ed-85687ac985fa	LARRAIN VIAL	3f4cdf53-1468-4cff-9951-f55b22587ce4
-829ca581-c3da-411a-8b1a-1aed2d2ec9db	LATCAM PAGOS INTERNACIONALES S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-92c56642-0a66-4349-a1db-3978b7d83197	LATIN SECURITIES SA AGENTE DE VALORES	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-7b25d17c-916b-4990-8023-c87a5a7adf5c	LATVIJAS ARODBIEDRIBU KRAJAIZDEVU SABIEDRIBA	c90259ac-8f05-467b-bdad-8e0549913bcc
-45139756-a593-4265-8e2b-4d01e59f3420	LCL (LE CREDIT LYONNAIS) MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-7f5610d8-c107-418c-a37a-7398ca0d6421	LENNO GLOBAL ADVISORY JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-cdce7b1a-159c-4306-b560-3918839c9462	LIDION BANK PLC	946573ce-5155-4405-a246-65b6a3d90a20
-41c46f38-56a2-43cb-acd8-a32f3cdacb7f	LOMBARD BANK MALTA PLC	946573ce-5155-4405-a246-65b6a3d90a20
-ca77569a-0503-4b61-970f-0971cbd3777b	LTFJA KKS JURNIEKU FORUMS	c90259ac-8f05-467b-bdad-8e0549913bcc
-557220d2-85b4-4a21-a00c-c0b84c189531	LTZ INVESTMENTS LTD	f2a8c291-89bb-4079-8618-980bebe0d294
-03235c9a-2906-465f-a9db-097bf6db4185	LUMINOR BANK AS LATVIAN BRANCH	c90259ac-8f05-467b-bdad-8e0549913bcc
-006f3d6e-1bc1-4625-9681-745f476a52d4	M.Z. INVESTMENT SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-c796dd3f-a302-4515-9126-deee8997bd38	MALTA STOCK EXCHANGE	946573ce-5155-4405-a246-65b6a3d90a20
-49f18ae8-3536-4c6d-81ed-fe89ec324ad6	MANAGEMENT COMPANY DSK ASSET MANAGEMENT AD	f2a8c291-89bb-4079-8618-980bebe0d294
-14db5adf-29c0-4b6a-b253-ba773753edb6	MBANK HIPOTECZNY SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-44223798-c79b-4484-8ff2-227af6d1a44f	MBANK S.A. (FORMERLY BRE BANK S.A.)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6c2177ad-412a-4ff4-b6d6-fb1a754ecd08	MBANK SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7f7f0e87-e16c-4b25-9682-d84c06fcc80a	MBI CORREDORES DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-3005fb5e-9f32-4901-a30a-ef8d9648b7ea	MEDIRECT BANK (MALTA) PLC	946573ce-5155-4405-a246-65b6a3d90a20
-8477cd38-597c-4322-8555-d7bd35559864	MERCEDES-BENZ BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-540bb788-17f9-4959-9ac9-6a0af88f173b	MERCURIUS DOM MAKLERSKI SP. Z.O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b4661093-1c6e-4b55-9bd7-51ccf21872c9	MERKANTI BANK LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-675a3cb8-5cd4-4d73-8af6-9deca1a7c80e	MERRILL LYNCH CORREDORES DE BOLSA S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-03ad12e8-36a0-40cb-a245-a28b87ea6cc8	MICHAEL GRECH FINANCIAL INVESTMENT SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-5a3a2240-3894-449f-85c3-c33dc64047fd	MICHAEL STROM DOM MAKLERSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-68aa7784-d368-42a2-948c-3e2a84303692	MIFINITY MALTA LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-3cf0fb8d-0c11-41fd-b938-183e53b762ad	MILLENNIUM DOM MAKLERSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e69436d1-8ffa-4203-b1f2-4943fadcd9d6	MINISTERIO DE ECONOMIA Y FINANZAS	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-2d7c5a3a-0cff-460b-9470-c31b969cae22	MINISTRY OF FINANCE OF ALBANIA	a9025df0-6675-45e2-8354-5d1e073a8579
-bde7fb8c-2b7f-4888-9b5d-f359fdf06892	MINTOS MARKETPLACE AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-27d49973-cd03-4efd-861f-3fab8c818624	MISTRAL PAY LTD	946573ce-5155-4405-a246-65b6a3d90a20
-35ac3f72-4204-4a76-8fd4-9c3233adee46	MK BROKERS AD	f2a8c291-89bb-4079-8618-980bebe0d294
-399fbafc-61cf-48d2-ac75-67c5167e94f6	MONACO SPORTS AND MANAGEMENT S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-9422daaa-6c6f-429f-9edc-1baaaa381858	MONEDA CORREDORES DE BOLSA DE BOLSA LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-f30b8f61-97e5-496b-a3ee-a5becac153ce	MONEDA S.A. ADMINISTRADORA GENERAL DE FONDOS	3f4cdf53-1468-4cff-9951-f55b22587ce4
-6d11188a-9a10-4d1d-9f66-bb8e4b3bc959	MONEY EXPRESS SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-4987a579-104d-441f-b2d8-842c612e5b60	MONEYBASE	946573ce-5155-4405-a246-65b6a3d90a20
-4ba81de1-98c0-4b2e-a095-26dab61a148a	MONEZIUM SP.Z.O.O	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c0054e3f-4fd6-4700-8f19-e42992559b91	MTACC LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-b9adc4d2-f9e9-49d0-b95c-cd5dc7ad3109	MULTITUDE BANK P.L.C.	946573ce-5155-4405-a246-65b6a3d90a20
-0104f463-97e6-4400-97ca-f62ae769fe29	MUNICIPAL BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-9d5b894a-7e22-4a33-b9a5-7484162e1c4c	MUNICIPAL BANK ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-b5960ac8-82fb-4c4e-bfc9-984ee7952f0f	MYFIN EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-b7ef5266-94eb-43a6-a06f-931de709b85d	NARODOWY BANK POLSKI	267c8e25-7b4f-458e-b91f-3d56352fc23c
-277ab044-f026-473a-99f5-a384a9829c5e	NASDAQ CSD SE	c90259ac-8f05-467b-bdad-8e0549913bcc
-f99e7e57-cfde-4584-a649-da1916020593	NATIONAL DEPOSITORY FOR SECURITIES (KDPW SA)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c8f2af6e-375b-4ecf-993b-03c2a87dbe02	NEST BANK S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-213c7e84-986c-4210-8c19-05e2ae7ee612	NEVASA S.A CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8bc9ee20-003f-4b64-9b93-8afdd7f17c86	NEXTMARKETS TRADING LTD	946573ce-5155-4405-a246-65b6a3d90a20
-79e73ff5-e2f6-4326-965b-1d558edf7301	NIXGROUP	c90259ac-8f05-467b-bdad-8e0549913bcc
-41aa94b5-0130-42fd-a3b1-ffff9dff80d2	NN INVESTMENT PARTNERS TOWARZYSTWO FUNDUSZY INWESTYCYJNYCH SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-28ce7da2-bb19-4bd4-9996-1fb4079cb3c5	NOBLE SECURITIES SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5aa34a1b-a659-4e57-92e0-9f2ce92d389a	NOVUM BANK LTD	946573ce-5155-4405-a246-65b6a3d90a20
-ef370d97-d36b-4362-8077-681519e78458	NWAI DOM MAKLERSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-012889f6-377e-4acc-ac56-2eee283cb2a9	OGRES KOMERCBANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-b0976647-ade4-4169-aa8d-d884c0f83271	OP CORPORATE BANK PLC LATVIA BRANCH	c90259ac-8f05-467b-bdad-8e0549913bcc
-cb4b1c12-4a3a-4ec4-808a-3b132363fc6f	OPENPAYD FINANCIAL SERVICES MALTA LTD	946573ce-5155-4405-a246-65b6a3d90a20
-9b66a7f1-e704-4b0e-9dfa-34ea48726c3f	OPERA DOM MAKLERSKI SPOWKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-58d31b72-9d12-427d-8900-a07c28e07d6c	PACIFIC MANAGEMENT SAM	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-52e5ed01-67de-449b-8f86-718fdd6cb77b	PAPAYA LTD	946573ce-5155-4405-a246-65b6a3d90a20
-ed3661ab-4468-4790-bfbd-5f6d92892a3e	PAYBYPAGO	946573ce-5155-4405-a246-65b6a3d90a20
-c539632a-55bc-4763-ba60-202125d9d8d4	PAYMAN GROUP OOD	f2a8c291-89bb-4079-8618-980bebe0d294
-8052afda-4889-4001-8caa-be9388c84b42	PAYNETICS AD	f2a8c291-89bb-4079-8618-980bebe0d294
-ee9e18d8-1b8f-4ec0-a810-84af54f02778	PAYSERA ALBANIA	a9025df0-6675-45e2-8354-5d1e073a8579
-24dfa394-39e5-45a5-9485-9cc760f4f025	PAYSERA LT	c90259ac-8f05-467b-bdad-8e0549913bcc
-362da1aa-0859-46e2-a967-4337e5dbb535	PDK FINANCIAL SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-3a4e8a79-41c2-426f-8347-6899f507fe62	PEKAO BANK HIPOTECZNY SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d39c9d8d-9b84-4186-b89c-54a5c60fe0b3	PEKAO INVESTMENT BANKING S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-3851be17-47da-4824-bdbb-874eff335024	PEKAO TFI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-6d3302b0-beae-4d97-8ad3-034bb25e2dd5	PEKAO TOWARZYSTWO FUNDUSZY  INWESTYCYJNYCH SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-7c0215a5-f92d-460a-b57b-fe998dacee04	PERSYSTEMCY SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-143506b0-0840-4b09-a6cc-f2508138e7fc	PHOENIX PAYMENTS LTD	946573ce-5155-4405-a246-65b6a3d90a20
-78589823-5ae1-4020-b351-ee4e16787223	PKO BANK HIPOTECZNY SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	PKO BANK POLSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-73976ee2-77d7-4800-97d3-1f6738dd760e	PKO TOWARZYSTWO FUNDUSZY INWESTYCYJNYCH SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-9826f303-230e-49fa-b46b-aba08132a373	PLUS BANK S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ffdc3a2c-61fe-439d-8c2b-83c60da095ff	POCZTA POLSKA SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-cf0c7eb7-26f2-45b1-a2b9-304727983de6	POCZTOWY BANK SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-2050518c-c800-462f-bba9-1716f7c3bb39	PODKARPACKI BANK SPOLDZIELCZY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-fe6ad914-061e-4836-a38a-bcc98d97df05	POLISH-AMERICAN MORTGAGE BANK INC.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8565c07b-8bfd-4b68-9d75-4ec79b2358b3	POLSKI STANDARD PLATNOSCI SP. Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b868006e-5a36-4872-9803-37d4754da7fe	POLUDNIOWO-MAZOWIECKI BS W JEDLINSKU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5bd20ac5-ac68-4403-b4f2-b70b19bd72e2	POSTA SHQIPTARE SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-bcf2a64d-e30d-4a93-b3ab-9e838637e27f	POWIATOWY BANK SPOLDZIELCZY W KEDZIERZYNIE-KOZLU	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8ac9ca3b-79d3-4838-9352-2166fbf7485f	POWIATOWY BANK SPOLDZIELCZY W TOMASZOWIE MAZOWIECKIM	267c8e25-7b4f-458e-b91f-3d56352fc23c
-fe19f8ba-ed27-40d7-8ff7-20958315e9fb	POWSZECHNY ZAKLAD UBEZPIECZEN NA ZYCIE SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-ee29832c-d038-40ee-8ac7-7e30b5daa760	POWSZECHNY ZAKLAD UBEZPIECZEN SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-04504633-f3ed-4072-92a8-d4b36d73088d	PROCREDIT BANK (BULGARIA) EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-c52c1da7-0712-4ee2-a59a-19309acb00f6	PROCREDIT BANK SH. A. ALBANIA (FORMERLY FEFAD BANK)	a9025df0-6675-45e2-8354-5d1e073a8579
-fd3907f1-1af7-4d91-b189-69f0ded4f6f1	PROSERVICE FINTECO SP Z O.O.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-bda993c0-e008-4f13-8665-c023c9cbee40	PROVINCIA CASA FINANCIERA	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-5ed7c4ec-56a6-4869-891e-8165059df5e0	PZU ASSET MANAGEMET SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-99577410-f04f-48d8-bdec-0474e23e574b	RAIFFEISEN ASSET MANAGEMENT (BULGARIA) EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-cd8140b7-452e-4a33-b1ef-7cd30dea9b76	RAIFFEISEN BANK SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-1d34bc8c-943e-4aa7-b99d-9e1b4074c334	RAIFFEISEN MALTA BANK PLC	946573ce-5155-4405-a246-65b6a3d90a20
-f9090a13-2ba0-45ee-9d4e-531fd64ee946	RBC ROYAL BANK (ARUBA) N.V. (FORMERLY RBTT BANK ARUBA N.V.)	48c73c0d-d359-4845-a1f6-8cbf182c9917
-c219f282-bb8a-4bf8-868a-2eab3290236e	REAL FINANCE ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-ccbb2bf5-050b-4432-bffe-1193b3a395d4	REAL FINANCE JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-cb9f6470-9b94-4d8f-8d92-a84f02030182	REDBANC S.A	3f4cdf53-1468-4cff-9951-f55b22587ce4
-17001c7d-6c3e-427c-924e-8a825e863f8d	REDHEDGE SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-b4025598-fcda-4630-95b0-ef9c23ece2ef	REGIONALA INVESTICIJU BANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-d911c403-e70c-4187-853d-04fa4a891042	REPLICA SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-3ebff7a9-76da-4c32-9135-c083bad59924	REPUBLICA A.F.A.P. S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-86a728f3-f29e-4b4c-9ae2-53ea3fe16631	RIETUMU BANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-ad723942-0c0e-465d-974f-5b5344a075c0	RIZZO FARRUGIA AND CO.(STOCKBROKERS) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-d31b06b9-b5aa-4dad-a36f-9340a07afda4	RMB MANAGEMENT LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-8230ad8d-233c-4194-b5f7-42fe780933f5	ROTHELAND S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-2af9d70b-0b98-4f12-9395-11d3074e8120	ROTHSCHILD AND CO WEALTH MANAGEMENT MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-00aa65b6-828d-46a9-ad8b-2695b32cbd27	SAMSUNG ELECTRONICS BALTICS	c90259ac-8f05-467b-bdad-8e0549913bcc
-438d7143-adcd-4616-a276-04a4b8747822	SAMSUNG ELECTRONICS CHILE LIMITADA (LTDA)	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8371b744-1ff7-4410-b326-d12151f38b0b	SAMSUNG ELECTRONICS POLSKA SPOLKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-aac67f61-13dc-46ac-bd33-80039f4ab6e2	SANTANDER BANK POLSKA S.A. (FORMERLY BANK ZACHODNI WBK S.A.)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-d4ca5d18-fdac-412c-b000-3231a32e58c6	SANTANDER CONSUMER BANK SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-2ec792a9-bddd-4f33-9120-c76405690711	SANTANDER CONSUMER BANK SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-cec0f720-71df-47ba-9223-7b45414d2447	SANTANDER INVESTMENT S.A. CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-8435c03b-cfaa-41bf-9ae3-671a661277a1	SANTANDER SECURITIES S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5686085f-fb72-4a1e-bad5-374505cabae8	SCOTIA AZUL CORREDORES DE BOLSA LIMITADA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-a7ff30c4-6789-404e-b12a-fc6c0fcc767f	SCOTIABANK CHILE	3f4cdf53-1468-4cff-9951-f55b22587ce4
-ec061a6c-320e-4dce-80cf-53d4c92675a6	SCOTIABANK URUGUAY S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-afc01ce2-5be8-4e41-a5af-87e54d4c4ce2	SEB BANKA	c90259ac-8f05-467b-bdad-8e0549913bcc
-4b495125-f025-4242-b446-db97f9d7272e	SECURITAS	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-664ee641-bf01-4e99-849b-58aac028db73	SECUS ASSET MANAGEMENT SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-89041c13-7f26-42e4-96b7-01176b84170b	SELECT ASSET MANAGMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-7a73ca84-492e-438f-b132-165ebd94850c	SGB-BANK S.A. (FORMERLY GOSPODARCZY BANK WIELKOPOLSKI S.A.)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8655cf6a-c175-4f67-bb50-f9c5e867ee0a	SIA 'SEMFOPAY'	c90259ac-8f05-467b-bdad-8e0549913bcc
-33bdf837-5d65-4e0d-bc29-0ef118d9689d	SIA 'XPATE'	c90259ac-8f05-467b-bdad-8e0549913bcc
-762ecfc7-e209-4e1e-8732-66a10d5cd127	SIA MONETIZATOR	c90259ac-8f05-467b-bdad-8e0549913bcc
-a9d347f1-002e-4bdb-8ad4-5e2de92cc6ce	SIA PAYBANCO	c90259ac-8f05-467b-bdad-8e0549913bcc
-0b3b6348-68ef-4634-9f07-6390fd1bc85a	SIA TRANSACT PRO	c90259ac-8f05-467b-bdad-8e0549913bcc
-bbb4f585-7dbc-4588-b4e0-2b69b7abfb3a	SIGNET BANK AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-ec6e35df-a43a-4bf1-af5a-577189940909	SISTEMA DE LIQUIDACION BRUTA EN TIEMPO REAL(LBTR)	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-6ad218e2-1413-4051-81bd-7ec7ac76bb8c	SKANDINAVISKA ENSKILDA BANKEN AB, SPOLKA AKCYJNA, ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-916a9320-e941-4cc8-a49f-753655560b73	SKY ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-ebe881d6-862e-4289-bc68-4088c4b01a0d	SMART FUND ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-b96aca7d-a685-4df8-9091-a236be585e0f	SOCIETE ANONYME DES BAINS DE MER ET DU CERCLE DES ETRANGERS A MONACO	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-5486aa50-73e9-4a53-bed5-18bf5fc8d19a	SOCIETE GENERALE (FORMERLY SOCIETE DE BANQUE MONACO)	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-64a571a5-4040-4d48-a332-f8e49ea7a85b	SOCIETE GENERALE MONTE CARLO (MONACO)	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-0e4af169-4661-4905-9f76-b3e3f38217a6	SOCIETE GENERALE PRIVATE BANKING (MONACO)	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-4f3f0bea-a6bd-4d45-b2ff-c4ae1abd6fa1	SOCIETE GENERALE SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1aedc438-f6c1-494f-9450-248759e1588b	SOCIETE MARSEILLAISE DE CREDIT	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-4e75e8e7-8899-40eb-9d8f-05d116e3704a	SOFIA INTERNATIONAL SECURITIES AD	f2a8c291-89bb-4079-8618-980bebe0d294
-072154a8-ffb0-4add-9639-6c6462daa537	SOMONI FINANCIAL BROKERAGE LTD.	f2a8c291-89bb-4079-8618-980bebe0d294
-7e057a56-7b7e-4c07-88a4-1a1416a074bc	SPARKASSE BANK MALTA PLC	946573ce-5155-4405-a246-65b6a3d90a20
-3ede7622-44c9-4d48-9a58-8b44b77df067	STANDARD INVESTMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-2cf8673e-d516-419a-a16e-c7b91231e0c9	STATE JOINT STOCK COMPANY LATVIJAS PASTS	c90259ac-8f05-467b-bdad-8e0549913bcc
-8898a6dd-8dba-4000-a064-4cd0765bf0d6	STEITS, SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-a753a25e-bf21-4739-8670-19e848b3d43d	STOCK POLSKA SPOLKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-52cddc46-7c7c-48da-bd17-6244d5ee9fce	STRATEGY ASSET MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-1618da58-728a-4314-92d9-910d140c084d	SUPREMA SECURITIES S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-8ed1dadf-2e75-49f4-8157-b1ac0dedb986	SVENSKA HANDELSBANKEN AB SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c760c0d6-6f03-4904-8a79-086949de2baa	SWEDBANK AS	c90259ac-8f05-467b-bdad-8e0549913bcc
-1e2f192e-8c55-4a7f-8e19-71c1f3d9d6f6	SYSPAY LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-049408f6-527d-41bb-98c5-2cdc293c8181	SYSTEM PAY SERVICES (MALTA) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-f3cc45e6-b1c3-4046-98fe-08c5586b1c1e	T.C. ZIRAAT BANKASI - SOFIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-9b1e41bb-baee-47d4-95ff-5f8bccfeb8a8	TANNER CORREDORES DE BOLSA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-9d9bbb58-adc3-4148-8d43-89a618a8520f	TANNER SERVICIOS FINANCIEROS S.A.	3f4cdf53-1468-4cff-9951-f55b22587ce4
-145e411d-34c6-4580-9286-2adf27703ec1	TBI ASSET MANAGEMENT EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-29993a9b-1b3a-49d9-9744-cc8cf8d5b618	TBI BANK EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-f5600e69-31af-4ec2-a953-e1bf312cf36d	TBI INVEST EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-db8751ae-302d-4853-8293-98f077b535b2	TENEN PAYMENTS JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-af24ca3b-3fd3-4c0f-a9bb-dfc10980d3f7	TESORERIA GENERAL DE LA REPUBLICA	3f4cdf53-1468-4cff-9951-f55b22587ce4
-975d8961-3e82-41d1-8520-7ed4ee7285b8	TEXIM ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-25d336c0-b882-4d0b-9e3b-cad66fead189	TEXIM BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-70b8e236-22f7-473e-990e-aa81d77373a2	TF BANK AB LATVIJAS FILIALE	c90259ac-8f05-467b-bdad-8e0549913bcc
-2218646f-1558-4144-8b1d-04d95b3f3274	TGA FUNDS SICAV PLC	946573ce-5155-4405-a246-65b6a3d90a20
-a5b69c56-92ef-4c0e-a1aa-1323249524f7	THE POLISH REGISTRY OF ALLOWANCES	267c8e25-7b4f-458e-b91f-3d56352fc23c
-84bed634-1497-42b1-80fc-eb25381e43c8	THRACIAN INVEST INC.	f2a8c291-89bb-4079-8618-980bebe0d294
-27e98558-2a96-414d-b4f4-307935e1910f	TIMBERLAND INVEST LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-28e5ae90-aeb1-4457-99d2-5024cf59531d	TIRANA BANK	a9025df0-6675-45e2-8354-5d1e073a8579
-1e54bd07-a5f3-41f1-a575-bd03ab143768	TOKUDA BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-be904e89-524a-4a9e-bdc3-a103ce93a0c0	TOWARZYSTWO FUNDUSZY INWESTYCYJNYCH PZU SA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-3d1a7079-a006-4554-8513-1a07b9053c73	TOWARZYSTWO UBEZPIECZEN I REASEKURACJI WARTA SPOLKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b377d2d4-9f7f-4287-92ad-656d1f0ee0f2	TOYOTA BANK POLSKA S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-0dbd6284-883e-4a61-9297-3b8c55dc789d	TPCG FINANCIAL SERVICES AGENTE DE VALORES S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-6310fe9f-3729-45a3-81e3-67e3732d1e4e	TRADEXEC (TEX) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-741cdcf7-7fdb-455a-83ac-92f663bb97ab	TRADING 212	f2a8c291-89bb-4079-8618-980bebe0d294
-e3c852cf-68ad-49ee-8770-90b50609a9cb	TRANSACT EUROPE EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-1507db49-81fa-4501-985b-bb1d9dfa8900	TRANSACT PAYMENTS MALTA LTD	946573ce-5155-4405-a246-65b6a3d90a20
-7d35bf7d-19f4-4ac7-8014-a3d2b9346720	TRANSCARD FINANCIAL SERVICES	f2a8c291-89bb-4079-8618-980bebe0d294
-8b9f9b20-046c-46f6-8c64-421dd888b346	TREASURY OF THE REPUBLIC OF LATVIA, THE	c90259ac-8f05-467b-bdad-8e0549913bcc
-b2ca9d66-202f-4c0a-8c23-c7478eea200d	TREND ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-757f1a30-2a64-43ae-97bf-9d934f7851ed	TRIGON DOM MAKLERSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-1b76ec36-dd9a-436f-bd67-f58cec8888db	TRIVE FINANCIAL SERVICES MALTA LTD	946573ce-5155-4405-a246-65b6a3d90a20
-de40bbaa-6d44-40e9-936e-e331bff977b1	TRUEVO PAYMENTS LTD	946573ce-5155-4405-a246-65b6a3d90a20
-f260f1c1-6af7-455f-aa0c-0a5634c81b8e	TRUMIA LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-11a7eb94-7a79-44a3-a115-12bd1c456b8c	TRUST PAYMENTS (MALTA) LTD	946573ce-5155-4405-a246-65b6a3d90a20
-b056b991-02e9-4ff4-9a71-0c43d6611dba	UBB ASSET MANAGEMENT	f2a8c291-89bb-4079-8618-980bebe0d294
-5713e1f3-6fd4-4017-b8ab-99dd225b75dc	UBS (MONACO) S.A.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-60c3a704-f1b8-4e40-ae11-c5f179e76685	UG MARKET FUND MANAGEMENT JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-0c734039-fbb1-48e1-b1b3-0e3fc2998d6c	UG MARKET JSC	f2a8c291-89bb-4079-8618-980bebe0d294
-3bd72790-be4f-4ab4-beb5-b239a48f4b1a	UNICREDIT BULBANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-0b00ea29-7905-4582-8a7f-15aa8165ce51	UNIFIEDPOST PAYMENTS JOINT STOCK COMPANY, BRANCH IN POLAND	267c8e25-7b4f-458e-b91f-3d56352fc23c
-dafe12af-1adf-4efe-8fa3-ed7a78aba77b	UNIFIEDPOST PAYMENTS SA FILIALE LATVIJA	c90259ac-8f05-467b-bdad-8e0549913bcc
-558c904a-1688-4c22-ac1d-872bef4faa2a	UNION-CAPITAL A.F.A.P.S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-ef830bc7-ae9d-4115-a8a1-bbca4f5605f7	UNION BANCAIRE PRIVEE, UBP SA	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-50fe1e45-f399-4b77-80e3-8c18ddac7afb	UNION BANK SH.A.	a9025df0-6675-45e2-8354-5d1e073a8579
-6c52a7cf-eb14-415e-97a7-52a7d46b2651	UNIONGOLDENPAY LTD	946573ce-5155-4405-a246-65b6a3d90a20
-5724d37c-f583-418e-af9b-a1e81d855237	UNITED BANK OF ALBANIA SH.A	a9025df0-6675-45e2-8354-5d1e073a8579
-80e9c166-c674-46b4-813a-cdceac1597e9	UNITED BULGARIAN BANK AD	f2a8c291-89bb-4079-8618-980bebe0d294
-609b2655-6cdc-4b3e-980f-22b4d850e390	UNITED BULGARIAN BANK AD (FORMER KBC BANK BULGARIAEAD)	f2a8c291-89bb-4079-8618-980bebe0d294
-1c47a282-ed9f-4a0d-8454-b017ab6c7d3a	UP TREND	f2a8c291-89bb-4079-8618-980bebe0d294
-bf62bd27-419d-4033-be72-a8828d0d112d	VARCHEV FINANCE EOOD	f2a8c291-89bb-4079-8618-980bebe0d294
-206af6a0-bce5-4a82-805f-8d0d33c39cc5	VARCHEV MANAGING COMPANY EAD	f2a8c291-89bb-4079-8618-980bebe0d294
-5b32cafa-1782-4573-8d41-a4bc14963c69	VARENGOLD BANK AG, SOFIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-67de7e80-d921-4d3e-85b8-ca05c5831f2b	VARLIX S.A.	76b38b0b-2d80-4f3f-83d0-fbe187e30b4c
-8ad1cf95-6e27-4190-a91d-846376431637	VELOBANK S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-53f72136-c221-41d6-a1fb-d19ba977bcb0	VENUS MANAGEMENT COMPANY S.A.M.	092979aa-e2b4-43a4-b5a1-5824d067ec6e
-7739648b-492f-4860-ab0a-4fd9542f0c72	VESTOR DOM MAKLERSKI S.A.	267c8e25-7b4f-458e-b91f-3d56352fc23c
-5758a2c7-d4a7-4df8-8bdc-f7892067f878	VIENNA LIFE TOWARZYSTWO UBEZPIECZEN NA ZYCIE S.A. VIENNA INSURANCE GROUP	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e9bbb815-6e45-41d7-b778-382043296b4c	VIVA PAYMENT SERVICES S.A. BULGARIA BRANCH	f2a8c291-89bb-4079-8618-980bebe0d294
-2e467d64-6f26-4343-bf27-42191a28c98d	VIVA PAYMENT SERVICES SINGLE MEMBER S.A. MALTA BRANCH	946573ce-5155-4405-a246-65b6a3d90a20
-e28ca672-c7db-4e7a-b75f-c52f2155cbcf	VIVA PAYMENT SERVICES SPOLKA AKCYJNA ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-e638fe94-5565-4c9e-9e32-6a6232ea6694	VOLKSWAGEN BANK GMBH SP.Z O.O. ODDZIAL W POLSCE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-caf7d260-511f-4178-aa48-655be2df8855	VOLKSWAGEN POZNAN SPOLKA Z OGRANICZONA ODPOWIEDZIALNOSCIA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-0438a9fb-9ad9-4214-9df9-a83dfd337ae2	W AND J COPPINI AND CO	946573ce-5155-4405-a246-65b6a3d90a20
-5e421d61-af50-467d-8bc2-779ee08b9833	W AND J COPPINI INVESTMENT SERVICES LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-03fed218-ba79-4599-9872-828c0351489c	WARMINSKO-MAZURSKI BANK SPOLDZIELCZY (WARMIA MASURIA COOPERATIVE BANK)	267c8e25-7b4f-458e-b91f-3d56352fc23c
-cf96f88f-c61c-4cc0-82ca-0b345e3b8211	WEBCOR INVESTMENTS LTD	946573ce-5155-4405-a246-65b6a3d90a20
-7df476f6-4a7d-4261-b461-78e93d07f08b	WOOD AND COMPANY FINANCIAL SERVICES A.S. POLISH BRANCH	267c8e25-7b4f-458e-b91f-3d56352fc23c
-960fcf88-ad01-4f44-b211-50bba44deec6	WOT, SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-ce1ba3d0-e6ef-4a20-ac99-3fbf291e4b13	WSCHODNI BANK SPOLDZIELCZY W CHELMIE	267c8e25-7b4f-458e-b91f-3d56352fc23c
-b0b2ea6a-c737-4430-8dbc-e0990e188de8	X-TRADE BROKERS DOM MAKLERSKI SPOWKA AKCYJNA	267c8e25-7b4f-458e-b91f-3d56352fc23c
-c0a06c0e-6162-44aa-a516-424ad930fe52	XNT LTD	946573ce-5155-4405-a246-65b6a3d90a20
-04074ee9-e104-4dbb-8b43-dce0bba05d2a	XOPAY, SIA	c90259ac-8f05-467b-bdad-8e0549913bcc
-529ff1ce-e146-4121-a99b-357e15240686	ZAGORA FINACORP AD	f2a8c291-89bb-4079-8618-980bebe0d294
-99191645-2f3d-4f7b-bdab-3982cbad086a	ZFP EQUITY TRADING (MALTA) LIMITED	946573ce-5155-4405-a246-65b6a3d90a20
-9dada108-7831-4299-a761-8f4e3f216af7	ZULAWSKI BANK SPOLDZIELCZY	267c8e25-7b4f-458e-b91f-3d56352fc23c
-\.
-
-
---
--- TOC entry 4886 (class 0 OID 25128)
--- Dependencies: 223
--- Data for Name: countries; Type: TABLE DATA; Schema: public; Owner: postgres
---
-
-COPY public.countries (id, iso2_code, name) FROM stdin;
-092979aa-e2b4-43a4-b5a1-5824d067ec6e	MC	MONACO
-3f4cdf53-1468-4cff-9951-f55b22587ce4	CL	CHILE
-f2a8c291-89bb-4079-8618-980bebe0d294	BG	BULGARIA
-267c8e25-7b4f-458e-b91f-3d56352fc23c	PL	POLAND
-a9025df0-6675-45e2-8354-5d1e073a8579	AL	ALBANIA
-946573ce-5155-4405-a246-65b6a3d90a20	MT	MALTA
-c90259ac-8f05-467b-bdad-8e0549913bcc	LV	LATVIA
-76b38b0b-2d80-4f3f-83d0-fbe187e30b4c	UY	URUGUAY
-48c73c0d-d359-4845-a1f6-8cbf182c9917	AW	ARUBA
-\.
-
-
---
--- TOC entry 4887 (class 0 OID 25132)
--- Dependencies: 224
--- Data for Name: swift_codes; Type: TABLE DATA; Schema: public; Owner: postgres
---
-
-COPY public.swift_codes (id, swift_code, bank_id, is_headquarter, address) FROM stdin;
-845f822a-4771-4bc7-86db-0cf19903ea03	BCECCLRMXXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-a123e2e3-1e81-4df1-a5a6-e79e053f482c	ECCVCLRMXXX	19ca4e9d-29af-4f6e-b184-158521c5c5fd	t	
-82d312ce-4924-4f76-854c-5f211c386da1	XWARPLP1XXX	e7e0bcbe-5199-46ae-882f-2e7a38496a06	t	NOWY SWIAT 6/12  WARSZAWA, MAZOWIECKIE, 00-400
-dc91854e-e46a-4bc2-a406-3e3cb38de487	ZAFIBG21XXX	529ff1ce-e146-4121-a99b-357e15240686	t	DOBRUDJA STR. 10 ENTR. B, OFFICE 27 HASKOVO, HASKOVO, 6300
-fab419be-7522-4f41-b215-6dc8ff3a5961	ZETMMTM1XXX	99191645-2f3d-4f7b-bdab-3982cbad086a	t	FLOOR 5, REGENT HOUSE 52 BISAZZA STREET SLIEMA, SLIEMA, SLM 1641
-303b0211-54ba-499e-adf8-30dc8ff9955d	ZIPIPLP1XXX	e38213af-a805-4490-a5c1-e53f97a32bc9	t	MICKIEWICZA 1  - PIOTRKOW TRYBUNALSKI, LODZKIE, 97-300
-6e3f6d00-edd5-433d-92c4-488dd2bf2faf	ZLLCBGS1XXX	b69efdac-bc2b-48e0-b8cc-b059deb71aac	t	POSTOYANSTVO  SOFIA, SOFIA, 1111
-15162057-f667-4376-b83e-23a251e83d4a	ZUSPPLP1XXX	9dada108-7831-4299-a761-8f4e3f216af7	t	SIKORSKIEGO 52  - NOWY DWOR GDANSKI, POMORSKIE, 82-100
-be519ef5-5f62-4d1b-90ab-4774b64efe0f	AAAJBG21XXX	b097c875-fb64-4828-827e-c4c8e27f960b	t	6TH OF SEPTEMBER BLVD 152 BUSINESS CENTER LEGIS PLOVDIV, PLOVDIV, 4000
-31f69722-25a8-429b-a483-04920de92f02	AAAPBGS1XXX	5fecb5e5-57a1-40ea-9a51-9bb4e090a9b2	t	EXARCH YOSIF 7  SOFIA, SOFIA, 1301
-f8336cf9-698f-45b0-afb9-9baa2fa24648	AAESBGS1XXX	7090928e-dbce-4287-85c0-e07ad06cb390	t	SREDNA GORA STR 49 FLOOR 6 SOFIA, SOFIA, 1303
-395f0f30-39a9-46a9-bbe9-294c13a9cb92	AAISALTRXXX	5724d37c-f583-418e-af9b-a1e81d855237	t	HYRJA 3 RR. DRITAN HOXHA ND. 11 TIRANA, TIRANA, 1023
-bfb05760-8ba9-468f-8e57-38d12ad4a13e	AAMJBGS1XXX	a7332dcc-03b5-484e-8340-cbf66b507a1f	t	CHRISTOPHER COLUMBUS BLVD 43 FLOOR 5 SOFIA, SOFIA, 1592
-c196be42-79a5-4b34-8fb8-678e206afe00	AAVLCLR9XXX	ea885cf1-1fdb-463a-82d8-adc4b05e5bbc	t	
-d45a6cf3-f0dd-43e6-a751-5bee5f517953	ABIEBGS1XXX	900a798e-9476-4e90-a6cd-c629ea88198c	t	TSAR ASEN 20  VARNA, VARNA, 9002
-ef58d9c6-cee6-4502-a8e0-53b3c5d537a6	ABIGBGS1XXX	2c2ab63d-cdef-4e31-883b-e09a639d3520	t	DAMYAN GRUEV 46  SOFIA, SOFIA, 1606
-5fa20018-5296-4b32-a9b5-6ac5f217efe2	ACFCMTM1XXX	d893a61f-9941-4466-a06c-07c2ab1ea025	t	184 ST. LUCIA STREET  VALLETTA, VALLETTA, VLT 1189
-2dcb92dc-9197-4b71-8467-c642f893d190	ADCRBGS1XXX	fa17dab4-2078-4d6a-b4be-9840ad67d728	t	JAMES BOURCHIER BLVD 76A HILL TOWER SOFIA, SOFIA, 1421
-9f5783e8-56b6-47bb-9d94-c90654a5b1ac	AFAAUYM1XXX	8980487f-0022-41ad-82fd-a25892fe1c9c	t	PLAZA INDEPENDENCIA 743  MONTEVIDEO, MONTEVIDEO, 11000
-b31ab346-aec6-4c6e-9a57-a3b628634d1e	AFNTCLRSXXX	83a8dd56-ebaf-43aa-8d12-c8545909d94c	t	
-4fb45791-1995-46a6-97da-22fa1d819fb6	AFPCCLRSXXX	c5720be5-def3-442d-b1db-10af91fce085	t	
-03ec8eb6-014d-439d-bcf1-a2fa93a06427	AFPPCLR2XXX	96d62063-ad5e-40b1-a8dc-f6095521e977	t	AVENIDA PEDRO DE VALDIVIA 100 FLOOR 9 PROVIDENCIA - SANTIAGO PROVINCIA DE SANTIAGO, 7580502
-44d991fd-8739-4682-9130-0fc40237fc07	AFSMMTM1XXX	faffc990-f051-4f81-b4cf-16a1b85768a2	t	FLOOR 1, BLUE HARBOUR BUSINESS CENTRE YACHT MARINA TA'XBIEX, TA'XBIEX, XBX 1027
-5ad9966a-1e5b-4b78-b940-b524bd967c89	AGDSCLRSXXX	2dedc756-957d-460f-b13a-2d3e731dea22	t	AV. APOQUINDO 4820, FLOOR 15  SANTIAGO, SANTIAGO, 8320000
-c018db8e-e410-4614-b2d3-777663c3e5c0	AGRIMCM1XXX	e24c6670-6842-48a3-82ce-d689c9f4eb3e	t	23 BOULEVARD PRINCESSE CHARLOTTE  MONACO, MONACO, 98000
-6093eb88-cf71-4e4a-b896-7652116e1709	AGRIPLPRXXX	80277956-a33b-4cd1-808a-21e3d13b402e	t	UL. LEGNICKA 48 C-D  WROCLAW, DOLNOSLASKIE, 54-202
-65f15b76-f13a-45cf-ac41-eaf85dc8d33e	AGRKMTMTXXX	cdce7b1a-159c-4306-b560-3918839c9462	t	LUQA, LUQA, LQA 4000
-e5e144b0-288d-426a-9287-72e989d716ee	AIPOPLP1XXX	2ec792a9-bddd-4f33-9120-c76405690711	t	STRZEGOMSKA 42C  WROCLAW, DOLNOSLASKIE, 53-611
-c1e4728f-73b1-4333-8fef-763e28bb9603	AIZKLV22CLN	71e79e80-bcad-4ea9-982e-ccc237868a4b	f	ELIZABETES STREET 23  RIGA, RIGA, LV-1010
-5403fd17-e801-480c-9c55-9286b2afec61	AIZKLV22XXX	71e79e80-bcad-4ea9-982e-ccc237868a4b	t	MIHAILA TALA STREET 1  RIGA, RIGA, LV-1045
-6a7266ee-8796-4e77-a132-6bd45d9ca5cd	AKBKMTMTXXX	ac5f6c7d-e1d1-471b-9ed9-1a52b5b2686f	t	FLOOR 6, PORTOMASO BUSINESS TOWER 01 PORTOMASO PTM - ST. JULIAN'S ST. JULIAN'S, STJ 4011
-fcc2e721-e4dc-421d-9612-b50690573987	AKFSMTM2XXX	1b76ec36-dd9a-436f-bd67-f58cec8888db	t	THE PENTHOUSE, FLOOR 5, LIFESTAR BUILDING TRIQ TESTAFERRATA TA'XBIEX, TA'XBIEX, XBX 1403
-da3ffb35-828c-47d8-996b-cba46b64bcfc	ALBPPLP1BMW	72fc966e-4de8-433b-939a-c653b08238f6	f	WARSZAWA, MAZOWIECKIE
-6a7a72f1-f553-4130-aaa8-34fffe2d85a0	ALBPPLPWCUS	72fc966e-4de8-433b-939a-c653b08238f6	f	LOPUSZANSKA BUSINESS PARK LOPUSZANSKA 38 D WARSZAWA, MAZOWIECKIE, 02-232
-06155365-35ae-4899-ada5-90e688f3f39b	ALBPPLPWXXX	72fc966e-4de8-433b-939a-c653b08238f6	t	LOPUSZANSKA BUSINESS PARK LOPUSZANSKA 38 D WARSZAWA, MAZOWIECKIE, 02-232
-80fc739e-ca5a-442e-a92c-f4dedefac5ee	ALFPLV21XXX	31562ef7-c3f3-45f3-b962-8c03da92181c	t	KR. VALDEMARA IELA  RIGA, RIGA, LV-1013
-96da6504-ff0b-4a6f-8f09-6fd0cc8044b5	AMNMBGS1XXX	72c77558-9e70-440c-8060-ff0d6a32f795	t	HRISTOFOR KOLUMB  SOFIA, SOFIA, 1592
-14f39b4c-a998-40b9-b6a6-098cbcbf6ede	ANFVMTMMXXX	5e22ee49-c695-46fc-90e4-5bdddaf02aac	t	FLOOR 2, MELITA COURT GIUSEPPE CALI STREET, C/W TRIQ ABATE RIGORD TA'XBIEX, TA'XBIEX, XBX 1420
-3d34e0e2-490f-42c8-9934-2c995121c71b	ANIBAWA1XXX	7594958f-b615-4343-a59b-09ae6fbe3e02	t	WILHELMINASTRAAT 36  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-ab5c4a19-ef97-42ea-87f6-71853b98914a	ANTLUYM1XXX	8e2a14cf-dc5a-470d-a3c4-9377ec40d2e4	t	GUATEMALA 1075  MONTEVIDEO, MONTEVIDEO, 11800
-d4ac9c92-a3e8-4b10-ac55-ddca6baf2928	APAHMTMTXXX	dfb6d170-a482-4833-b2fb-3c60e251406d	t	171 OLD BAKERY STREET  VALLETTA, VALLETTA, VLT 1455
-4b1f78bb-8e48-4a35-bd99-663e9f82a804	APAHMTMVXXX	dfb6d170-a482-4833-b2fb-3c60e251406d	t	171 OLD BAKERY STREET  VALLETTA, VALLETTA, VLT 1455
-753451f9-4ef5-457d-876e-c34463569ea1	APAYMTMTXXX	11a7eb94-7a79-44a3-a115-12bd1c456b8c	t	EUROPA BUSINESS CENTRE 2 1 TRIQ DUN KARM BIRKIRKARA, BIRKIRKARA, BKR 9034
-4bac2ce4-9d0d-4784-be8d-4a2c2afc5999	APMEMTM1XXX	b05b30d6-1c13-4728-b8f4-9aeef133c0e4	t	FLOOR 2, PROGETTA HOUSE TOWER ROAD SWATAR BIRKIRKARA, BIRKIRKARA, BKR 4012
-c94aba73-714a-461b-8f4b-c8bc0b594441	APSBMTMTXXX	f4a25b84-f212-44cf-9353-ba8ee224bfaa	t	APS CENTRE TOWER STREET BIRKIRKARA, BIRKIRKARA, BKR 4012
-4c9ad5d3-0d9c-4b8d-a4ac-928124f29444	ARUBAWAXXXX	c8c64fc5-ae9f-4220-9403-1576a073aff5	t	CAMACURI 12  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-3f981857-ee95-419a-9826-409e28a7173e	ASFHPLPKXXX	0a71d09e-805d-4d3e-b2b6-c82c1666ea4e	t	ZABLOCIE 43B  KRAKOW, MALOPOLSKIE, 30-701
-826dc545-9b4f-4b06-8549-0b39c9f1d083	ATISMTM1XXX	924faac6-0e09-4d7b-a3a8-d86fc52ce9bc	t	ABATE RIGORD STREET  TA'XBIEX, TA'XBIEX, XBX 1120
-42bee958-fa67-4b5c-96f6-a4f21ff475f0	AUFCMTM1XXX	90986ed2-dcab-4df8-aaf7-16050988ee51	t	TRIQ DUN KARM  BIRKIRKARA, BIRKIRKARA, BKR 9034
-b2ee36c7-738c-4a82-9627-473e1b0827d2	AUSVMTM1XXX	e441afa7-3b43-49e6-b42c-6efc2581f328	t	VALLETTA BUILDINGS SOUTH STREET VALLETTA, VALLETTA, VLT 1103
-8e114de5-0414-449e-8751-1ed22ed5d6a5	AVCABGS1XXX	741cdcf7-7fdb-455a-83ac-92f663bb97ab	t	LACHEZAR STANCHEV STR 3 LITEX TOWER, FLOOR 10 SOFIA, SOFIA, 1756
-06ae4950-db94-4b13-a529-1d920249d44e	AVFIBGS1XXX	2a1d755b-5fed-4137-ac52-2450c2a808e1	t	BUL. TODOR ALEKSANDROV 141 FLOOR 9 SOFIA, SOFIA, 1309
-6f7ee6fd-5992-421c-9942-39928edabeaf	AVJCBGS1XXX	9ef81bf6-7473-409e-b839-99234b746bd0	t	TODOR ALEKSANDROV BLVD 73 FLOOR 1 SOFIA, SOFIA, 1303
-6466232e-36e8-4591-8232-d3d1ee22f361	AXERMTM1XXX	6e05af92-5068-48a8-8c3d-8ed59dd578d8	t	108 TRIQ IT TIBEN  SWIEQI, SWIEQI, SWQ 3032
-7b4cd796-60f5-4f36-af86-ea60c389a30b	AZFMMCMCXXX	2f7015e2-11e0-430f-bad3-af1f632c92c0	t	ESCALIER DES FLEURS 8 BOULEVARD DES MOULINS MONACO, MONACO, 98000
-e74bffd0-3af4-4ce3-84f7-d5aa21ad2cdf	BACAMCMCXXX	cd17ef70-51f3-4c58-9da6-e7921dc6e692	t	1 AVENUE DES CITRONNIERS  MONACO, MONACO, 98000
-4ad6da2c-65a3-414f-9cb1-5899bdcac76f	BAERMCMCXXX	699b4296-5963-4123-bcee-51d3daa110a6	t	12 BOULEVARD DES MOULINS  MONACO, MONACO, 98000
-44dfb4dd-2d81-46bc-89cf-6815f3ab4dc5	BAPDBGS1XXX	abff3eef-5569-4100-a6e9-b1fc2d273d3f	t	SQ. POZITANO 9  SOFIA, SOFIA, 1000
-f9ba4ab1-b907-423e-a1f1-962f47daef05	BARCMCC1XXX	1226fdf7-370a-4c7d-a213-b8bfdc990a7f	t	31 AVENUE DE LA COSTA  MONACO, MONACO, 98000
-a4d30c4b-d4b2-4817-b7a2-2e8c2dbb1bb9	BARCMCMXXXX	8b9e1499-d421-4815-834c-9cd0b95f87e1	t	31 AVENUE DE LA COSTA  MONACO, MONACO, 98000
-f05a69f3-211a-4ed3-8432-818f9ca67d17	BATSALT1XXX	1aed086f-f27e-43bc-b7f4-d362d12da092	t	ISH KOMBINATI USHQIMOR KAVAJA STREET TIRANA, TIRANA, 1001
-6e0364ad-344e-4525-92ed-b44dfdddde60	BATSCLR2XXX	a3291dfb-cd05-460f-a423-b170aabd9f80	t	RUTA 68 S/N FUNDO LA ROTUNDA CASABLANCA, CASABLANCA-SETTAT 2480000
-53e2425b-6e16-4827-a468-94de60214ed8	BATSCLR3XXX	107c2c5f-a56b-44f3-b246-fd8ad05dc44f	t	
-020bf001-44c5-46ee-a6f9-b28ea1e111c9	BBVAUYMMXXX	337eb7af-ee6a-4e0f-9b6a-3983928f9026	t	25 DE MAYO, ESQUINA ZAQBALA 401  MONTEVIDEO, MONTEVIDEO, 11000
-f7f1d128-47aa-4c94-9430-df9cf38128f3	BCBDCLR1XXX	bfb01a9c-c753-470b-9766-e39858939125	t	PEDRO DE VALDIVIA 100, FLOOR 7 PROVIDENCIA - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-aa961d14-ca38-4da1-a812-729127524a2e	BCCSCLR1XXX	f5ca72bd-f77f-449d-b0a0-90fdbb84680e	t	
-385411cc-90ea-4765-b55f-4bb58fc844fa	BCECCLA1XXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-21a99449-e2eb-4b80-905e-de017b6383b5	BCECCLB1XXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-4cf85ea9-c0fd-424b-af7a-bcadcc3fdd8b	BCECCLC1XXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-554cddd2-cf29-4c47-b792-f935b4413904	BCECCLR2XXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-1adffd16-af23-442f-99cc-69e7ebe2ec82	BCECCLRDXXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-bbb9ca5e-41e9-4ad7-addb-1b56417aaeab	BCECCLRFXXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-67ba8b77-5db9-4196-a360-e3a0bbe4a32c	BCECCLRMCSH	6ab0012f-dc44-4692-b4b9-009bfe340c11	f	
-402d942d-7637-4c29-93b4-1b27bf57adea	BCECCLRMFCE	6ab0012f-dc44-4692-b4b9-009bfe340c11	f	
-629e6989-480b-4a21-955f-f436cb617e19	BCECCLRMFES	6ab0012f-dc44-4692-b4b9-009bfe340c11	f	
-c024bd85-6bc1-4236-96a5-d35d742f0b76	BCECCLRMFRP	6ab0012f-dc44-4692-b4b9-009bfe340c11	f	
-51c75e92-eed2-4034-a8ef-2c975ff63a72	BCECCLRRXXX	6ab0012f-dc44-4692-b4b9-009bfe340c11	t	
-cff4972a-58ed-44d5-84cc-5288a9d98f08	BCGMMCM1XXX	156a67e4-9515-42cc-a670-f97d50f69e4c	t	27 BOULEVARD PRINCESSE CHARLOTTE  MONACO, MONACO, 98000
-b7c0af6e-3901-44c3-a5d2-a8af1f64eaed	BCHICLR10R2	2c752332-bdad-4663-b45e-485223f63fe5	f	
-8657f0c0-ee9d-4833-a4a3-fef86d541769	BCHICLR10R3	2c752332-bdad-4663-b45e-485223f63fe5	f	21 DE MAYO 330  ARICA, PROVINCIA DE ARICA, 1000000
-ebcdf27c-d5b7-4da5-ad17-30dc6334ed71	BCHICLR10R4	2c752332-bdad-4663-b45e-485223f63fe5	f	
-eb32415c-f22c-44f1-b537-62aa5130c209	BCHICLR10R5	2c752332-bdad-4663-b45e-485223f63fe5	f	
-ce8b87a4-57b5-45f1-ad1f-d5bd0a9b6512	BCHICLR10R6	2c752332-bdad-4663-b45e-485223f63fe5	f	
-ea269bd8-0fa4-4a4b-ae56-4b51c26da586	BCHICLR10R7	2c752332-bdad-4663-b45e-485223f63fe5	f	
-0d40b577-dfd7-436d-80bd-befbc647d724	BCHICLR10R8	2c752332-bdad-4663-b45e-485223f63fe5	f	
-7cfe9f39-cae9-46e1-87d6-ef69ad66b2c6	BCHICLR10RA	2c752332-bdad-4663-b45e-485223f63fe5	f	
-b91a4194-4158-42ac-b096-4d30b6ae5bc3	BCHICLR10RC	2c752332-bdad-4663-b45e-485223f63fe5	f	
-37320817-fbf0-42cd-9b9c-34a73acd27c1	BCHICLR10RV	2c752332-bdad-4663-b45e-485223f63fe5	f	
-92dcc77f-173c-49a8-bad1-11d211e918ba	BCHICLR10RY	2c752332-bdad-4663-b45e-485223f63fe5	f	
-7ae84751-bdf1-4f6b-9529-bc7e9501c411	BCHICLRMCUS	2c752332-bdad-4663-b45e-485223f63fe5	f	
-e3b40853-3cd5-47fd-af40-c34b1f688e38	BCHICLRMEXP	2c752332-bdad-4663-b45e-485223f63fe5	f	
-8be89270-c477-43d1-a486-d61b500c6a75	BCHICLRMIMP	2c752332-bdad-4663-b45e-485223f63fe5	f	
-cac48de1-3600-4bfd-92f9-831a53d03d0a	BCHICLRMIOB	2c752332-bdad-4663-b45e-485223f63fe5	f	
-2ab442b2-f387-4a2f-bc38-96b5863e1709	BCHICLRMXXX	2c752332-bdad-4663-b45e-485223f63fe5	t	
-57708347-82a5-4a74-be47-d4b08213f267	BCITPLPWXXX	21be23f7-842e-4c03-9bbc-aae85ba53eb7	t	CENTRUM GIELDOWE, TOWER C UL. KSIAZECA 4, FLOOR 5 WARSZAWA, MAZOWIECKIE, 00-498
-2e48a405-6b94-45e5-a145-b5b5a78e3329	BCOSCLR1XXX	da4a34b2-a400-4f13-b41c-4576d49f25b6	t	AGUSTINAS 975, FLOOR 2 CASA MATRIZ BANCO DE CHILE - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-6cebb651-11f9-414f-8724-bb972130affe	BCRCBGS1XXX	fe3a3154-6ec8-4dc3-9c07-0ac5ead90628	t	EVLOGI GEORGIEV 95 FLOOR 1 SOFIA, SOFIA, 1142
-d5dc7c64-1e1f-476e-a8f0-4e788d434254	BDCCAWAWXXX	210aa149-49d4-48d5-94a5-0adca312f380	t	VONDELLAAN 31  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-c2796e34-0d27-48f0-a1d7-b16bc5aef372	BECHCLRMXXX	e9e1ad16-9edf-46c1-a850-94e8140c1c05	t	
-98516b2b-2e51-4c02-ae42-296200c55816	BEDMPLP1XXX	0a495ca8-ea17-44b6-bf90-a5d7e5cf6f8c	t	STOJALOWSKIEGO 7  BIELSKO BIALA, SLASKIE, 43-300
-f1f27787-fac7-4f7e-90f9-b5a8e11c0750	BEFNBGS1XXX	16fff5e5-fec3-4d14-bda4-c20f69a132a5	t	VISKIAR PLANINA 19 FLOOR 2 SOFIA, SOFIA, 1407
-57315421-d535-4f5d-80dc-2aa74fc051d3	BEHMMCMCXXX	78a8e402-83dc-4bdd-899e-7a8b3483b686	t	LOT 777 , FLOOR RDC, LES TERRASSES DU PORT 2 AVENUE DES LIGURES MONACO, MONACO, 98000
-366ee5ac-4cbc-4b30-9134-4efe71d8f9dc	BELDPLP1XXX	7fc1789f-fd35-4652-9b00-72d347276bd3	t	UL.NOCZNICKIEGO 3  BELSK DUZY, MAZOWIECKIE, 05-622
-5da21f6c-5be5-4ca6-8227-41da5021dd1d	BERLMCMCBDF	cfd6ce7e-4d8c-417e-9615-6d83d9ce0587	f	MONACO, MONACO, 98000
-beb402f8-1759-4170-a64c-34ec58877088	BERLMCMCXXX	cfd6ce7e-4d8c-417e-9615-6d83d9ce0587	t	LES TERRASSES, CARLO 2 AVENUE DE MONTE MONACO, MONACO, 98000
-405cc1b0-477f-4393-a29c-f880e3863c38	BGUSBGSFXXX	9bdf3264-88e9-4035-b044-6019f52fbb07	t	SLAVYANSKA STR 2  SOFIA, SOFIA, 1000
-00fb41a9-c59b-44cc-a8ea-a1500db9df89	BHUMUYM1XXX	b10c5215-52f0-4b44-8306-20637d97ac34	t	FERNANDEZ CRESPO 1508  MONTEVIDEO, MONTEVIDEO, 11200
-bcfbe676-da47-4cae-8cac-ebaf569a42fb	BICECLRMXXX	e4e2b095-9df9-470b-874d-8944edd223ce	t	
-1b6ce6fe-32f4-4bbc-ab95-b0d441fd30d1	BICHCLRMXXX	23418226-afe1-4798-931b-b108f0f6962e	t	
-8dee75ad-3f1d-4a60-951a-d72b8acc270f	BICYBGS1XXX	7f5610d8-c107-418c-a37a-7398ca0d6421	t	OBORISHTE STR 9  SOFIA, SOFIA, 1504
-c0630d89-39aa-46d8-9202-ac074f454f9d	BIEZPLP1XXX	4cc09a98-b601-4538-aced-b706ee3f9a2a	t	ZAMOJSKIEGO 27  BIEZUN, MAZOWIECKIE, 09-320
-90c2a871-42f9-4698-8813-2cf1766c9102	BIGBPLPWCUS	cf3d7775-6f23-4200-9b71-379f745c8375	f	HARMONY CENTER UL. STANISLAWA ZARYNA 2A WARSZAWA, MAZOWIECKIE, 02-593
-2f1b4025-2f6a-449f-bae6-6fbd477af534	BIGBPLPWXXX	cf3d7775-6f23-4200-9b71-379f745c8375	t	HARMONY CENTER UL. STANISLAWA ZARYNA 2A WARSZAWA, MAZOWIECKIE, 02-593
-10e57046-5e64-474b-b3ef-c02822ec518b	BIGKBGSFXXX	1c86535f-e8c0-43e4-8bc9-5b1d8b340d61	t	BOULEVARD GEN. EDUARD I. TOTLEBEN 53-55 FLOOR 2 SOFIA, SOFIA, 1606
-6c630e16-85ab-41e4-8a3b-a0a2c0cf1aba	BIGKLV21XXX	2a88cc34-84bb-40bd-b9b1-a16c8768cf99	t	GUSTAVA ZEMGALA GATVE 78-1  RIGA, RIGA, LV-1039
-66e50fd1-335b-4bf0-b0b2-f60cec904d1c	BISPPLP1XXX	40789083-212a-4e1b-b7e2-ad99e151d85b	t	DUBOIS STREET 5 A  WARSZAWA, MAZOWIECKIE, 00-184
-1c6e39b3-6db0-454d-a699-9c37a150db70	BJSBMCMXLCO	b780e60f-a770-4263-8126-971fe048819b	f	MONACO, MONACO, 98000
-54e866f9-a418-405b-9b8c-221e8ac7ad38	BJSBMCMXXXX	b780e60f-a770-4263-8126-971fe048819b	t	LE BELLE EPOQUE 15BIS/17 AVENUE D'OSTENDE MONACO, MONACO, 98000
-f54f6206-6e58-44ff-9678-f37dfc6754b5	BKCHCLRMXXX	6834183f-1870-413f-b8a4-983057862c6c	t	AVENIDA ANDRES BELLO 2457, FLOOR 16 COSTANERA CENTER TORRE DOS SANTIAGO, PROVINCIA DE SANTIAGO
-fb273222-7803-402f-bf30-d8c67f17c25a	BKCHPLPXXXX	7f496f67-08ac-47eb-ad82-8523bad56633	t	UL.ZIELNA 41/43  WARSZAWA, MAZOWIECKIE, 00-108
-423629ca-ef99-42b3-a8c6-7e101ca3b1d2	BKSACLRM055	a7ff30c4-6789-404e-b12a-fc6c0fcc767f	f	
-2191d523-cf71-4b8a-9547-16a37737505b	BKSACLRM061	a7ff30c4-6789-404e-b12a-fc6c0fcc767f	f	
-fbac8ce2-06c3-4da3-a6df-e1a94c0f02a0	BKSACLRM064	a7ff30c4-6789-404e-b12a-fc6c0fcc767f	f	21 DE MAYO 187  ARICA, PROVINCIA DE ARICA, 1000000
-c943eddc-d124-4767-941b-a016638cbe1c	BKSACLRM068	a7ff30c4-6789-404e-b12a-fc6c0fcc767f	f	
-2a8da96f-426d-4893-9825-d93e0d332ae2	BKSACLRMXXX	a7ff30c4-6789-404e-b12a-fc6c0fcc767f	t	AVENIDA COSTANERA SUR 2710, FLOOR 10 EDIFICIO PARQUE TITANIUM SANTIAGO, PROVINCIA DE SANTIAGO
-20fc841f-217e-43f7-9109-98fe00cd4775	BLICCLRMXXX	b8d2014a-8807-46a0-83bd-0c4db7359f2b	t	AVENIDA ISIDORA GOYENECHEA 2800 FLOOR 23 LAS CONDES - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-df99b656-c619-452e-a204-def7320a42b0	BLICUYMMXXX	b7792c26-60ce-4e4e-85ac-a8ef6fc8f4e5	t	RINCON 391 FLOOR 3 MONTEVIDEO, MONTEVIDEO, 11000
-6b0720e8-8008-42e5-89e8-9b4365fc302c	BLIKPLPWXXX	8565c07b-8bfd-4b68-9d75-4ec79b2358b3	t	CZERNIAKOWSKA X20, 97A, FLOOR 2  WARSZAWA, MAZOWIECKIE, 00-718
-1b477e29-4202-4d57-b7e6-e7b658532a2b	BLLGMTMBXXX	9e007332-69a5-49e1-bf16-ae9875f81e85	t	FLOOR 2, TRIDENT PARK, NOTABILE GARDENS 7 MDINA ROAD, ZONE 2 BIRKIRKARA, BIRKIRKARA, CBD 2010
-8926b840-84f7-454f-90ff-1a102d75ba2d	BLLGMTMTXXX	a37b0c96-d892-4d07-bf62-a1b968b36793	t	FLOOR 2, TRIDENT PARK NOTABILE G 7 MDINA ROAD, ZONE 2 BIRKIRKARA, BIRKIRKARA, CBD 2010
-a92ae16d-5d6e-45f0-9643-5f307f2a01e5	BLPBLV21XXX	cb70415c-0554-4160-9a9b-00628dc03973	t	SIA MONO KATLAKALNA ST. 1 RIGA, RIGA, LV-1017
-5a493d37-fb89-46f2-b56e-c829546d633e	BMDNPLP1XXX	149e40e3-2989-46f8-9b8e-c08c90324086	t	ZEROMSKIEGO 75  RADOM, MAZOWIECKIE, 26-600
-c2890362-ab27-42a2-84e8-d180677308c5	BMPBPLPPXXX	1a09b7d4-16b1-49c6-8c98-3be9ee50db30	t	DOBRA 40  WARSZAWA, MAZOWIECKIE, 00-344
-07da13e7-bf66-4862-9762-5b246a3b4a9b	BNBGBGSAXXX	d4567382-7623-4762-99bb-0e33870bbcb1	t	ALEXANDER BATTENBERG SQUARE 1  SOFIA, SOFIA, 1000
-1ec91e84-f1a0-4d6c-9ec5-4d1f09def87d	BNBGBGSBXXX	d4567382-7623-4762-99bb-0e33870bbcb1	t	ALEXANDER BATTENBERG SQUARE 1  SOFIA, SOFIA, 1000
-77de138f-e7a4-4401-b421-51792179ccd0	BNBGBGSDXXX	3e40d333-92a3-4714-bc8e-0b43bf6b2f0b	t	ALEXANDER BATTENBERG SQUARE 1  SOFIA, SOFIA, 1000
-6928cea2-85de-4a8f-99b6-545868157dd0	BNBGBGSEXXX	d4567382-7623-4762-99bb-0e33870bbcb1	t	KNYAZ ALEXANDER I SQUARE 1  SOFIA, SOFIA, 1000
-af8bbdc3-0530-41d6-9a21-99fcbcd57312	BNBGBGSFXXX	d4567382-7623-4762-99bb-0e33870bbcb1	t	ALEXANDER BATTENBERG SQUARE 1  SOFIA, SOFIA, 1000
-e05d9cac-c9d9-4029-abb3-65ebf9091cd9	BNIFMTMTXXX	24095eca-ef31-4239-b102-eda868e8a974	t	FLOOR 2 203 RUE D'ARGENS GZIRA, GZIRA, GZR 1368
-5d5d7d43-5689-40c7-9091-9e9c229833ef	BNPABGSXXXX	9948b30d-745b-411d-b458-4ec6c49687d6	t	MLADOST 4 BUSINESS PARK SOFIA BUILDING 14, FLOOR 1, RESIDENTIAL SOFIA, SOFIA, 1766
-ffe56cea-a46b-47e0-95ea-4dc736add109	BNPAMCM1XXX	77048edf-088c-4d40-8113-8cbaeab9e669	t	MONACO, MONACO, 98000
-39bf717c-fb4d-4928-9209-899d5d0729cc	BNPAPLPXXXX	b6a1f4d7-8f7c-4ad3-b148-8f5a5a667cb8	t	UL. WRONIA 31  WARSZAWA, MAZOWIECKIE, 00-846
-aa9be5ad-c43f-4c2a-9927-1fb0f15edaaf	BOFACLR1XXX	a6ae1666-40ff-4608-ba6c-fd6002172ed0	t	
-bc909abc-cfc0-4b96-8e9d-e4e84123abe0	BONPPLP9XXX	5ff3b011-89b4-4f59-aed8-ada047b064d9	t	KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-374e1ee6-83a0-4cc5-a72f-72ca6112b8ed	BPABCLRMXXX	b07732a0-dc06-4417-82b8-0a600aadf06d	t	AVENIDA COSTANERA SUR 2730, P.19 PARQUE TITANIUM, TORRE B, LAS SANTIAGO, PROVINCIA DE SANTIAGO
-e9b82b2c-b0ba-418f-a783-75da9a33834e	BPBIBGSFISD	9973c9cd-d327-49d2-93f2-c0f20a8f365d	f	SOFIA, SOFIA
-bfe15069-362b-4b73-8c26-ed9357d0a666	BPBIBGSFSEC	9973c9cd-d327-49d2-93f2-c0f20a8f365d	f	SOFIA, SOFIA
-33a43370-ffad-4c0a-aec0-749a87232d7b	BPBIBGSFXXX	9973c9cd-d327-49d2-93f2-c0f20a8f365d	t	OKOLOVRASTEN PAT STR 260  SOFIA, SOFIA, 1766
-09de1692-fc62-4c38-a575-68736c097fd4	BPHKPLP1BMK	84737404-3cc8-4da1-a4d8-b98cb4e9fe34	f	KRAKOW, MALOPOLSKIE
-7c644647-cfc7-4faf-ad83-a1cab004e29b	BPHKPLPKCUS	84737404-3cc8-4da1-a4d8-b98cb4e9fe34	f	UL. CYPRIANA KAMILA NORWIDA 1  GDANSK, POMORSKIE, 80-280
-125be0d6-1a8e-4fff-9d74-9328092cccea	BPHKPLPKXXX	84737404-3cc8-4da1-a4d8-b98cb4e9fe34	t	UL. CYPRIANA KAMILA NORWIDA 1  GDANSK, POMORSKIE, 80-280
-4197c894-d2f8-4a3f-8a2e-0362c743e566	BPKHPLPGXXX	78589823-5ae1-4020-b351-ee4e16787223	t	PULAWSKA 15  WARSZAWA, MAZOWIECKIE, 02-515
-66f8a98b-fad5-4e49-b2b0-e20cb2886b75	BPKOPLPWBIA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	TYSIACLECIA PANSTWA POLSKIEGO 6  BIALYSTOK, PODLASKIE, 15-111
-ba69b536-5270-4b3f-b927-483e4c249cd5	BPKOPLPWCSD	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	WARSZAWA, MAZOWIECKIE
-a1446daf-7e5b-4d36-959b-5e66689ce845	BPKOPLPWGDG	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	SWIETOJANSKA 17  GDYNIA, POMORSKIE, 71-368
-c83a85a9-7c8a-4672-9814-0187186c0256	BPKOPLPWGOA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	KOSYNIEROW GDYNSKICH 79  - GORZOW WIELKOPOLSKI, LUBUSKIE, 66-400
-1df59790-8875-49b5-b108-c554ef55a401	BPKOPLPWKAB	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	DAMROTA 23  KATOWICE, SLASKIE, 40-022
-af34bfbe-6b84-4dc2-873f-6a46fda18a63	BPKOPLPWKRD	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	OSIEDLE KOSCIUSZKOWSKIE 1  KRAKOW, MALOPOLSKIE, 31-858
-e306043c-0547-4ad1-834d-3b2d0927d04a	BPKOPLPWLDA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	PIOTRKOWSKA STREET 173  LODZ, LODZKIE, 90-959
-22d4181f-9a28-4a43-ad2b-cceb7c3b129d	BPKOPLPWLUA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	WATYKANSKA 7  LUBLIN, LUBELSKIE, 20-538
-e428c886-a96c-4eee-8993-7103c8c35008	BPKOPLPWOLS	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	KOSCIUSZKI STREET 89/91 OLSZTYN, WARMINSKO-MAZURSKIE 10-584
-2415d4d0-32c3-4d6c-8a4d-c73c3f1258d8	BPKOPLPWPOA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	GORDA WILDA 109  POZNAN, WIELKOPOLSKIE, 60-959
-430c8e1a-339d-4042-a29f-5e414489a3c5	BPKOPLPWSEC	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	WARSZAWA, MAZOWIECKIE
-4ffaecb8-7390-44ca-a030-71c8bc6a0cca	BPKOPLPWTDH	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	PULAWSKA STREET 16  WARSZAWA, MAZOWIECKIE, 02-515
-175eedaf-8e8d-479e-a4ba-154ca0984486	BPKOPLPWTOB	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	PLAC TEATRALNY 4  - BYDGOSZCZ KUJAWSKO-POMORSKIE, 85-950
-b6dc868e-2c24-441e-a91a-d15f2c91462e	BPKOPLPWWAA	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	SIENKIEWICZA 12/14  WARSZAWA, MAZOWIECKIE, 00-944
-f0823da8-7a6c-4b0c-991f-0eb05d57e31b	BPKOPLPWWRD	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	f	WITA STWOSZA 12  WROCLAW, DOLNOSLASKIE, 50-950
-13da4ea9-288c-4b1a-b70a-469911b97d78	BPKOPLPWXXX	c2476a48-9fd7-4542-8a3d-bf2f41ec28f0	t	UL. PULAWSKA 15  WARSZAWA, MAZOWIECKIE, 02-515
-09b62d75-fa96-4a8c-9be1-0037d60a8c94	BPPBMCMCXXX	156a67e4-9515-42cc-a670-f97d50f69e4c	t	15-17 AVENUE D'OSTENDE  MONACO, MONACO, 98000
-736ddb70-c4dd-4d7d-bbf8-beb8d1c44e11	BPSOUYM1XXX	cc2895cb-654d-42b0-a804-896641cecf0d	t	FERNANDEZ CRESPO 1621  MONTEVIDEO, MONTEVIDEO, 11200
-0c564357-0365-41ff-ae03-3329116a09d1	BREXPLPWBIA	44223798-c79b-4484-8ff2-227af6d1a44f	f	UL. SWIETOJANSKA 15  BIALYSTOK, PODLASKIE, 15-277
-aefe88d3-29c3-46ce-8cd6-b082a5ac588f	BREXPLPWBIB	44223798-c79b-4484-8ff2-227af6d1a44f	f	PLAC WOLNOSCI 7  BIELSKO BIALA, SLASKIE, 43-300
-5bf9daaa-5c32-4d6a-af17-2c6b3ed82074	BREXPLPWBYD	44223798-c79b-4484-8ff2-227af6d1a44f	f	GRODZKA 19-21  - BYDGOSZCZ KUJAWSKO-POMORSKIE, 85-109
-14749e6c-d954-4bd3-855e-369ffb9a059d	BREXPLPWCUS	44223798-c79b-4484-8ff2-227af6d1a44f	f	CYBERNETYKI 7A  WARSZAWA, MAZOWIECKIE, 02-677
-ea2ff496-424c-4fc8-94eb-035091842a39	BREXPLPWCZE	44223798-c79b-4484-8ff2-227af6d1a44f	f	JASNOGORSKA 79  CZESTOCHOWA, SLASKIE, 42-217
-ad869a22-4ec5-45b2-bfe6-bc97f639218c	BREXPLPWDKS	44223798-c79b-4484-8ff2-227af6d1a44f	f	SENATORSKA 18  WARSZAWA, MAZOWIECKIE, 00-950
-fadb78f8-cdbc-4355-8391-fb35fabf7c01	BREXPLPWGDA	44223798-c79b-4484-8ff2-227af6d1a44f	f	JANA Z KOLNA 11  GDANSK, POMORSKIE, 80-864
-078a5d55-4b54-4ba9-8fed-67ffb46bba90	BREXPLPWGDY	44223798-c79b-4484-8ff2-227af6d1a44f	f	SLASKA 47  GDYNIA, POMORSKIE, 81-310
-164c3d01-ae8b-4420-89e9-e87c927650a1	BREXPLPWGOR	44223798-c79b-4484-8ff2-227af6d1a44f	f	PRZEMYSLOWA 2  - GORZOW WIELKOPOLSKI, LUBUSKIE, 66-400
-91876377-32aa-4ba2-b184-7820858267b1	BREXPLPWKAL	44223798-c79b-4484-8ff2-227af6d1a44f	f	FRYDERYKA CHOPINA 26/28  KALISZ, WIELKOPOLSKIE, 62-800
-cfeef9dd-753a-4c04-8ba1-513914863c4f	BREXPLPWKAT	44223798-c79b-4484-8ff2-227af6d1a44f	f	POWSTANCOW 43  KATOWICE, SLASKIE, 40-024
-d6c29a5e-c1ae-4e70-8a9f-dc702111924e	BREXPLPWKIE	44223798-c79b-4484-8ff2-227af6d1a44f	f	IX WIEKOW KIELC 4  KIELCE, SWIETOKRZYSKIE, 25-516
-5221f350-7f92-47b3-9d82-853e098354b4	BREXPLPWKOS	44223798-c79b-4484-8ff2-227af6d1a44f	f	OKRZEI 3  - KOSZALIN ZACHODNIOPOMORSKIE, 75-203
-5d5a076d-e5d3-41cb-b325-d050e96cbce4	BREXPLPWKRA	44223798-c79b-4484-8ff2-227af6d1a44f	f	AUGUSTIANSKA 15  KRAKOW, MALOPOLSKIE, 31-064
-b235a75f-0c8a-406b-81d6-827122810a71	BREXPLPWLOD	44223798-c79b-4484-8ff2-227af6d1a44f	f	JANA KILINSKIEGO 74  LODZ, LODZKIE, 90-119
-b11ef66b-e048-4e4f-b0e6-010b873bd5b2	BREXPLPWLUB	44223798-c79b-4484-8ff2-227af6d1a44f	f	SPOKOJNA 2  LUBLIN, LUBELSKIE, 20-074
-491fb372-0765-463c-bfd5-cbbd5f91db54	BREXPLPWMBK	44223798-c79b-4484-8ff2-227af6d1a44f	f	SKRYTKA POCZTOWA 2108  LODZ, LODZKIE, 90-959
-a4676114-6307-42d4-b614-816111321ea4	BREXPLPWMDM	44223798-c79b-4484-8ff2-227af6d1a44f	f	WSPOLNA 47/49  WARSZAWA, MAZOWIECKIE, 00-684
-9a519d3f-f539-45a6-adcc-50bc1aef9d79	BREXPLPWMUL	44223798-c79b-4484-8ff2-227af6d1a44f	f	SKRYTKA POCZTOWA 2108  LODZ, LODZKIE, 90-959
-d4178852-6ebb-4521-b911-892b4b1e95aa	BREXPLPWNWS	44223798-c79b-4484-8ff2-227af6d1a44f	f	PRAZMOWSKIEGO 11  NOWY SACZ, MALOPOLSKIE, 33-300
-f756a1cf-c0a9-4603-a508-cf5822565521	BREXPLPWOLS	44223798-c79b-4484-8ff2-227af6d1a44f	f	PILSUDSKIEGO 44A  - OLSZTYN WARMINSKO-MAZURSKIE, 10-450
-fb6a79f9-a44f-4076-9b05-28b60e88d28d	BREXPLPWOMB	44223798-c79b-4484-8ff2-227af6d1a44f	f	PILSUDSKIEGO STR 3  LODZ, LODZKIE, 90-368
-00684fc5-9d78-43b9-8094-30617f7cbe3f	BREXPLPWOPO	44223798-c79b-4484-8ff2-227af6d1a44f	f	KSIAZAT OPOLSKICH 29  OPOLE, OPOLSKIE, 45-005
-01febde4-d36d-4e68-948b-ba68a3cb73e9	BREXPLPWPOZ	44223798-c79b-4484-8ff2-227af6d1a44f	f	ROOSEVELTA 22  POZNAN, WIELKOPOLSKIE, 60-829
-f80b2cd7-ad8a-4ef7-b320-8b8354cbcadb	BREXPLPWRCO	44223798-c79b-4484-8ff2-227af6d1a44f	f	POWSTANCOW 43  KATOWICE, SLASKIE, 40-024
-96d3540e-6297-4d43-85c7-fd38bd79f84d	BREXPLPWRYB	44223798-c79b-4484-8ff2-227af6d1a44f	f	RUDZKA 3  RYBNIK, RYBNIK, 44-200
-b6b5d250-1f05-4dd5-8feb-fd60bd30ca98	BREXPLPWRZE	44223798-c79b-4484-8ff2-227af6d1a44f	f	SOKOLA 6  RZESZOW, PODKARPACKIE, 35-010
-7b23b98a-85c1-4e9e-bce6-7500b127491d	BREXPLPWSZC	44223798-c79b-4484-8ff2-227af6d1a44f	f	ZBOZOWA 4  - SZCZECIN ZACHODNIO-POMORSKIE, 70-653
-f30af139-ec2e-44d6-b8cd-6c6b98d662d5	BREXPLPWTOR	44223798-c79b-4484-8ff2-227af6d1a44f	f	BYDGOSKA 1  TORUN, KUJAWSKO-POMORSKIE, 87-100
-035b19c6-9b1a-4818-9cf2-9c24faa44396	BREXPLPWWA1	44223798-c79b-4484-8ff2-227af6d1a44f	f	UL. SENATORSKA 18  WARSZAWA, MAZOWIECKIE, 00-950
-c7eeae1b-3123-4c95-b95d-a1f10c08f117	BREXPLPWWA2	44223798-c79b-4484-8ff2-227af6d1a44f	f	UL. DOMANIEWSKA 44  WARSZAWA, MAZOWIECKIE, 02-672
-a922ce32-51dc-41f3-a37b-6d10a1b70a59	BREXPLPWWA3	44223798-c79b-4484-8ff2-227af6d1a44f	f	OSTROBRAMSKA 207  WARSZAWA, MAZOWIECKIE, 04-077
-a94d88ae-9a4e-4862-bac1-d783879b6ef9	BREXPLPWWA4	44223798-c79b-4484-8ff2-227af6d1a44f	f	AL.JEROZOLIMSKIE 174  WARSZAWA, MAZOWIECKIE, 02-486
-5fec3853-9680-46e4-8f2a-17d5b2fc4a10	BREXPLPWWA5	44223798-c79b-4484-8ff2-227af6d1a44f	f	PROSTA 68  WARSZAWA, MAZOWIECKIE, 00-838
-506713b1-8c2e-41ee-b210-00dcafc28b88	BREXPLPWWAL	44223798-c79b-4484-8ff2-227af6d1a44f	f	SIENKIEWICZA 2  WALBRZYCH, DOLNOSLASKIE, 58-300
-272f8922-1223-4757-b1ec-af1422b3e8f5	BREXPLPWWRO	44223798-c79b-4484-8ff2-227af6d1a44f	f	PL. JANA PAWLA II 9  WROCLAW, DOLNOSLASKIE, 50-136
-1cfd5a5b-fc56-493f-9f8e-53e8ade4e97b	BREXPLPWXXX	44223798-c79b-4484-8ff2-227af6d1a44f	t	UL. PROSTA 18  WARSZAWA, MAZOWIECKIE, 00-850
-c7112c23-da52-43dd-8d1f-1244a6da3d6b	BREXPLPWZIE	44223798-c79b-4484-8ff2-227af6d1a44f	f	PLAC JANA MATEJKI 19  ZIELONA GORA, WIELKOPOLSKIE, 65-056
-3017a89c-eff6-425d-af99-1a9b50bd90f6	BROUUYMMXXX	a3dbdcb5-95ce-42a4-bdc6-c7eab9d7981d	t	CERRITO, ZABALA STREET 351  MONTEVIDEO, MONTEVIDEO, 11000
-b784b21d-7077-4249-a48b-76c7dd8cb18c	BSBAPLP1XXX	4b5ef67e-2ee8-466e-a580-1addd2d280af	t	ZAMENHOFA 4  BIALYSTOK, PODLASKIE, 15-435
-0ede5931-3e3d-4a58-8944-de330bc3f966	BSBGBGS2XXX	1484c75d-ddc5-438c-b8c4-75dbf10c111a	t	TZAR BORIS III BLVD. 41  SOFIA, SOFIA, 1612
-75bdf4e1-1863-446c-afa6-1db56f51ed93	BSBGBGSFBOR	1484c75d-ddc5-438c-b8c4-75dbf10c111a	f	TZAR BORIS III BLVD 41  SOFIA, SOFIA, 1612
-4afac660-3a25-421d-bc79-e3ffcf0aa6dc	BSBGBGSFIOP	1484c75d-ddc5-438c-b8c4-75dbf10c111a	f	SOFIA, SOFIA
-3b2a20f5-4458-4bca-8227-27deed8a8f40	BSBGBGSFST2	1484c75d-ddc5-438c-b8c4-75dbf10c111a	f	TZAR BORIS III BLVD. 41  SOFIA, SOFIA, 1612
-4b0982d0-0d1c-4c51-ae1a-c0a706ca6209	BSBGBGSFTPS	1484c75d-ddc5-438c-b8c4-75dbf10c111a	f	TZAR BORIS III BLVD. 41  SOFIA, SOFIA, 1612
-a1e51344-cc37-4495-9476-e4df80f3b54e	BSBGBGSFXXX	1484c75d-ddc5-438c-b8c4-75dbf10c111a	t	TZAR BORIS III BLVD 41  SOFIA, SOFIA, 1612
-a62150d9-cbb4-4938-9c83-e32b129e0a09	BSBIPLP1XXX	fac71579-1dc2-4673-b353-38366b07d0b8	t	SZKOLNA 6  BIALOBRZEGI, MAZOWIECKIE, 26-800
-74b6b2da-6bff-4db5-b59a-93f202de8b46	BSCHCLR10R2	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-27d51dc0-102c-47a9-a5f2-a19d6b07e4b3	BSCHCLR10R3	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	21 DE MAYO 300  ARICA, PROVINCIA DE ARICA, 1000000
-466e4bda-eac6-412d-9550-4c735f44793c	BSCHCLR10R4	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-fc099ee8-cf97-4f69-ba43-04f640494f13	BSCHCLR10R5	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-e47c2852-dfd3-4635-8e48-a6b04c67af5b	BSCHCLR10R6	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-35fe3895-cf3b-49ce-84cc-ba500513a14b	BSCHCLR10R7	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-08b1a06a-6873-4e72-a9e0-af4407e31bb0	BSCHCLR10RA	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-f8ad94ec-b47b-48e4-8a68-7e33733adc7e	BSCHCLR10RB	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-07b709f3-ffb6-4940-9005-17f6b41cc6b9	BSCHCLR10RD	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-eb400c3d-142c-4239-81a6-64344624503a	BSCHCLR10RE	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-966e9abe-ebd9-4d31-9ac2-4b5264375c2c	BSCHCLR10RF	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-86840dc7-c51a-4df3-b36b-65348c1dfcd3	BSCHCLR10RG	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	SUR 4 ORIENTE 1, 1098  TALCA, PROVINCIA DE TALCA, 3460000
-a87536cb-debc-4c88-8c37-8fe754224e38	BSCHCLR10RH	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-c0d73146-ee11-49e5-8ad8-8fa0c49a0837	BSCHCLR10RJ	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-5fb44396-fe3d-4ff6-8764-03334d1f1bc3	BSCHCLR10RK	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-ed472442-cc23-4a70-b9bc-cffb5dd3c881	BSCHCLR10RL	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-a1cdd12a-5244-495d-990a-66d62f7e3a5a	BSCHCLRMGST	ebf2f1b9-3f7b-4943-9319-6ad231040a41	f	
-c2f4ad52-ffac-4073-be76-f229246a41a4	BSCHCLRMXXX	ebf2f1b9-3f7b-4943-9319-6ad231040a41	t	
-a24bca01-c489-4413-b32d-e9cd9205775d	BSCHUYMMXXX	f9428069-ca1e-4553-bdb4-9a1a5558c5db	t	JULIO HERRERA Y OBES 1365  MONTEVIDEO, MONTEVIDEO, 11100
-2c9b3fc1-4573-4103-aa99-b887cf516602	BSCLCLRMXXX	2209d9ab-ed2b-4f56-8417-f7ee372a2e33	t	
-dfd4b2cf-0c9a-48c9-817c-d0a940a9b647	BSEMUYM1XXX	235cc660-27aa-425a-8113-7df2d26fe711	t	MERCEDES 1051  MONTEVIDEO, MONTEVIDEO, 11100
-242e8286-97b4-4fab-a319-ed2728f5c8b3	BSGJPLP1XXX	6c204b51-885c-4a21-8295-1aa633491474	t	JATKOWA 3  GROJEC, MAZOWIECKIE, 05-600
-2f521e88-7676-43a9-9a0a-4355e91dafd9	BSGLPLP1XXX	8fba61dd-3cfc-4c65-a838-5c072b968e91	t	UL.MLYNARSKA 5/13  GLOWNO, POWIAT ZGIERSKI, 95-015
-2d9dd449-4068-40cd-a304-89a058cf7ea7	BSGOPLP1XXX	94e657ea-4e26-4413-a408-5ce2007f0c2d	t	UL.KOSCIELNA 1  GOWOROWO, POWIAT OSTROLECKI, 07-440
-fe8a6c72-1c55-45b5-acb3-944e944ff1e0	BSGRPLP1XXX	5713e166-1688-47a8-a052-0e92df66db36	t	PL.GRUNWALDU 4 A  GRUDUSK, MAZOWIECKIE, 06-460
-eef52e89-9f88-4b97-9f13-9be7edae0d48	BSKRPLP1XXX	bef58c6f-5ac3-4f2e-8f4d-5aae6af09524	t	UL.TORUNSKA 5  KROSNIEWICE, LODZKIE, 99-340
-c089a5c4-cf8b-4475-bcfe-13863d4b8bfb	BSLCPLP1XXX	3ed47b8d-594d-4d34-a203-5eabcde694d4	t	UL.SLONECZNA 223  - LESZNOWOLA POWIAT PIASECZYNSKI, 05-506
-9951fc39-fad9-45f5-bc28-fab4f555cebf	BSLPPLP1XXX	678e7b3d-78e5-48ff-933b-c4e9e6673926	t	UL.GEN.WL.SIKORSKIEGO 80  LAPY, PODLASKIE, 18-100
-951084da-77d8-476d-b68d-6f1bd53c279d	BSMLPLP1XXX	2fcbbfb7-2f4b-45a2-92be-f21052081a7e	t	UL.SADOWA 3  MLAWA, MAZOWIECKIE, 06-500
-33d8a914-918f-4e65-bcb6-73b3a4586c1e	BSPIPLP1XXX	008a551b-c4e1-4bf9-9f4e-40256d3c79f4	t	PLAC KONSTYTUCJI MAJA 3, 4  PIONKI, MAZOWIECKIE, 26-670
-5f9cf791-85c5-4f45-8d3d-8fd08b232619	BSRAPLP1XXX	6e411f64-909b-4de3-b548-1ee110040fc5	t	UL.MLAWSKA 20A  RACIAZ, POWIAT PLONSKI, 09-140
-339a442f-6230-4a56-9554-35c49a547b04	BSRLPLP1XXX	d16d162a-7417-4ce7-95b8-ebf604da9c5d	t	UL.MONIUSZKI 6  LODZ, LODZKIE, 90-111
-38fad2fb-ef8e-4f2f-8cc3-e4c5af68e84d	BSSOPLP1XXX	4af4217c-2bfc-48c1-b949-39447a182c5a	t	UL.GEN.WL.SIKORSKIEGO 12 SOKOLY, POWIAT WYSOKOMAZOWIECKI 18-218
-b2d95f32-ddc1-4755-bfd6-37d19a820b4f	BSSTPLP1XXX	8e035646-adba-4018-a621-59f26fb05334	t	UL.WYZWOLENIA 6  STRZEGOWO, MAZOWIECKIE, 06-445
-c2d70178-b828-41fe-854e-e7b04e30e511	BSTRPLP1XXX	355c6bc8-63e6-4e22-ad72-516ad6c8fdeb	t	UL.SZYMANOWSKA 14  TERESIN, MAZOWIECKIE, 96-515
-12caddc4-ccf8-4a92-bb64-f04b9a19defc	BSWPPLP1XXX	01b150bd-4722-4898-aabc-e85594674178	t	UL.BARLICKIEGO 33  KUTNO, LODZKIE, 99-300
-312155c4-cf25-476e-a1b0-15015624cf99	BSWRPLP1XXX	ea51eca9-3bb0-4d1b-8593-e04d6e2313fa	t	WARSZAWSKA 5  WARKA, MAZOWIECKIE, 05-660
-0ba9452a-8ed8-41e7-b4e5-047151b157f6	BSZLPLP1XXX	d3bb3ce4-328c-4391-b245-b1e26fa4dac8	t	STARY RYNEK 18  LOWICZ, LODZKIE, 99-400
-22163e28-7136-4cf9-ac8d-c8ebfbdfb0ef	BUAABGS1XXX	52ba4fff-75ab-4cb6-b657-a3d4e42e6a6a	t	SHEINOVO STR 7  SOFIA, SOFIA, 1504
-f87f7439-8a28-4e86-975f-0e66c3c05520	BUINBGSFXXX	7a303a69-d608-4794-ae8c-d276932c1c8d	t	SREBARNA 16 PARK LANE OFFICE CENTER, FLOOR 6 SOFIA, SOFIA, 1407
-0bd08dbb-8ad3-4805-8e5a-0270466c367f	BUIVBG21XXX	a5a2ac68-3cb0-4e5b-ad9c-638de4a37354	t	PETAR PARCHEVICH 9  VARNA, VARNA, 9000
-f306a72d-5a30-473d-9a1c-7edfc5381685	BUTKBGS1XXX	1c47a282-ed9f-4a0d-8454-b017ab6c7d3a	t	ZLATEN ROG STREET 12  SOFIA, SOFIA, 1407
-2dcef2e4-189e-41f0-9b62-5ee185f00440	CAIJBGS1XXX	09f2c701-b0f2-4ea9-a620-1863fd3a5a3b	t	STEFAN KARADJA 2  SOFIA, SOFIA, 1000
-ace3998f-9f83-4d77-8ac3-359a6bd91a4d	CAIXPLPWXXX	6c19a1c0-c938-49a2-a7f3-3c9575f8fd40	t	UL. PROSTA 51  WARSZAWA, MAZOWIECKIE, 00-838
-b5f980dd-4bd2-4704-992f-e865ca65d01f	CAMCCLR1XXX	994b800d-9b01-4fb0-87e7-b25558fc9911	t	
-c00639d0-4aa3-4eb7-b0b3-a53603b631a8	CAMJBGS1XXX	5c3b8a1b-ed6c-4ed9-a7dc-c5ec5c5ab85a	t	HRISTO BELCHEV STR 8  SOFIA, SOFIA, 1000
-492f7c27-8ef7-4381-acd8-0e12ac6453fa	CBARAWAWXXX	21a30c16-0db9-4c00-b8a0-e33ebe4bbeaf	t	J.E. IRAUSQUIN 8  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-e3b5b231-54e3-42d7-9263-06813a1c4453	CBBRLV22OVE	c2f3223d-94c8-45d9-8df7-51eba83ba6ca	f	SMILSU IELA 6  RIGA, RIGA, LV-1050
-86abef67-7c8c-4d2d-a069-bd387744f0da	CBBRLV22TIP	c2f3223d-94c8-45d9-8df7-51eba83ba6ca	f	SMILSU IELA 6  RIGA, RIGA, LV-1050
-a280d0ea-6bf7-4621-b4d9-dbb7dfe6f4ee	CBBRLV22XXX	c2f3223d-94c8-45d9-8df7-51eba83ba6ca	t	SMILSU IELA 6  RIGA, RIGA, LV-1050
-bc59e468-43be-4a30-ad6d-5f6f5b8addd2	CBCUUYMDXXX	ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	t	FABINI 777  MONTEVIDEO, MONTEVIDEO, 11000
-7f1c028a-2a9a-4c82-89e6-2d82073b57be	CBCUUYMFXXX	ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	t	FABINI 777  MONTEVIDEO, MONTEVIDEO, 11100
-c693dfde-f033-4dd1-ba85-5b1500dac3bc	CBCUUYMMFGD	ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	f	MONTEVIDEO, MONTEVIDEO
-2c7cbce4-d2e2-4d24-a42e-a69c8b34e2b9	CBCUUYMMGPA	ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	f	FLORIDA PAYSANDU MONTEVIDEO, MONTEVIDEO, 11000
-3349a8a9-9d16-42ee-897f-47e5d54e51d7	CBCUUYMMXXX	ae5264ad-d488-4cf6-a5cd-e40ef4202d3a	t	FLORIDA PAYSANDU MONTEVIDEO, MONTEVIDEO, 11000
-9bc6ecc1-4744-4f4f-b218-5e3ee8e86582	CBEUUYMM0ME	dce712c1-edea-4d04-a3d5-28f7509d044b	f	MONTEVIDEO, MONTEVIDEO
-93a122b0-dac1-4097-a325-71de22a0b3fe	CBEUUYMM0MG	dce712c1-edea-4d04-a3d5-28f7509d044b	f	18 DE JULIO 1459  MONTEVIDEO, MONTEVIDEO, 11200
-1fb0ef6d-3a61-4b55-b444-323280651a77	CBEUUYMMXXX	dce712c1-edea-4d04-a3d5-28f7509d044b	t	18 DE JULIO 1497  MONTEVIDEO, MONTEVIDEO, 11200
-d598913c-4582-4bf1-909e-f287913c3864	CBOPPLP1XXX	91d89728-c686-4607-b879-3d5b1f1bccbc	t	WOLOSKA 18  WARSZAWA, MAZOWIECKIE, 02-675
-c7426afa-932d-4ecc-93a5-fb7d69cead9e	CBSCCLR1XXX	5686085f-fb72-4a1e-bad5-374505cabae8	t	
-2ed66d09-ded3-472f-a113-8160f85815b5	CCBPMCM1XXX	56d91e62-748e-4a24-aa51-31a06a2d5fa3	t	SPORTING D'HIVER PLACE DU CASINO MONACO, MONACO, 98000
-18dac37d-7419-4293-85a1-f92dee501f14	CCOACLR1XXX	4c352901-4ae8-47e0-a42f-dddc07b64a8b	t	
-e52ed24e-4101-4210-bed7-ec56eb0cfa55	CCTUCLRRXXX	1d1440de-ab50-4ead-bca2-87317212e9bc	t	
-cd9baabe-0dd4-4cb4-8b02-7620481abf25	CCUHMTMTMBB	4987a579-104d-441f-b2d8-842c612e5b60	f	FLOOR GF, EWROPA BUSINESS CENTRE TRIQ DUN KARM BIRKIRKARA, BIRKIRKARA, BKR 9034
-0f8f6c23-d67d-4060-be20-d084efe55317	CCUHMTMTXXX	4987a579-104d-441f-b2d8-842c612e5b60	t	FLOOR GF, EWROPA BUSINESS CENTRE TRIQ DUN KARM BIRKIRKARA, BIRKIRKARA, BKR 9034
-5aec6ab8-7ceb-41c7-8eae-5a20b617ab38	CDERCLRGXXX	1514d150-8641-459a-bf17-4627015d405f	t	CERRO COLORADO 5240, FLOOR 18 TORRES DEL PARQUE I - SANTIAGO PROVINCIA DE SANTIAGO, 7560591
-f07944d2-193e-44cc-85da-6028bfdcdc26	CDERCLRMXXX	1514d150-8641-459a-bf17-4627015d405f	t	CERRO COLORADO 5240, FLOOR 18 EDIFICIO TORRES DEL PARQUE 1 SANTIAGO, PROVINCIA DE SANTIAGO
-6cf69600-50bd-4c77-8949-b1c037c570d8	CDISALTRXXX	144100c9-796b-46aa-a650-1ab92914eecc	t	VASO PASHA 8  TIRANA, TIRANA, 1019
-e3059fa4-8d45-4ebe-9296-ff34d33cd0cd	CECBBGSFXXX	fda4fffc-41b8-44f2-babf-627f25d54901	t	TSARIGRADSKO SHOSE BLVD 87  SOFIA, SOFIA, 1113
-5e90a23d-91ee-42c7-9af1-5dee3c955280	CEDPBGSFCLX	5b1d1706-c235-4510-a5e7-19474637faf0	f	TRI USHI STREET 6  SOFIA, SOFIA, 1000
-feaf6f4c-e2ec-4fee-a58b-24ee567385bd	CEDPBGSFXXX	5b1d1706-c235-4510-a5e7-19474637faf0	t	TRI USHI STREET 6  SOFIA, SOFIA, 1000
-e39ea55c-760b-4204-8cee-185f2d25e39c	CELFCLR1XXX	8fccd80e-8683-4928-b2a4-735e188fbe4a	t	
-b9850ed2-95b8-4a44-86fb-55aa507df1c2	CEPAMCM1XXX	9314fa70-596b-49f0-8103-f3ca5387e441	t	17 BOULEVARD ALBERT PREMIER  MONACO, MONACO, 98000
-f3cc9367-ad34-413c-8c43-95c19fc23a4f	CESDMTM1XXX	6c92ded4-47ef-48f1-ad28-65ce2ef5cc32	t	27 PIETRO FLORIANI STREET  FLORIANA, IL-FURJANA, FRN 1060
-bf3fcd7d-b395-402c-8304-dd9d584ffa22	CFACUYMMXXX	f4fffe4c-fe7d-40f0-b7a0-b55064042ba5	t	ZABALA 1338  MONTEVIDEO, MONTEVIDEO, 11200
-06b5271e-a80b-4ef8-83da-2782b7022397	CFCOUYM1XXX	64643800-f336-448b-b830-068efc3c77e1	t	SARANDI 402  MONTEVIDEO, MONTEVIDEO, 11000
-5d5c35df-d4b2-47bc-ac7b-db02a6511747	CFMOMCMXXXX	b7ff3069-5ad2-4f5c-9d75-9db7060fd5d5	t	11 BOULEVARD ALBERT IER  MONACO, MONACO, 98012
-07dddb3f-c65b-4289-9d5d-12d1191ff7f0	CFPCCLR1XXX	4e9ed172-48e6-484d-8d5d-b7ae24515adf	t	
-659e734b-3a8c-430d-9a61-0da654623c73	CFTEMTM1XXX	cb4b1c12-4a3a-4ec4-808a-3b132363fc6f	t	FLOOR 3 137 SPINOLA ROAD - ST JULIAN'S, ST. JULIAN'S, STJ 3155
-b01c6288-4ee4-4527-8812-cfaf37c699d3	CFTEMTM3XXX	cb4b1c12-4a3a-4ec4-808a-3b132363fc6f	t	FLOOR 3 137 SPINOLA ROAD - ST JULIAN'S, ST. JULIAN'S, STJ 3140
-1c03bf62-5b93-4d4e-8989-04dd0b7d193d	CGPMMCM1XXX	2f7015e2-11e0-430f-bad3-af1f632c92c0	t	ESCALIERS DES FLEURS 8 BLD DES MOULINS MONACO, MONACO, 98000
-5ed90f06-3b0f-417b-a63b-0a8fa8af89d3	CHASCLRMAMM	6da719ac-60b7-48ec-9903-e66d4497e419	f	AV. APOQUINDO 2827, FLOOR 13 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 7590956
-6505e800-8f9d-4d9c-bae8-84b187293281	CHASCLRMCDB	6da719ac-60b7-48ec-9903-e66d4497e419	f	AV. APOQUINDO 2827, FLOOR 13 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 7590956
-6bbedccc-4561-42c8-ba8d-937e404ffce8	CHASCLRMICM	6da719ac-60b7-48ec-9903-e66d4497e419	f	AV. APOQUINDO 2827, FLOOR 13 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 7590956
-5e973a57-376c-45b2-ae57-9becddb52856	CHASCLRMXXX	6da719ac-60b7-48ec-9903-e66d4497e419	t	AV. APOQUINDO 2827, FLOOR 13 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 7590956
-822f9739-44fb-46b2-9b3e-73b5ef9dacbb	CINKPLPZXXX	83bdf44b-2cdf-4d23-a3ef-fff6be9640a8	t	WROCLAWSKA 17B  ZIELONA GORA, WIELKOPOLSKIE, 65-427
-a447c658-2e7d-4e91-8de8-3cb41c4e81e3	CISRMTM1XXX	5e421d61-af50-467d-8bc2-779ee08b9833	t	FLOOR 1 58 MERCHANTS STREET VALLETTA, VALLETTA, VLT 1173
-af5968d9-3a67-4a29-bd27-aa9549a5e8f5	CITCMTMTXXX	9038ccf5-b7fb-4ecb-ba3c-b0bfa96ca48f	t	LEVEL 2 WEST, MERCURY TOWER ELIA ZAMMIT STREET - ST. JULIAN'S, ST JULIAN'S, STJ 3155
-a77661b4-5a53-4154-8c37-640c7ba84b6e	CITIBGSFTRD	68c3ff55-3943-48b6-a4a9-fecc6a1dd074	f	SITNYAKOVO BLVD 48 SERDIKA OFFICES, FLOOR 10 SOFIA, SOFIA, 1505
-93808b1e-db59-4230-ad50-59d2f952e6ba	CITIBGSFXXX	68c3ff55-3943-48b6-a4a9-fecc6a1dd074	t	SITNYAKOVO BLVD 48 SERDIKA OFFICES, FLOOR 10 SOFIA, SOFIA, 1505
-32990d89-c60b-4565-b3a3-6a4fb6856de4	CITIPLPXBRO	fdbac88b-82fc-4955-be56-a19569794e55	f	SENATORSKA 16  WARSZAWA, MAZOWIECKIE, 00-923
-06cd13e2-6007-4bd4-93de-218abb00c3d0	CITIPLPXCCH	fdbac88b-82fc-4955-be56-a19569794e55	f	WARSZAWA, MAZOWIECKIE
-1c9b3905-9cbc-4aa6-8e52-aa685689ae3a	CITIPLPXXXX	fdbac88b-82fc-4955-be56-a19569794e55	t	SENATORSKA 16  WARSZAWA, MAZOWIECKIE, 00-923
-99e94d62-a2c0-43b3-aa0d-43d77c1c2983	CITIUYMMCOL	9bc30334-19bb-46d7-bd57-49ccdeec36a8	f	COLONIA, COLONIA
-08f24b9b-fe70-4115-8304-410e18eaea70	CITIUYMMPDE	9bc30334-19bb-46d7-bd57-49ccdeec36a8	f	PUNTA DEL ESTE, MALDONADO, 20000
-a0334467-55ff-4b44-b7c2-1279ea7b9737	CITIUYMMXXX	9bc30334-19bb-46d7-bd57-49ccdeec36a8	t	CERRITO 455 CIUDAD VIEJA MONTEVIDEO, MONTEVIDEO, 11000
-8b8959ba-9dd3-4d09-9b01-fd5551d69889	CJNOUYM1XXX	a5dd93b9-b6b1-4aab-8b2b-c8cee9bed4ea	t	18 DE JULIO 1730 FLOOR 10 MONTEVIDEO, MONTEVIDEO, 11200
-c736b0b0-5783-4949-b395-b73114d1ea1c	CJPBUYM1XXX	4cb06b2b-59e5-4731-a8ba-16d9a74812a8	t	CIRCUNVALACION DURANGO 314  MONTEVIDEO, MONTEVIDEO, 11000
-6978115a-aa39-4bbf-b733-7748953623b4	CJPPUYM1XXX	d7681cf5-32f3-4513-93b5-feca762895b6	t	ANDES 1521  MONTEVIDEO, MONTEVIDEO, 11100
-25f58e47-2bec-47c2-9151-d3aa30009f18	CLSMMCM1XXX	5e023531-b23b-4ac0-9ef6-70e601bc767d	t	LES VILLAS DEL SOLE 47-49 BOULEVARD D'ITALIE MONACO, MONACO, 98007
-6fed21e4-7198-4fe8-806f-a4b4875bda37	CMBAAWAXXXX	58663c86-fbdb-44eb-9816-6f471b95cd85	t	KAYA GILBERTO FRANCOIS CROES 53 ORANJESTAD, ORANJESTAD-WEST AND ORANJESTAD-EAST
-6063278e-1c2b-485a-a09e-2ec90836749b	CMBMMCMXXXX	4bca4f0f-944b-494a-b9fb-cc503f9e2e8c	t	17 AVENUE DES SPELUGUES  MONACO, MONACO, 98000
-99970672-3361-427a-b80f-c2fe3ab4374a	CMCIMCM1BEC	605b0c1b-d629-47aa-a9a5-fef43795769a	f	8 RUE GRIMALDI  MONACO, MONACO, 98000
-8b31b83c-3145-4a4d-b5e3-845d14d3aa85	CMCIMCM1LYB	605b0c1b-d629-47aa-a9a5-fef43795769a	f	26 BOULEVARD PRINCESSE CHARLOTTE  MONACO, MONACO, 98000
-e45cafc0-2da9-4f94-895e-8d68c7fc9e2c	CMCIMCM1XXX	605b0c1b-d629-47aa-a9a5-fef43795769a	t	RUE GRIMALDI  MONACO, MONACO, 98000
-7f3fc658-8678-41fa-b510-c3b87df236b3	CMMDMCM1XXX	3538a195-c238-46b5-a5e9-277f17dbabcb	t	15 AVENUE DE GRANDE BRETAGNE  MONACO, MONACO, 98000
-1b02ca51-7b46-4560-abc9-bd469dfe31f6	CNAEBGS1XXX	ef7146ac-3ea6-4620-9e54-0b1cf5cb9df6	t	TODOR ALEXANDROV BLVD 141  SOFIA, SOFIA, 1309
-5934093b-f320-435f-bfac-212c4fcfb2be	CNCCCLR1XXX	5be17037-edb3-4815-bcad-67be9f208946	t	
-48c7b447-c64c-430a-b9b3-467cbf8a7c56	CNCOCLR1XXX	e25347fe-8e99-4357-a65c-42c6d741dc1f	t	EL BOSQUE SUR 130, FLOOR 13 EDIFICIO CONSORCIO - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-6a6f7017-099f-41eb-b8ed-39a5839c1aae	CNENPLP1XXX	9867b1da-bff7-4adf-90be-3fa7b33e7f09	t	SZYPERSKA 14, FLOOR V  POZNAN, WIELKOPOLSKIE, 61-754
-b7057be4-9ab5-440e-a89b-f2b2cb446ac5	COIJBGS1XXX	7f6ee1dc-e3fd-44cc-8a5a-eac64d009b7d	t	GEORGE WASHINGTON 19  SOFIA, SOFIA, 1000
-71024ee2-a8e0-4220-aaf7-8546df733c66	COMEUYMMALL	ec061a6c-320e-4dce-80cf-53d4c92675a6	f	MONTEVIDEO, MONTEVIDEO
-ae0e7c19-2be2-40a9-908d-a172bc70049d	COMEUYMMXXX	ec061a6c-320e-4dce-80cf-53d4c92675a6	t	MISIONES 1399  MONTEVIDEO, MONTEVIDEO, 11000
-65268038-9119-4877-bed1-2ffa990a6c00	CONBCLRMXXX	bd5d26ca-429a-4245-bb2d-ebf61ddeee62	t	PRESIDENTE RIESCO 5537, FLOOR 7 EDIFICIO NUEVA LAS CONDES, LAS SANTIAGO, PROVINCIA DE SANTIAGO
-78f09c5d-31d6-4b15-a46f-d0a0c6846e52	CONPPLPWXXX	f283f101-8dff-4e0e-a250-9950cbaccf47	t	WROCLAWSKA 17B  ZIELONA GORA, WIELKOPOLSKIE, 65-427
-1fbb2365-abc7-49ee-bf4a-cbe199160318	COPAUYM1XXX	ace98c76-24e4-47ef-84ee-1e0d5c344b2a	t	DIAGONAL FABINI 777  MONTEVIDEO, MONTEVIDEO, 11100
-c3ac9013-f45a-4bdd-8aa0-ab53a58ba8f5	COPXMTMTXXX	0438a9fb-9ad9-4214-9df9-a83dfd337ae2	t	144 TOWER ROAD  SLIEMA, SLIEMA, SLM 1604
-afdd271a-2798-402f-ae3d-fac5c64a0736	CPNMBGS1XXX	dbb67ab3-8074-47f7-b6bd-ae3f96bacd54	t	TRI USHI 8  SOFIA, SOFIA, 1000
-8c356d6e-9f8b-400c-9c75-43a796d2c034	CPSCMTM1XXX	c62a6732-c3cd-4796-bdfc-8a8e77f5a7d3	t	FLOOR 1, ORANGE POINT DUN KARM STREET BIRKIRKARA, BIRKIRKARA, BKR 9037
-73e932e5-e6c5-4439-9a4a-83495b02228f	CPSMBGS1XXX	040ff9b6-b28f-4c08-b59b-9a8f2bf6032a	t	TRI USHI STR 8  SOFIA, SOFIA, 1301
-376ed02a-d5e4-4db9-a0d2-2214299faa14	CRBAALTRXXX	f3aef98e-ca83-4758-9c8a-7d63766f7c64	t	TIRANA, TIRANA
-7fa4363a-64b3-4b76-9f4b-e88403853070	CREDCLRMXXX	8896f848-e2e1-4268-b3ba-97013ed4a453	t	
-615838c4-1ac1-4d5b-a0e7-a727312be77d	CREXBGSFXXX	1e54bd07-a5f3-41f1-a575-bd03ab143768	t	GEORG WASHINGTON 21  SOFIA, SOFIA, 1000
-bff0846a-928e-43de-bee7-db5176be5afa	CRFVMTM1XXX	b4641c52-9972-4920-ad93-598f84c6a4ae	t	6 FREEDOM SQUARE  VALLETTA, VALLETTA, VLT 1060
-ee582f48-205b-4f21-9968-729d4ad124a6	CRLYMCM1BAS	45139756-a593-4265-8e2b-4d01e59f3420	f	21 AVENUE DES BEAUX ARTS  MONACO, MONACO, 98000
-957041ae-9831-455b-8e6e-8b515b2866ab	CRLYMCM1CAE	45139756-a593-4265-8e2b-4d01e59f3420	f	1 AVENUE DES CITRONNIERS  MONACO, MONACO, 98000
-96e2578e-79d7-4648-b217-c150547824c6	CRLYMCM1FVI	45139756-a593-4265-8e2b-4d01e59f3420	f	13 AVENUE DU PRINCE HEREDITAIRE  MONACO, MONACO, 98000
-ca13d004-a3b6-4437-90d3-e5c867e9aeac	CRLYMCM1LCM	45139756-a593-4265-8e2b-4d01e59f3420	f	7 AVENUE PRINCE PIERRE  MONACO, MONACO, 98000
-0a3300b1-976d-4174-a1e2-4086a4e01879	CRLYMCM1LMO	45139756-a593-4265-8e2b-4d01e59f3420	f	PLACE DES MOULINS  MONACO, MONACO, 98000
-0c57fb2d-8044-48a5-bf8c-beb5f1f96a07	CRLYMCM1MCA	45139756-a593-4265-8e2b-4d01e59f3420	f	2 AVENUE PRINCESSE ALICE  MONACO, MONACO, 98000
-6f56d50b-3bc1-41b6-8f57-c8d92d1e302d	CRLYMCM1XXX	45139756-a593-4265-8e2b-4d01e59f3420	t	1 AVENUE DES CITRONNIERS  MONACO, MONACO, 98000
-3f45b187-b0c3-4d98-92e6-cc34007e1399	CRRBCLR1XXX	89b670a3-71e2-4da1-9ddf-e9b523d47d90	t	
-452cccb6-5f05-4c58-98fe-6dd98955f5fe	CRSGMCM1XXX	36b71e58-47f8-44eb-9c59-145f927cb304	t	MONACO, MONACO, 98000
-f56091a4-d340-41d4-bf78-226922fc215a	CRXBMTMTXXX	9ae25edc-32bf-4005-8848-ee78336ac93c	t	PALAZZO HOMEDES 80 STRAIT STREET VALLETTA, VALLETTA, VLT 1436
-f048500c-68b2-49ac-b905-0419ab85537c	EBOSPLPW258	cac3d68c-b522-449c-b72b-9bd70169f229	f	LEGNICA, DOLNOSLASKIE
-4be3d0a4-2a19-4683-bd59-583a7ca7f6a5	CSMEBGS1XXX	864e0cfb-05ed-4e98-9705-507e64f40249	t	STEFAN KARADZHA 2  SOFIA, SOFIA, 1000
-4fe0baf3-ab38-4a37-ad3d-b7afccc00d6b	CSSNPLP1XXX	0c78b09a-77fd-4f1b-9947-3b55ce92cd75	t	SZKOLNA 5/13  POZNAN, WIELKOPOLSKIE, 61-832
-59c802a1-f368-4c39-8b48-e08856f45bfd	CTBEMTM1XXX	005c4593-0eb7-4eee-8ed3-2aa1f6c04031	t	FLOOR 3, STRAND TOWERS 36 THE STRAND SLIEMA, SLIEMA, SLM 1022
-2703b16c-9d63-4f0d-be82-722fb4cec016	CTBSPLP1XXX	aadc0543-7b08-4a2a-ae94-bc00dd24e760	t	CHLODNA 64  WARSZAWA, MAZOWIECKIE, 00-872
-b104530a-457b-4bd0-87ac-36d6ea40daba	CUIMPLP1XXX	f3dace05-8885-4877-980d-d1050146c675	t	UL. PROSTA 70  WARSZAWA, MAZOWIECKIE, 00-838
-157788a2-f84c-4972-9c9a-127e02a965e2	CULMPLP1XXX	ce1ba3d0-e6ef-4a20-ac99-3fbf291e4b13	t	UL. DWORCOWA 5  CHELMNO, KUJAWSKO-POMORSKIE, 86-200
-1004217a-2e09-4239-b1ae-fdb18b2c3b6f	CULRMTMMXXX	67c2d64f-7c2e-4053-9a16-4c1ca54acd71	t	UNIT A, GROUND FLOOR Q2, TIGNE POINT SLIEMA, TAS-SLIEMA, SLM 3190
-e3394c0c-3e7e-420c-920f-a2df72204d10	CURPMTM1XXX	48d4f5f0-83f3-44f6-bfc9-36b320bb7dbe	t	FINANCE HOUSE PRINCESS ELIZABETH STR TA'XBIEX, TA'XBIEX, XBX 1102
-3fd128fe-ab70-4cb5-8f33-6d5c2f0ab118	DABAPLPWXXX	a1287539-02f9-4459-8060-52f67a924695	t	WSPOLNA 70  WARSZAWA, MAZOWIECKIE, 00-687
-426fdc79-2258-4d11-a41d-fc24b7e85fef	DBINMTM1XXX	70e6d307-c7e2-4a91-8898-e72ea2e1c816	t	DEBER NIGRET ROAD  ZURRIEQ, ZURRIEQ, ZRQ 3172
-6a8f4a87-18d9-4868-9335-7d9755c804b9	DCVVCLRMXXX	a6f0bdef-89f8-434f-b559-5d771361f626	t	
-a4077e6a-b68b-4152-9d3f-39c67a0385e9	DDPPPLP1XXX	3db4b087-da98-49a1-b940-20bd230f1267	t	LOK. 313 PL. DABROWSKIEGO 1 WARSZAWA, MAZOWIECKIE, 00-057
-ea97e211-a050-4dc8-9284-f70c05eccc87	DECVUYM1XXX	3950f25f-ea80-4000-8537-598d147121ba	t	DIAGONAL FABINI 777  MONTEVIDEO, MONTEVIDEO, 11100
-70cc2f5c-555d-4f6d-8f64-c88d0306b75d	DEEABGS1XXX	ebe881d6-862e-4289-bc68-4088c4b01a0d	t	TSAR OSVOBODITEL BLVD 8 FLOOR 4 SOFIA, SOFIA, 1000
-588f6e7b-8cb2-4c70-9bb7-ed28f15e20c9	DEMIBGSFXXX	be2ccee5-ea81-4cf6-a1f9-443f97c8aa66	t	GENERAL TOTLEBEN BLVD 8  SOFIA, SOFIA, 1606
-ce5adf63-c88f-471d-83f5-03e2cc4829fd	DETTBGS1XXX	612cb31b-a857-48d0-af25-e4611dbcc07e	t	KORAB PLANINA 6 MEGAPARK SOFIA, SOFIA, 1407
-7f108c05-28c5-41a9-8ab1-33289fed7a07	DEUTPL21XXX	bee6256a-ffab-4c06-95e4-b07fb9fb3061	t	ALEJA ARMII LUDOWEJ 26  WARSZAWA, MAZOWIECKIE, 00-609
-3dbbbe68-5a1b-40ad-b796-e1cfdacb0e50	DEUTPLPXXXX	39c57dab-e24c-489f-a2a3-635e10fedb93	t	FOCUS BUILDING AL. ARMII LUDOWEJ 26 WARSZAWA, MAZOWIECKIE, 00-609
-49a2b264-f88d-4781-af32-032237aafdb9	DEUTUYM1XXX	7ab83814-97cb-429d-bd9f-dd268838db54	t	AVDA. LUIS DE HERRERA 1248 OF. 1902 MONTEVIDEO, MONTEVIDEO, 11300
-bf492abd-3458-43f9-abcc-df330903749f	DIBRPLP1XXX	6c2177ad-412a-4ff4-b6d6-fb1a754ecd08	t	WSPOLNA STREET 47/49  WARSZAWA, MAZOWIECKIE, 00-684
-290b9014-baa4-44ca-a113-1ec51fb6ac32	DIETMCMCXXX	b94c0904-39d7-4ca5-8e45-e5fda03928a6	t	GILDO PASTOR CENTER 7, 9 RUE DU GABIAN MONACO, MONACO, 98000
-c8725a19-4ce6-41a7-8885-6567ad46bba6	DIEUMTMTXXX	d6c0dc31-9f27-46d0-b04b-4c5f52d73042	t	FLOOR 3, W BUSINESS CENTRE TRIQ DUN KARM BIRKIRKARA, BIRKIRKARA, BKR 9033
-a8fbcb61-f19d-436f-8f1b-766f85c335ad	DIFKBGS1XXX	26628782-78d8-4d68-b8c0-46fdd4c99143	t	TZAR ASSEN STR 7  SOFIA, SOFIA, 1000
-3c51ba48-1327-466b-8239-0f8838cbb3b5	DISJBGS1XXX	35ac3f72-4204-4a76-8fd4-9c3233adee46	t	TSAR OSVOBODITEL BLVD 8 FLOOR 4 SOFIA, SOFIA, 1000
-39df6557-7f6f-4af5-a60d-7c00d0d6ba16	DMAFPLP1XXX	7676d701-5228-4e9f-b566-1129adac13cb	t	PANSKA CORNER PANSKA 73 WARSZAWA, MAZOWIECKIE, 00-834
-7a94280b-6a39-4d5e-a83c-6a8f30191cfe	DMOSPLP1XXX	9e6b7333-3157-4384-b810-87f35d899049	t	MARSZALKOWSKA 78/80  WARSZAWA, MAZOWIECKIE, 00-517
-454bad7c-7d59-4812-8fc7-69fd3f712da3	DMPNPLP1XXX	28ce7da2-bb19-4bd4-9996-1fb4079cb3c5	t	SZPITALNA 40  KRAKOW, MALOPOLSKIE, 31-024
-2284082c-9e87-4803-abc7-ac1d683d23f0	DMTBPLP1XXX	59b91139-efaf-40d8-a6da-dd5dcf885670	t	MILLENNIUM PLAZA AL.JEROZOLIMSKIE 123 A WARSZAWA, MAZOWIECKIE, 02-017
-be6910a3-b522-4d03-b79c-0d5a7e0343c8	DOAEMTM1XXX	3db61e09-061a-43dd-bf51-c7ceba95fcc5	t	FLOOR 5 89 ST. JOHN'S STREET VALLETTA, VALLETTA, VLT 1165
-cde13739-9ba1-4ae6-8409-fa4d94f8de12	DOAVMCMCXXX	7f3006e7-552b-4e87-a399-8350138bb1ea	t	LES CARAVELLES  MONACO, MONACO, 98000
-22bac90d-a50c-43fa-a8ed-b7532593c054	DOIIPLP1XXX	7739648b-492f-4860-ab0a-4fd9542f0c72	t	ZEBRA TOWER MOKOTOWSKA 1 WARSZAWA, MAZOWIECKIE, 00-640
-2c13afe0-6b95-43c9-8dfd-5b19d6eaa0e1	DOINPLP1XXX	99e7ec90-4c6f-4769-b601-d9d0c688cf4c	t	RETORYKA 7  KRAKOW, MALOPOLSKIE, 31-108
-14c13a68-e42b-4520-b798-52bdc0acfea6	DOIXPLP1XXX	88ceb6cd-3713-4c6f-8b72-af5b1bd60083	t	PULAWSKA 107  WARSZAWA, MAZOWIECKIE, 02-595
-2c144a0c-2dc9-40ad-bd08-49202a01e7e0	DOMDPLP1XXX	8d8016d6-86b5-465c-b137-a0eb2b657276	t	MAWY RYNEK 7  KRAKOW, MALOPOLSKIE, 31-041
-e7849865-2503-4c8b-8d6d-13cc788af866	DOMHPLP1XXX	9c5c9e17-28f4-4da6-835b-bd3c3d3d66c8	t	UL. CHALUBINSKIEGO 8  WARSZAWA, MAZOWIECKIE, 00-613
-e5522d25-2bb3-4056-936b-62b5ea5f9947	DOMKPLP1XXX	91d89728-c686-4607-b879-3d5b1f1bccbc	t	WOLOSKA 18  WARSZAWA, MAZOWIECKIE, 02-675
-13ce1f8f-182b-4876-b963-c675e40bd390	DOMNPLP1XXX	d8292258-139a-4b1a-8663-62b0409c531e	t	KOSZYKOWA 54  WARSZAWA, MAZOWIECKIE, 00-675
-3c2d56dc-7c54-4e94-aba5-300537cf457e	DOMPPLP1XXX	469b9413-9787-485e-94bd-7197228fb234	t	GREY HOUSE RYNEK GLOWNY 6 KRAKOW, MALOPOLSKIE, 31-042
-10cbf5de-78fd-4f6e-8bcc-0afccefc296e	DOMSPLP1XXX	9f3636c7-2fab-4fdd-90df-8fff0ff44e54	t	PLOCKA 9/11B  WARSZAWA, MAZOWIECKIE, 01-231
-d12db03a-68d0-476b-aa3d-daf7795caaa6	DPCDBGS2XXX	30fbaabb-c669-45fe-a08d-af8b35b32780	t	TSARIGRADSKO SHOSSE 115A FLOOR 6-TH SOFIA, SOFIA, 1784
-02419a5f-7c6b-427e-85a2-bdef685175f3	DSCBCLR1XXX	8e2ece47-6ba8-41d5-a315-d4a2eef36ea8	t	AVENIDA EL BOSQUE SUR 130, FLOOR 5 CONSORCIO - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-b060d274-57c6-4985-862f-004061dc7f56	DZLKPLP1XXX	25bcc898-d0ab-4a3f-840b-cb334d170652	t	NOWY RYNEK 18  - LIDZBARK, POWIAT DZIALDOWSKI, 13-230
-b10557ba-1f84-4f8c-99ca-95230ba53a7e	EAMGBGS1XXX	f63c571e-fccd-4c7a-8105-55a20b82bcb7	t	G.S. RAKOVSKI STR 96A  SOFIA, SOFIA, 1000
-faec6fd6-8798-4782-9815-7746449fe776	EAPSBGS2XXX	c2ec522b-6f50-440a-b8b3-1c3ccf334185	t	JAWAHARLAL NEHRU BLVD 28, 7 LYULIN RESIDENTIAL AREA, FLOOR 2, OFFICE SOFIA, SOFIA, 1324
-1e14c20b-26c2-42ba-b348-ae78bacbd658	EBOSPLPW005	cac3d68c-b522-449c-b72b-9bd70169f229	f	CZESTOCHOWA, SLASKIE
-a515fe21-39a3-4d70-a4ab-f3f4ad44a726	EBOSPLPW017	cac3d68c-b522-449c-b72b-9bd70169f229	f	WARSZAWA, MAZOWIECKIE
-16a6dae4-0f53-47c0-8403-edc17adccc76	EBOSPLPW018	cac3d68c-b522-449c-b72b-9bd70169f229	f	BYDGOSZCZ, KUJAWSKO-POMORSKIE
-7c3c97a1-04de-48c7-acbd-15e98d8594af	EBOSPLPW021	cac3d68c-b522-449c-b72b-9bd70169f229	f	WROCLAW, DOLNOSLASKIE
-e40edb2f-e34e-4bb5-8576-d2cfb1bd8a96	EBOSPLPW034	cac3d68c-b522-449c-b72b-9bd70169f229	f	KOSZALIN, ZACHODNIOPOMORSKIE
-4a924b1b-a307-44c3-9121-ee04f2013797	EBOSPLPW047	cac3d68c-b522-449c-b72b-9bd70169f229	f	POZNAN, WIELKOPOLSKIE
-7c3f3556-1f21-4c35-bb81-1cf9a0e7c8dc	EBOSPLPW050	cac3d68c-b522-449c-b72b-9bd70169f229	f	WLOCLAWEK, KUJAWSKO-POMORSKIE
-585a1291-abd4-433f-bc8e-ca49f82384f5	EBOSPLPW063	cac3d68c-b522-449c-b72b-9bd70169f229	f	OLSZTYN, WARMINSKO-MAZURSKIE
-0d3b6d48-570d-4aca-b402-b4798a9d97e1	EBOSPLPW076	cac3d68c-b522-449c-b72b-9bd70169f229	f	SZCZECIN, ZACHODNIOPOMORSKIE
-b6ca5ec1-5081-4a13-a9a6-a50069cadca9	EBOSPLPW089	cac3d68c-b522-449c-b72b-9bd70169f229	f	GDANSK, POMORSKIE
-efb0ee41-6e64-4434-a17e-2a646bb28c6b	EBOSPLPW102	cac3d68c-b522-449c-b72b-9bd70169f229	f	WARSZAWA, MAZOWIECKIE
-3c81d522-d876-4842-9410-9f389e4c048d	EBOSPLPW106	cac3d68c-b522-449c-b72b-9bd70169f229	f	KRAKOW, MALOPOLSKIE
-a97ae091-1bf1-4898-9e3d-f1916692e19c	EBOSPLPW119	cac3d68c-b522-449c-b72b-9bd70169f229	f	KATOWICE, SLASKIE
-ad29c3e6-1d9d-4dd6-81ee-6d90a7ab8592	EBOSPLPW122	cac3d68c-b522-449c-b72b-9bd70169f229	f	RZESZOW, PODKARPACKIE
-de7c1a51-70f0-43d7-bbc4-f2603337836c	EBOSPLPW135	cac3d68c-b522-449c-b72b-9bd70169f229	f	LUBLIN, LUBELSKIE
-c42a9073-c134-462e-b64e-a03999f45dd4	EBOSPLPW157	cac3d68c-b522-449c-b72b-9bd70169f229	f	WARSZAWA, MAZOWIECKIE
-cfebfaef-fe24-4edd-a9f0-7da816394013	EBOSPLPW199	cac3d68c-b522-449c-b72b-9bd70169f229	f	JELENIA GORA, DOLNOSLASKIE
-8d4b8d58-bba7-40e3-b4f8-ff4b1cb26a11	EBOSPLPW210	cac3d68c-b522-449c-b72b-9bd70169f229	f	OBORNIKI , WIELKOPOLSKIE, 64-600
-498b4e48-aa15-431e-bee6-2eb02cf18ee8	EBOSPLPW216	cac3d68c-b522-449c-b72b-9bd70169f229	f	BIALYSTOK, PODLASKIE
-d1a26f9e-20d5-44ab-97bd-7985f1bd04fc	EBOSPLPW223	cac3d68c-b522-449c-b72b-9bd70169f229	f	OSTROW WIELKOPOLSKI, WIELKOPOLSKIE
-c8709170-9faf-4e2b-b46f-2a01b149e5a7	EBOSPLPW229	cac3d68c-b522-449c-b72b-9bd70169f229	f	OPOLE, OPOLSKIE
-e09f841e-a710-4857-b9e0-7a5cd3d32c51	EBOSPLPW232	cac3d68c-b522-449c-b72b-9bd70169f229	f	KONIN, WIELKOPOLSKIE
-b8e878a0-9797-4d1d-9c01-e0e6003b634a	EBOSPLPW245	cac3d68c-b522-449c-b72b-9bd70169f229	f	LODZ, LODZKIE
-6aa29002-f0d0-4366-b062-86b59f5593bd	EBOSPLPW252	cac3d68c-b522-449c-b72b-9bd70169f229	f	TARNOW, MALOPOLSKIE
-008be73a-8222-4cc4-87ef-9a6541711f82	EBOSPLPW261	cac3d68c-b522-449c-b72b-9bd70169f229	f	BIELSKO BIALA, SLASKIE
-d3685111-86e2-4a71-a47c-961f1c0d2404	EBOSPLPW274	cac3d68c-b522-449c-b72b-9bd70169f229	f	ELBLAG, WARMINSKO-MAZURSKIE
-98f25f95-10d4-4cc9-81a6-8ea854d4c09b	EBOSPLPW287	cac3d68c-b522-449c-b72b-9bd70169f229	f	WARSZAWA, MAZOWIECKIE
-063a7abe-905f-4d00-b81e-4239865df895	EBOSPLPW290	cac3d68c-b522-449c-b72b-9bd70169f229	f	PLOCK, MAZOWIECKIE
-4bedaa86-c470-41fc-a135-80ed9ecde106	EBOSPLPW304	cac3d68c-b522-449c-b72b-9bd70169f229	f	TORUN, KUJAWSKO-POMORSKIE
-58b7a273-44c0-40c1-83ce-ac52a32d3155	EBOSPLPWCHA	cac3d68c-b522-449c-b72b-9bd70169f229	f	CHELM, LUBELSKIE
-48872808-ffd5-42fa-94e1-41de98ec87c7	EBOSPLPWKIA	cac3d68c-b522-449c-b72b-9bd70169f229	f	KIELCE, SWIETOKRZYSKIE
-79369e83-a6ca-4841-a858-6d16bbd1bd67	EBOSPLPWLOA	cac3d68c-b522-449c-b72b-9bd70169f229	f	LOMZA, PODLASKIE, 18-400
-2c5f5fba-d736-421e-b782-fe37775d54c0	EBOSPLPWPIA	cac3d68c-b522-449c-b72b-9bd70169f229	f	PILA, WIELKOPOLSKIE, 64-920
-546881a3-4d33-432e-a97c-fdf8d5a01c7f	EBOSPLPWSUA	cac3d68c-b522-449c-b72b-9bd70169f229	f	SUWALKI, PODLASKIE
-9ba89800-1365-40c9-9d3a-1457f8d8271a	EBOSPLPWXXX	cac3d68c-b522-449c-b72b-9bd70169f229	t	UL. ZELAZNA 32  WARSZAWA, MAZOWIECKIE, 00-832
-ff7c1d26-3cfd-4b2d-ad78-45f1d4917548	EBOSPLPWZAA	cac3d68c-b522-449c-b72b-9bd70169f229	f	ZAMOSC, LUBELSKIE
-fce8f165-0a33-4b17-a4cb-9e1f33ea4242	EBPAPLP1XXX	0e7b72ac-e7c0-462b-8a4c-ced8177d67da	t	UL KROLEWSKA 18  WARSZAWA, MAZOWIECKIE, 00-103
-bd2b187f-635e-463e-9f59-598d10660db4	ECCVCLRSXXX	19ca4e9d-29af-4f6e-b184-158521c5c5fd	t	
-4f20181f-b763-4570-a335-3cbcf112bd43	ECFEBG22XXX	b94350ae-2097-4367-86f4-f3a05ba2b5f4	t	SLAVYANSKI BLVD 16  RUSE, RUSE, 7000
-7d2bf2b5-4b4a-43a6-b26a-d9448f9732f1	ECMBMTMTXXX	dcbd8c84-8aee-4541-b2c3-a3e411453154	t	THE ADELAIDE 230-231 TOWER ROAD SLIEMA, SLIEMA, SLM 1601
-b0cfa4d5-d962-4364-aa70-d0c4aaece4fb	EDMBMTM2XXX	d22bee31-b94f-4f95-a8a3-665a8ca9ce01	t	FLOOR 1A, CENTRAL NORTH BUSINESS CENTRE SQAQ IL-FAWWARA SLIEMA, SLIEMA, SLM 1670
-8575b48e-17e9-417a-a682-bc57dc33c8e1	EEERBGS1XXX	c72d5465-19b0-4152-9ae4-708ea62f00fd	t	LACHEZAR STANCHEV STR 3 LITEX TOWER, FLOOR 13 SOFIA, SOFIA, 1756
-e0fb408a-7377-45b3-8b96-198e40599b7e	EFGBMCMCXXX	dc78d155-719a-4d13-be4c-a4e01446799b	t	VILLA DES AIGLES 15 AVENUE D'OSTENDE MONACO, MONACO, 98000
-bdeda075-863b-490a-ab57-367928d8aa14	EFTGMTM1XXX	461d65bf-6261-421d-9edc-b4a739b861d6	t	PALAZZO PIETRO STIGES 103 STRAIT STREET VALLETTA, VALLETTA, VLT 1436
-eb9130c5-71c3-45f2-8c7c-27feca43cd5f	EISIMTM1XXX	8f68c503-f0b6-4dcd-8ccf-219d6e19219c	t	FLOOR 3, VALLETTA BUILDING 1 SOUTH STREET VALLETTA, VALLETTA, VLT 1103
-8e54e0c9-9cce-4602-bd58-52886328c51b	EKIRPLP1XXX	b3fe929e-9031-40cc-a028-fbb1f03772b0	t	PILECKIEGO ST. 65  WARSZAWA, MAZOWIECKIE, 02-781
-df1e753d-40e0-4d37-8ba6-7b4383bfe4f0	EKIRPLPDXXX	b3fe929e-9031-40cc-a028-fbb1f03772b0	t	RTM. W. PILECKIEGO 65  WARSZAWA, MAZOWIECKIE, 02-781
-8c342b74-1810-4033-9cbb-c664aad80f36	EKIRPLPWXXX	b3fe929e-9031-40cc-a028-fbb1f03772b0	t	PILECKIEGO ST. 65  WARSZAWA, MAZOWIECKIE, 02-781
-81c0d717-099a-4006-a5b5-a17036c39bb5	EKIRPLS1XXX	b3fe929e-9031-40cc-a028-fbb1f03772b0	t	RTM. PILECKIEGO 65  WARSZAWA, MAZOWIECKIE, 02-781
-56af46a6-6cc1-4275-970b-7e37976f83eb	ELFGBGS1XXX	86094322-85de-4334-9f42-754f6e8df967	t	LACHEZAR STANCHEV STR 5 SOPHARMA BUSINESS TOWERS SOFIA, SOFIA, 1756
-4b450f93-0495-4f14-9edf-219ce2a06985	ELTABGS1XXX	f58531ea-4022-4299-bece-8732968e0949	t	LACHEZAR STANCHEV STR 5 SOPHARMA BUSINESS TOWERS, TOWER:B, FLOOR 12 SOFIA, SOFIA, 1756
-52d2fcf0-eef6-461e-9f6e-7f7d4fa261f6	EMOEMTM2XXX	c79cb8ea-133b-4423-94aa-d7ecc0b2c308	t	TRIQ IX XATT TA' XBIEX  MSIDA, MSIDA, MSD 1516
-b9207710-9c7d-48c6-ac26-9b576ba23a65	EMONMTM2XXX	c79cb8ea-133b-4423-94aa-d7ecc0b2c308	t	TRIQ IX XATT TA' XBIEX  MSIDA, MSIDA, MSD 1516
-9b7cca27-0f2c-4a22-bd11-8b975937f585	EMPOALTRXXX	af146cc4-58b1-4623-bcf4-d047a2cfa6b2	t	HYRJA 1 RRUGA E KAVAJES NDERTESA 27 TIRANA, TIRANA, 1001
-989208a0-4325-4fbf-89b6-76917c2313c1	EMSYMTMTXXX	8b0fe19b-01b3-40f2-a876-8ea780808381	t	PARTHENON BUILDING  SLIEMA, TAS-SLIEMA, SLM 3141
-b81132ca-1fa8-4e3e-8353-b1ef8870c2a4	ENGAPLP1XXX	a494f716-29ea-4f58-ba01-35c559068a3a	t	UL. MARSZALKA JOZEFA PILSUDSKIEG 45  - KOSZALIN, ZACHODNIOPOMORSKIE 75-502
-1ff859cf-d685-450a-a8a6-8f8cf4bf6653	ENLCCLRMXXX	2e242295-94e0-463d-8053-cd816ff3503f	t	AVENIDA SANTA ROSA 76, FLOOR 15 EDIFICIO ENEL - SANTIAGO, PROVINCIA DE SANTIAGO, 8330099
-2d32dbb8-e6b1-460c-a788-c8cdb2e2c712	ERMOMCM1XXX	156a67e4-9515-42cc-a670-f97d50f69e4c	t	VILLA DES FLEURS 27 BOULEVARD PRINCESSE CHARLOTTE MONACO, MONACO, 98000
-0514517c-a954-4815-8a96-2f0bd03894a7	ERSPPLP1XXX	a047308b-d99c-4099-a74f-4b17a2e65010	t	SASKI CRESCENT UL. KROLEWSKA 16 WARSZAWA, MAZOWIECKIE, 00-103
-dfaef06e-2e4e-4046-9a01-500d9e408834	ESPYBGS1XXX	c6822902-2ddf-4710-9602-9d313fd07f1f	t	IVAN VAZOV STR 16  SOFIA, SOFIA, 1000
-a9d92cdd-e286-4c5d-bc76-77ba2954b5cc	ESSEPLPWXXX	6ad218e2-1413-4051-81bd-7ec7ac76bb8c	t	UL. ZELAZNA 51/53  WARSZAWA, MAZOWIECKIE, 00-841
-7135b5d3-30ac-448e-9c33-aeef111e65d6	ESSIPLPXXXX	75a9951f-523c-4972-99e1-a398ebe619fd	t	LUMEN ZACHODNI ZLOTA STREET 59, FLOOR 5 WARSZAWA, MAZOWIECKIE, 00-120
-5dda7c9a-fa54-47ac-8c39-bc5be57a21b3	EUFCBGS1XXX	b7b980a4-0d85-40d4-863d-cbffd85f25a5	t	PATRIARCH EVTIMII BLVD 84  SOFIA, SOFIA, 1463
-56a04c61-ade2-4b5a-8304-ee39296f2765	EUFVMTM1XXX	54d25409-0d29-4981-9813-a958e647f68a	t	175 RUE D'ARGENS  GZIRA, GZIRA, GZR 1362
-701c28b0-a2da-46f6-b83e-37dfaf6261de	EUOBCLR1XXX	ea535af6-65b6-4cce-92cc-90ecd5f2c94c	t	APOQUINDO 3885 LAS CONDES SANTIAGO, PROVINCIA DE SANTIAGO 7550177
-90138785-dd1e-47be-a0c5-4697c7c29e19	EUOTPLP1XXX	e0d09e3f-ba8f-49bd-ae4f-1ab2626f5824	t	CYNAMONOWA 19  WARSZAWA, MAZOWIECKIE, 02-777
-73bffa26-ffa7-4400-9a29-a1fc170db466	EVNEMTM2XXX	8e8dc8b4-8e6c-4344-b76c-5f3e3aad9bdc	t	OFFICE 1/1165, FLOOR G, QUANTUM HOUSE 75 ABATE RIGORD STREET TA'XBIEX, TA'XBIEX, XBX 1120
-9aa0dc63-72b5-476f-9f0f-cb13af02f3bc	EXAEMTM1XXX	c0a06c0e-6162-44aa-a516-424ad930fe52	t	PORTOMASO BUSINESS TOWER VJAL PORTOMASO - ST. JULIAN'S, ST JULIAN'S, STJ 3155
-6f72b445-6953-4bf3-9987-16930dc19071	EXSRPLP1XXX	721f90d5-f965-4c03-a581-937d76ac523c	t	UL.KRAKOWSKA 12B/1A  RZESZOW, PODKARPACKIE, 35-111
-b63d06b1-8da8-46f2-82c4-5b104112d39c	FALACLRMXXX	ff7483e7-36c2-4d4c-b35d-37cf705f57cf	t	CALLE MONEDA 970, FLOOR 9 EDIFICIO EUROCENTRO - SANTIAGO, PROVINCIA DE SANTIAGO, 8320313
-4e9e5334-f244-449b-a8c2-e14b131fba12	FALSBGS1XXX	e003fc97-3f12-4d81-b527-1d25a1a8b0c1	t	ANGEL KANCHEV STR 25  SOFIA, SOFIA, 1000
-5c382845-4fa3-415b-b5f2-76b9298f2923	FBHLMTMTXXX	1dd1730e-3a9c-48c6-b535-bfe58f82583a	t	143/2 TOWER ROAD  SLIEMA, SLIEMA, SLM 1604
-749ca680-9236-4a3b-b161-6c9a8932932a	FBPLPLPWXXX	cb29ed95-f50d-4cde-bac8-275b24fa24c1	t	AL. WYSCIGOWA 6  WARSZAWA, MAZOWIECKIE, 02-681
-1f79ea6a-674b-4ef4-8c4e-b20909fa561a	FCMFMTMTXXX	d04186d6-82f4-46d2-90b1-4484b25c434d	t	SUITE 3, TOWER BUSINESS CENTRE TOWER STREET SWATAR, SWATAR, BKR 4013
-9172e6d6-d305-4285-a613-b852fdcbe0d8	FEFAALTRXXX	c52c1da7-0712-4ee2-a59a-19309acb00f6	t	H. 15 RRUGA DRITAN HOXHA, NJESIA BASHKIAKE 11 TIRANA, TIRANA, 1023
-674ba386-09fc-486d-a26e-406a9497e4a8	FEMAMTMADCA	b9adc4d2-f9e9-49d0-b95c-cd5dc7ad3109	f	FLOOR 9, ST BUSINESS CENTRE 120 THE STRAND GZIRA, GZIRA, GZR 1027
-df996034-0034-4ce6-9153-349104ab61e3	FEMAMTMAXXX	b9adc4d2-f9e9-49d0-b95c-cd5dc7ad3109	t	FLOOR 9, ST BUSINESS CENTRE 120 THE STRAND GZIRA, GZIRA, GZR 1027
-6864fb48-6622-45cf-bcc2-6e3efc98ae80	FEMAMTMTXXX	b9adc4d2-f9e9-49d0-b95c-cd5dc7ad3109	t	FLOOR 9, ST BUSINESS CENTRE 120 THE STRAND GZIRA, GZIRA, GZR 1027
-7255172f-31ed-4fd2-b41f-a6df995b01dc	FESKPLP2XXX	c15545ad-b220-40ef-a3cd-b1b74190d326	t	PROMIENNA 38/1  WARSZAWA, MAZOWIECKIE, 03-672
-4f55eae5-384f-4a2e-b09b-c795b11c6bf1	FFAMBGS1XXX	8c567e76-8544-48bf-8529-ecc8a6e47483	t	ENOS STREET 2 FLOOR 4 SOFIA, SOFIA, 1408
-c39c48cf-7483-4e62-ac1f-a0ef1384439a	FFBHBGS1XXX	70075c84-8df8-483d-877e-0e7d2bb18cb2	t	ENOS STREET 2  SOFIA, SOFIA, 1408
-539f4564-a653-4c48-a0ec-b6d985f9063e	FFSMMTM1XXX	7556cc79-7676-4204-bd98-ca317a785dfb	t	ALPINE HOUSE NAXXAR ROAD SAN GWANN, SAN GWANN, SGN 9032
-5710afdb-673e-4ec8-a0c1-5fea4437f1e7	FIIHMTM1XXX	f03174e0-7f09-47f1-b5cd-5854acd8ecc1	t	SUITE A, FLOOR 2, THE PARK LANE BUILDING MOUNTBATEN STREET G'MANGIA, G'MANGIA, HMR 1576
-f20b5194-1fff-4846-9012-e91582bbff0e	FIMBMTM3XXX	9fccb0ef-c127-4551-af46-77acfffcc1f6	t	MERCURY TOWER ELIA ZAMMIT STREET ST. JULIAN'S, ST. JULIAN'S, STJ 3153
-da51a915-399d-4c06-8951-eadc525815da	FINDMTMTXXX	a877aa5d-91ee-417a-9766-a7c26a015045	t	FLOOR 2, CENTRIS BUSINESS GATEWAY II 46 TRIQ IS-SALIB TAL-IMRIEHEL BIRKIRKARA, BIRKIRKARA, CBD 3020
-63daac68-5a88-451b-a4f0-7beb3afdb98c	FINVALTRXXX	b8cdbae7-6e16-48dd-95cc-f9963ace4ca5	t	TWIN TOWERS NO.2 BLVD.DESHMORET E KOMBIT TIRANA, TIRANA, 1010
-e2a853b0-78a6-46c2-8924-c8802d40f24b	FINVBGSFXXX	5051310f-3799-4ec0-843e-7fb2857b8d5c	t	TSARIGRADSKO SHOSSE BOULEVARD 111 P  SOFIA, SOFIA, 1784
-e3a12359-b9c8-4b17-8764-e3104f56b68f	FIRYBGS1XXX	bb68df58-117b-4a0a-8bb0-0093938db103	t	TSAR BORIS THE THIRD BULVD 159  SOFIA, SOFIA, 1618
-c3716e49-17fb-432c-90e9-8533803780b5	FITMPLP1XXX	6d28abea-ca1f-4720-8027-8c3a97d3c650	t	AL. JANA PAWA II 15  WARSZAWA, MAZOWIECKIE, 00-828
-ca7e0e75-65b7-48e8-b25e-c9e72fb32dea	FNEXBGS1XXX	4aed0303-2703-43af-8721-5ecf6134f76f	t	ALEXANDER STAMBOLIJSKI BLVD 235  SOFIA, SOFIA, 1303
-e4899770-4799-4734-827f-08b1eaa03c94	FNOBCLR2XXX	661ff74c-fc1e-4276-8c18-7af2627973aa	t	ISIDORA GOYENECHEA 3477, FLOOR 11 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 7550106
-00b55a8d-b0c6-42af-b4dd-54df7a9ce670	FNTMPLP2XXX	8a709130-77b1-4ac2-b480-7693015b746c	t	MARSZALKA JOZEFA PILSUDSKIEGO 45 KOSZALIN, ZACHODNIOPOMORSKIE 75-502
-01c37801-3926-4852-ba8d-57125b36832d	FPIJBGS1XXX	5ae085ec-889e-4890-a6a0-a4b2118dc913	t	CHERNI VRUH BLVD  SOFIA, SOFIA, 1407
-16b83f4d-e876-4dc4-80da-e58fd24e223b	FPLSMTM1XXX	977e9fc6-8b27-4b4a-912a-5be418b3c15e	t	MARINA COURT 4 G. CALI STREET TA'XBIEX, TA'XBIEX, XBX 1027
-3f6da225-bf33-4524-98cc-2ce87acb378a	FRIPCLR1XXX	4bc576c8-3f74-4533-8a4d-b66f4d45cbb8	t	ALCANTARA 200, OF. 202 LAS CONDES SANTIAGO, PROVINCIA DE SANTIAGO 8320000
-64c173d3-26d1-413f-a881-72d3b405442a	FRIPPLP1XXX	90eeb31a-8b10-44b3-98f1-c205213adf4d	t	SASKI CRESCENT KROLEWSKA 16 WARSZAWA, MAZOWIECKIE, 00-103
-d1aaf365-aa64-46dd-94c0-8551b459160e	FRIPUYM1XXX	5a514657-264b-496f-ac5a-f1abaabd1894	t	CONVENCION 1490 AP. 201 MONTEVIDEO, MONTEVIDEO, 11100
-8fa06b49-df18-418c-9624-aaea70ffe599	FTLNCLRMXXX	9d9bbb58-adc3-4148-8d43-89a618a8520f	t	
-15247b97-cb1b-4020-8c70-a8bbcf56a786	FTRMMTM1XXX	bd04d104-4ed4-4046-af91-a98b0c63c8b0	t	FLOOR 2, THE MALL COMPLEX  FLORIANA, IL-FURJANA, FRN 1470
-8f4e1a82-a07c-4da9-8759-06ba0e957fb0	GASBPLP1XXX	e0b58216-1fe9-4e01-94b7-0a1b85d4e8e8	t	UL.GLOWNA 23  GASOCIN, MAZOWIECKIE, 06-440
-7192f13d-2149-4e37-be6f-56d8de8da52c	GASIPLP1XXX	ae7267ed-2f51-4fd0-9bdd-52ca0b5720bb	t	ROZANA 2/51  WARSZAWA, MAZOWIECKIE, 02-548
-b776f15a-e38c-41c3-bf9b-56bf62d371cf	GBGCPLPKXXX	8ad1cf95-6e27-4190-a91d-846376431637	t	THE WARSAW HUB RONDO I. DASZYNSKIEGO 2C WARSZAWA, MAZOWIECKIE, 00-843
-5d34b599-2031-4624-b21f-4a78a35ce263	GBWCPLPPXXX	7a73ca84-492e-438f-b132-165ebd94850c	t	UL. SZARYCH SZEREGOW 23A  POZNAN, WIELKOPOLSKIE, 60-462
-5a7e1af1-6068-4248-a43f-f61c6f25b91b	GCBMCLR1XXX	be1ac731-0eb0-43cc-ab21-62a77851ce6a	t	
-e3e8a555-e79c-4767-8664-fe1ea15e29eb	GLFAMTM1XXX	e36c1d13-8c14-4d80-bf9d-0db3a7d749ef	t	120 THE STRAND  GZIRA, GZIRA, GZR 1027
-97b8ec14-64e2-468b-88e7-10a2e7709f5c	GLFMMTM1XXX	e36c1d13-8c14-4d80-bf9d-0db3a7d749ef	t	OPERATIONS CENTRE BALZAN VALLEY ROAD BALZAN, BALZAN, BZN 1407
-b3a3ad6e-7f8d-45bb-b995-be360cbfec59	GLMKBGS1XXX	4d4c29fe-2a23-46f8-be62-e8207ef2393a	t	SOFIA, SOFIA, 1816
-87fa70e0-fa16-4ae1-ac41-49f1803ec619	GOSKPLPWXXX	60042e48-0739-449b-9a6f-eaaf2f346ec0	t	AL. JEROZOLIMSKIE 7  WARSZAWA, MAZOWIECKIE, 00-496
-58528743-2e2f-417a-a416-6d4df697f96a	GROIMTM1XXX	c170f90a-4a2f-4900-a2ab-fd66e37fa96f	t	MIDDLE SEA HOUSE  FLORIANA, IL-FURJANA, FRN 1442
-d8a2ccf1-1ca3-4380-8eed-ddd5cf15097a	GSCLCLR1XXX	38d902a3-c0ef-4fd7-b1bb-cb0a6b213528	t	AVENIDA APOQUINDO 472, 3 PATIO FOSTER BUILDING, LAS CONDES SANTIAGO, PROVINCIA DE SANTIAGO
-3d1c7f36-4735-4ea7-8e9d-781c557f6a72	GSESMTMTXXX	21be3530-5a6e-4fcb-b966-78f793efae8b	t	171 OLD BAKERY STREET  VALLETTA, VALLETTA, VLT 1455
-36ab29e5-fc54-4973-bc36-bbb63aa31971	GSIWPLP1XXX	4b053a80-e76c-4273-aa8f-f6ee65d6b8e9	t	WARSAW SPIRE PLAC EUROPEJSKI 1 WARSZAWA, MAZOWIECKIE, 00-844
-f5166858-0a6a-43e6-aa87-1ed5a0ce2035	GSPSPLP1XXX	624c2d39-2d84-4610-bbef-69b8265c9ffd	t	WARSAW SPIRE PLAC EUROPEJSKI 1 WARSZAWA, MAZOWIECKIE, 00-844
-5784cfc2-c5c6-4d93-ba4c-719bf4f3949a	GUAVPLPPXXX	638d38ae-33c6-46a4-9c02-2c599fe1a60d	t	SW. MIKOLAJA 8/11  WROCLAW, WROCLAW, 50-125
-e22655cb-4a6e-458c-b2c2-2cec3ca1459d	HABALV22TIP	c760c0d6-6f03-4904-8a79-086949de2baa	f	BALASTA DAMBIS 1A  RIGA, RIGA, LV-1048
-e798453d-41f9-4e30-9584-8d6daca43065	HABALV22XXX	c760c0d6-6f03-4904-8a79-086949de2baa	t	BALASTA DAMBIS 1A  RIGA, RIGA, LV-1048
-6e6ebfa7-0d59-4be7-a4fd-7478d8b4a459	HAJNPLP1XXX	9fd46997-c9aa-4565-8cec-d0e23a4cf604	t	PILSUDSKIEGO 12  HAJNOWKA, PODLASKIE, 17-200
-00379aea-7829-4603-98a3-00116c1206c4	HANDPLPWXXX	8ed1dadf-2e75-49f4-8157-b1ac0dedb986	t	WOLOSKA 22  WARSZAWA, MAZOWIECKIE, 02-675
-dfb3bc51-befb-40b1-b9b4-2a86d87e5899	HAVLMCMXBDF	281b3b4b-d032-49ca-bffe-dc549527670a	f	MONACO, MONACO, 98000
-ac4df64c-9c46-41d9-ae44-016c3919e99c	HAVLMCMXXXX	281b3b4b-d032-49ca-bffe-dc549527670a	t	3-7 BOULEVARD DES MOULINS  MONACO, MONACO, 98000
-27e0ff52-000d-4a3c-8ef6-5a6bcaa493f6	HFSIMTM1XXX	1604bc47-c02c-41d8-ab66-94324bf04564	t	FLOOR 3 BREWERY STREET MRIEHEL, BIRKIRKARA , BKR 3000
-b0469f65-bcb0-431e-8584-a5b750b9be43	HOCIMTM1XXX	f8fda268-0790-43b4-9804-d954209562fe	t	SUITE 33, REGENT HOUSE BISAZZA STREET SLIEMA, SLIEMA, SLM 1640
-f003e7e4-de02-4a32-9517-6531d27dcba0	HOCVMTM1XXX	f8fda268-0790-43b4-9804-d954209562fe	t	REGENT HOUSE 33 BISAZZA STREET SLIEMA, SLIEMA, SLM 1641
-ef0cd838-66d1-4ce5-bcc8-dc6ecf7693ce	HSBCPLPSXXX	48dd41fc-a63a-4c05-a0f5-65285c05c12d	t	RONDO ONZ 1  WARSZAWA, MAZOWIECKIE, 00-124
-092c3e75-3a30-4505-9aa6-98909e626046	HSBCPLPWXXX	48dd41fc-a63a-4c05-a0f5-65285c05c12d	t	RONDO ONZ 1  WARSZAWA, MAZOWIECKIE, 00-124
-c6e9196a-d31b-48eb-b7af-530f88f9602d	HSFMMTM1XXX	cfd06003-1a40-4e63-9c09-c50d4545cd15	t	HEXAGON HOUSE SPENCER GARDENS BLATA IL-BAJDA, BLATA IL-BAJDA, BKR 3000
-b88b2174-7c4a-45a2-bc58-cdc2cd2bd450	HYVEPLP2XXX	3a4e8a79-41c2-426f-8347-6899f507fe62	t	RENAISSANCE TOWER UL. SKIERNIEWICKA 10A WARSZAWA, MAZOWIECKIE, 01-230
-2ec2498c-b4e3-4b69-ba40-90d27181c77e	IABGBGSFXXX	dba4b9dc-3af6-44fb-a202-85c0e0e231fb	t	TODOR ALEXANDROV BLVD 81-83  SOFIA, SOFIA, 1303
-b080f411-d5b4-4593-bcf7-e64e6fd05d4e	IAFAUYM1XXX	1d48e084-9188-41cf-8152-bb9d1500c0c4	t	BVAR ARTIGAS 1253  MONTEVIDEO, MONTEVIDEO, 11200
-8cc17c5b-438c-4bc0-95e9-e5a600086d97	IARUAWA1XXX	3872fa15-4d83-4a5c-a0bc-d35aadd7d71d	t	CAYA G.F. CROES 38  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-f12be413-6b19-4ebc-ad24-034febbf7a82	ICBKPLPWXXX	71e0b7c2-4e8a-45a7-b6e6-603395542af6	t	PLAC TRZECH KRZYZY 18  WARSZAWA, MAZOWIECKIE, 00-499
-7e5cd6b6-f78a-494b-ae5b-c1c2b1d0c620	ICCSCLR1XXX	89b670a3-71e2-4da1-9ddf-e9b523d47d90	t	APOQUINDO 3457, FLOOR 1 EDIFICIO ITAU - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-097c0e2b-4fef-4d36-ad6c-bbb7a12d1d08	ICDGCLR1XXX	4f341c38-5e17-4431-abf3-960963ba0a3f	t	
-a23a0923-ed29-4a0a-94f8-e299a9de679c	ICDRMTMTXXX	8860bb41-b3e0-4042-aec8-d8e6a6059ca1	t	FLOOR 4, PALAZZO SPINOLA 46 ST. CHRISTOPHER STREET VALLETTA, VALLETTA, VLT 1464
-e3bf895b-46f1-4fe0-a426-8c52de9b1ed3	ICPLPLP1XXX	83b9f5ec-0bca-43cc-a2a5-4b2e71db6ef3	t	SIENNA 73  WARSZAWA, MAZOWIECKIE, 00-833
-44995eb3-127f-4d33-96fc-820e6b82904b	IDMAPLP1XXX	7739648b-492f-4860-ab0a-4fd9542f0c72	t	BUKOWIECKA 92  WARSZAWA, MAZOWIECKIE, 03-893
-e97b05fd-6165-4410-9962-3c2fbc8ae848	IDXOLV22TIP	e38a1386-c73a-4fc6-95f1-d3bd84c0d4c4	f	ELIZABETES STREET 13-1A  RIGA, RIGA, LV-1010
-85929dbb-296d-4604-bfdc-002dd6bce009	IDXOLV22XXX	bc4233be-6c7b-4fe6-b301-4c2c6e21e73f	t	ELIZABETES STREET 13-1A  RIGA, RIGA, LV-1010
-c91a3cac-0e51-491f-872b-3b692d0b8042	IESCMTM1XXX	2f8dfa08-8b09-47a1-b16d-bd1cba4a2e9a	t	OFFICE NO. 9 152 NAXXAR ROAD SAN GWANN, SAINT JOHN, SGN 9030
-238bddfb-e017-40e7-94fc-1997f620360e	IFSMMTM2XXX	a09bc7dc-3227-468b-9ba2-e62adaedac4e	t	PENTHOUSE, CAPITAL BUSINESS CENTRE TRIQ TAZ-ZWEJT SAN GWANN, SAN GWANN, SGN 3000
-4f80479b-f8ae-4f0d-989e-eed7e7764a8a	IGESPLPPXXX	cd9d56a7-22c8-4caa-997a-106ba867c0f2	t	OCEAN OFFICE PARK UL. PANA TADEUSZA 4, FLOOR 5 KRAKOW, MALOPOLSKIE, 30-727
-290fbd95-ad01-4418-b197-a8b5bda3d96a	IGTRPLP1XXX	5e90f040-01e5-4c89-8d9f-cc92ec158388	t	PULAWSKA 111A-109, FLOOR 5  WARSZAWA, MAZOWIECKIE, 02-707
-e37da779-5160-4733-8099-093b1ebbbdc5	IGTRPLPWXXX	5e90f040-01e5-4c89-8d9f-cc92ec158388	t	UL. PULAWSKA 111A, FLOOR 5  WARSZAWA, MAZOWIECKIE, 02-707
-1344245c-faa3-4213-88e5-7d2bdca49659	IIGBMTMTXXX	9b50f167-d9f9-40d2-bf24-ec3e96b23a75	t	PORTOMASO BUSINESS TOWER PORTOMASO ST. JULIAN'S, ST. JULIAN'S, STJ 4011
-9e66ed6b-87c8-4121-b8d8-e11c2989a428	IIMPPLP1XXX	41aa94b5-0130-42fd-a3b1-ffff9dff80d2	t	HOLLAND PARK PLAC TRZECH KRZYZY 10/14 WARSZAWA, MAZOWIECKIE, 00-499
-e3eb143a-18c3-4a24-85aa-fe7f3f86dded	IMIEAWA1XXX	8fbcb753-1aeb-4e9b-aff6-4231b0bf13e1	t	TANKI LENDEERT 143  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-a16b97f7-fd4b-4733-ad1e-4b322e983b7f	INCJBGS1XXX	a0ba05ad-8817-433b-a507-ab56531c7cfa	t	STEFAN KARADJA STREET 2  SOFIA, SOFIA, 1000
-27dfa20a-a0f3-454e-a6e3-ea5e4ef69a59	INGBBGSFXXX	95d123a1-93c1-49dd-a842-7a8bb54be5ce	t	BULGARIA BLVD 49B  SOFIA, SOFIA, 1404
-dbe9e29a-d726-4639-8ca6-bd29f9a6107d	INGBPLPHXXX	7218abf5-5ce9-457a-a8ce-6a19d4a8f018	t	CHORZOWSKA 50  KATOWICE, SLASKIE, 40-101
-d795698e-e376-4498-b74d-1eda6a777d2c	INGBPLPWXXX	1620593c-6035-41cb-a507-493c87264e22	t	UL. SOKOLSKA 34  KATOWICE, SLASKIE, 40-086
-092d69e8-afc5-465a-bfa5-4b720f6fb431	INMKBGS1XXX	adf43859-6908-4c2f-8183-c65560af1002	t	DOBRUDJA 6  SOFIA, SOFIA, 1000
-72d7f2d5-e04e-40f2-a176-e3f07205c09d	INMKBGSSXXX	adf43859-6908-4c2f-8183-c65560af1002	t	GEORGI SAVA RAKOVSKI 140 FLOOR 6 SOFIA, SOFIA, 1000
-e415e94e-8f1e-4307-9bb5-6184b7d14961	INTFBGSFXXX	a27ed204-2599-4f55-a553-ade9d42100b3	t	BUSINESS PARK VARNA B1  VARNA, VARNA, 9009
-80c4e4e6-8fdf-4014-a66c-8be11f293ba2	INUXUYM1XXX	8cfaa75c-2f7e-4db1-9bf3-54ed4d6de93d	t	RINCON 477  MONTEVIDEO, MONTEVIDEO, 11000
-8672cb7e-6cc9-434c-9e63-2b56d95f2e88	IORTBGSFXXX	3ceaa5f8-9d28-4249-b0b3-788e6d460187	t	BULGARIA BLVD 85  SOFIA, SOFIA, 1404
-e6c72959-c8ed-4819-bd72-f8f092bfdfb7	IPAGPLPLXXX	852b3d79-08f2-42c4-b74b-ba2ff0324d13	t	HENRYKA SIENKIEWICZA 82/84, FLOOR 3  LODZ, LODZKIE, 90-318
-53021d88-6ee1-403d-b911-03006ebc79a7	IPSEPLP1XXX	283a0069-ab8c-4643-95b7-661581439c0d	t	PROZNA 9  WARSZAWA, MAZOWIECKIE, 00-017
-5ff9a1fe-1241-4dd9-b24f-4ac8b6857d58	ISAOPLPPXXX	a80f79d3-df54-47bd-a0f7-890572bdb5a5	t	RIVERSIDE PARK FABRYCZNA 5A WARSZAWA, MAZOWIECKIE, 00-446
-6e078bcb-15f4-4da0-916d-cd7ad17effad	ITAUCLRMSSO	bd5d26ca-429a-4245-bb2d-ebf61ddeee62	f	
-b208ce30-b1ec-424e-a75f-46215b91f214	ITAUCLRMXXX	bd5d26ca-429a-4245-bb2d-ebf61ddeee62	t	PRESIDENTE RIESCO 5537, FLOOR 7 EDIFICIO NUEVA LAS CONDES, LAS SANTIAGO, PROVINCIA DE SANTIAGO
-aa2539db-807d-4e6e-92b3-35fc86fd5121	ITAUUYMMXXX	ca1d73eb-aebd-42bb-9856-ab2ecea8725e	t	DR. LUIS BONAVITA 1266  MONTEVIDEO, MONTEVIDEO, 11300
-61da8e78-605d-4391-af77-5b996819fed6	ITFIPLP1XXX	41aa94b5-0130-42fd-a3b1-ffff9dff80d2	t	HOLLAND PARK PLAC TRZECH KRZYZY 10/14 WARSZAWA, MAZOWIECKIE, 00-499
-83df27e3-89a9-42e5-a2b1-0a93172fb9c9	ITHOMTM2XXX	c358258f-1ed7-413c-a8ec-e79bf478d238	t	VILLA MALITAH MEDITERRANEAN STREET ST. JULIAN'S, ST. JULIAN'S, STJ 3155
-b01baf58-8c77-43f1-90f6-4dc421484392	IVFMBGS1XXX	182cc296-933e-4928-9bc0-973649de3b5c	t	DOBRUDJA 6  SOFIA, SOFIA, 1000
-04692312-c859-49d6-98e1-efada4e3a958	IVSEPLPPXXX	9826f303-230e-49fa-b46b-aba08132a373	t	AL. STANOW ZJEDNOCZONYCH 61A  WARSZAWA, MAZOWIECKIE, 04-028
-6c628f10-4c1b-41ec-8642-63d5aa464892	IZOLMTMTXXX	387782f2-fb14-4c5e-8f97-71a139ba551d	t	53-58 EAST STREET  VALLETTA, VALLETTA, VLT 1251
-9fe890ac-8aec-4d19-a20e-d1890b2a0d44	JMFSMTM1XXX	13403250-1055-4c40-85ff-19e9e24db349	t	FLAT 3 67 SOUTH STREET VALLETTA, VALLETTA, VLT 1105
-59d8be99-fcb5-4a63-b160-394c8971aff4	JOPSLV22XXX	8d7be73d-f277-4e28-bd0d-dc1ab83e745d	t	LIELIRBES 27  RIGA, RIGA, LV-1046
-0736bc80-e39b-45f6-b556-8346f4ddfb6f	JSSILV21XXX	714e2c18-7581-4027-aeb4-785087a73226	t	LACPLESA 20A-1  RIGA, RIGA, LV-1011
-7697ac0a-9de3-47f0-a847-c67e89432284	KACABGS1XXX	c47b2e3d-2018-4dd8-a9e6-ccffcd56ca4b	t	HRISTO BOTEV BLVD 57  SOFIA, SOFIA, 1303
-a7caddb4-dad6-4326-86fc-8596a834667f	KAOLBGS1XXX	63247028-d910-4fa3-9513-7bda951543f1	t	ZLATOVRAH 1  SOFIA, SOFIA, 1164
-ca84b78f-609b-47fa-b2c9-9eb92e6e467e	KBLXMCMCXXX	84643d9f-c2a6-4772-967d-b5b938efd067	t	BRETAGNE 8 AVENUE DE GRANDE BRETAGNE MONACO, MONACO, 98005
-da452a52-db9b-4daf-aada-0fd967f58054	KCCPPLPW1AM	4a7c6cb9-718d-433c-87e8-8424cb693048	f	KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-d0b32230-fb4b-43e8-9eb5-e4656a995be3	KCCPPLPWASI	4a7c6cb9-718d-433c-87e8-8424cb693048	f	WARSZAWA, MAZOWIECKIE
-5de46abf-5e11-4c44-8644-43f3545036e1	KCCPPLPWOTC	4a7c6cb9-718d-433c-87e8-8424cb693048	f	WARSZAWA, MAZOWIECKIE
-d61f9dad-2416-4e11-b452-0e3c541002a2	KCCPPLPWXXX	4a7c6cb9-718d-433c-87e8-8424cb693048	t	KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-016ef988-2708-4191-a866-64ba47d9efb9	KDPWPLPAASD	e5a5e820-0e81-4a9e-883f-f5e591f6e29e	f	KSIAZECA 4 4  WARSZAWA, MAZOWIECKIE, 00-498
-1825dd3d-417e-4133-b2cb-03ba98ba1731	KDPWPLPAXXX	f99e7e57-cfde-4584-a649-da1916020593	t	CENTRUM GIELDOWE KSIAZECA 4 WARSZAWA, MAZOWIECKIE, 00-498
-c32c0506-165b-42a2-9cb9-faede6b2b5bd	KDPWPLPW1AM	e5a5e820-0e81-4a9e-883f-f5e591f6e29e	f	UL. KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-beb5890e-6ef5-48b3-8209-146d396b652d	KDPWPLPWCAP	e5a5e820-0e81-4a9e-883f-f5e591f6e29e	f	UL. KSIAZECA 4 4  WARSZAWA, MAZOWIECKIE, 00-498
-31cdadaa-c001-4ead-be73-9ee16cb87e33	KDPWPLPWPEN	e5a5e820-0e81-4a9e-883f-f5e591f6e29e	f	UL. KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-a8b2005d-ec52-4836-9621-8258413344fd	KDPWPLPWXXX	6773a18d-7d8b-406a-b388-9e0a01d54a01	t	UL. KSIAZECA 4  WARSZAWA, MAZOWIECKIE, 00-498
-777f83bd-2da4-4ee8-8147-74f5f0b24d6f	KGITMTMTXXX	f7ce1efe-04d4-4c7d-8ef0-3805f50d2739	t	FLAT B8, THE ATRIUM WEST STREET MSIDA, MSIDA, MSD 1731
-550090d1-dcbf-48a1-9769-eb73d9b02e45	KKSDLV21XXX	7b25d17c-916b-4990-8023-c87a5a7adf5c	t	TURGENEVA 14  RIGA, RIGA, LV-1050
-a4462b04-d7e7-4e33-9b5b-624fac094de6	KKSLLV22XXX	37dcdaa1-7875-4640-877e-67ca0ff33be5	t	AUSEKLA STREET 3-60  RIGA, RIGA, LV-1050
-fceb5638-a45e-4dd4-bec5-b14d5af3ac8b	KLESPLP1XXX	d0286c1e-ecc0-4cf2-8dc9-160b67546143	t	UL.GLOWNA 114  KLESZCZOW, LODZKIE, 97-410
-86834cbc-7661-4fbc-83e3-c67ce25c7304	KOPWPLP1KPW	28657009-5e88-4c45-be25-99020012213e	f	POZNAN, WIELKOPOLSKIE
-14ce100f-bf53-4199-b3c1-f0a67f7ec7db	KOPWPLP1XXX	28657009-5e88-4c45-be25-99020012213e	t	UL. SZWAJCARSKA  POZNAN, WIELKOPOLSKIE, 61-285
-be70dad8-0b40-4da3-969b-9f1822c307b6	KRSPPLP2XXX	8e9ff8d5-7a2a-4c1f-af24-c1242e7e6ecb	t	RYNEK KLEPARSKI 8  KRAKOW, MALOPOLSKIE, 31-150
-cf749b50-0b30-4f7e-b967-bf9102c558f6	KRSPPLPKXXX	8e9ff8d5-7a2a-4c1f-af24-c1242e7e6ecb	t	RYNEK KLEPARSKI 8  KRAKOW, MALOPOLSKIE, 31-150
-9ed15340-b68a-490e-9bd4-c5607202cf47	LACBLV2XAMS	78ad8c60-ac26-4a88-b546-c470c7ffdce6	f	K. VALDEMARA STREET 2A  RIGA, RIGA, LV-1050
-de387b50-4224-4f39-908d-e96def8217f0	LACBLV2XCCB	78ad8c60-ac26-4a88-b546-c470c7ffdce6	f	K. VALDEMARA STREET 2A  RIGA, RIGA, LV-1050
-27a0dcc7-c3d9-4091-a545-f5ace5ac8431	LACBLV2XEKS	78ad8c60-ac26-4a88-b546-c470c7ffdce6	f	K. VALDEMARA STREET 2A  RIGA, RIGA, LV-1050
-1dd9f25c-f85d-49ac-b3f7-ee212a02f110	LACBLV2XXXX	78ad8c60-ac26-4a88-b546-c470c7ffdce6	t	K. VALDEMARA STREET 2A  RIGA, RIGA, LV-1050
-2ccd7304-7878-405a-b823-68fc31408b8c	LACBLV2XZMS	78ad8c60-ac26-4a88-b546-c470c7ffdce6	f	K. VALDEMARA STREET 2A  RIGA, RIGA, LV-1050
-c9c1e639-4137-492b-9792-6f9249032c71	LAPBLV2X011	595a6441-faf8-487e-8ee9-33b548c7a48c	f	BRIVIBAS IELA 54  RIGA, RIGA, LV-1011
-cb4003de-cf11-4c61-a544-a7f7094f8a17	LAPBLV2XTIP	7e4c616b-7b15-4f97-a0cd-b1946d94c6b3	f	BRIVIBAS IELA 54  RIGA, RIGA, LV-1011
-bb24ea20-0d61-43ec-8719-f9807532b152	LAPBLV2XXXX	7e4c616b-7b15-4f97-a0cd-b1946d94c6b3	t	BRIVIBAS IELA 54  RIGA, RIGA, LV-1011
-427584cc-5da1-4c44-b1c9-47788e4cb456	LARVCLR1XXX	ca7d63ff-9bee-4065-b3ed-85687ac985fa	t	
-a4c8f812-9792-44e3-a124-750bb181b36a	LATSLV21XXX	f3ecd8ac-0fd0-44cf-957e-dd64fd07aa8e	t	BAUSKAS STR. 20  RIGA, RIGA, LV-1004
-b629125f-599e-43eb-a306-8c43de1c356a	LAVFLV22XXX	70b8e236-22f7-473e-990e-aa81d77373a2	t	DELU IELA 4  RIGA, RIGA, LV-1004
-78e639d2-d94d-4287-bf23-72379948de99	LBMAMTMTXXX	41c46f38-56a2-43cb-acd8-a32f3cdacb7f	t	LOMBARD HOUSE 67 REPUBLIC STREET VALLETTA, VALLETTA, VLT 1117
-a6910476-c1ca-43bb-a5f4-d1b5d76e069e	LCDELV22CEE	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-61e3efd1-6e72-4a4b-9157-3248a4d79cbc	LCDELV22CLM	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-dc5c1e5e-687e-4913-ac1c-efd93c6d9f2b	LCDELV22CLT	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-a845dd44-ff71-47a5-ad12-0859422f2dbc	LCDELV22EEX	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-d32049eb-82c5-4dbe-b77f-ceb5d70b339c	LCDELV22LTX	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-fb74bedd-e1ce-4883-bbb9-cb2028fee27c	LCDELV22LVX	277ab044-f026-473a-99f5-a384a9829c5e	f	VALNU STREET 1  RIGA, RIGA, LV-1050
-b53393af-2380-4240-aa1c-2b7d71a02f9d	LCDELV22XXX	277ab044-f026-473a-99f5-a384a9829c5e	t	VALNU STREET 1  RIGA, RIGA, LV-1050
-e45a06c7-d73a-479b-a729-0b3f8f30fe35	LFIKLV21XXX	ae4b35dd-5149-445a-99b5-10ca0741112a	t	KR. BARONA STREET 20/22 - 5  REZEKNE, REZEKNE, LV-4601
-52b6f904-3415-4e24-85f7-10f3cf9d32be	LIPNPLP1XXX	77753321-b610-47d3-97f6-5e14beb37c34	t	MICKIEWICZA 30A  LIPNO, POWIAT LIPNOWSKI, 87-600
-3b0ba46f-1703-4a2c-b36a-b5d89d83234e	LKJFLV21XXX	ca77569a-0503-4b61-970f-0971cbd3777b	t	KATRINAS DAMBIS 22A  RIGA, RIGA, LV-1045
-aad74c7f-0521-4f4e-8d0b-124c14e016a4	LLBBLV2X111	bbb4f585-7dbc-4588-b4e0-2b69b7abfb3a	f	ANTONIJAS 3  RIGA, RIGA, LV-1010
-1e78fe35-f517-4ea1-a379-7f8c873d098f	LLBBLV2X222	bbb4f585-7dbc-4588-b4e0-2b69b7abfb3a	f	ANTONIJAS 3  RIGA, RIGA, LV-1010
-8400cb58-cf2b-4fc7-a844-e99bc91e4fa0	LLBBLV2XXXX	bbb4f585-7dbc-4588-b4e0-2b69b7abfb3a	t	ANTONIJAS 3  RIGA, RIGA, LV-1010
-57a89043-ceb0-4c2d-a955-c83404670087	LMARUYM1XXX	4baaa691-b010-4d96-8599-b6dc9d9d6feb	t	AV. 18 DE JULIO 1701  MONTEVIDEO, MONTEVIDEO, 11200
-8ce0fe4b-a961-4b57-8752-f5e5f1348461	LPITCLR1XXX	829ca581-c3da-411a-8b1a-1aed2d2ec9db	t	
-6fccd6c0-9405-47e7-82b3-d6b7e8e4d477	LPNSLV21XXX	2cf8673e-d516-419a-a16e-c7b91231e0c9	t	LIDOSTA ZIEMELU IELA 10 RIGA, RIGA, LV-1053
-7e75c8a3-2423-40cd-9472-02c27ae4643a	LSAVUYM1XXX	92c56642-0a66-4349-a1db-3978b7d83197	t	RUTA 8 KM 17 500 OF 215 FLOOR 2 MONTEVIDEO, MONTEVIDEO, 11000
-5c6e1144-9328-485a-a71a-0d1d4028d6f9	LTZIBG22XXX	557220d2-85b4-4a21-a00c-c0b84c189531	t	UL. ATON 1A BUILDING 6, FLOOR 5, APT. 26 PLOVDIV, PLOVDIV, 4001
-5c8ed598-8682-4fc4-9972-76ae02ad7cc3	LUMIBGS1XXX	d70edbe9-ef80-4f54-87f6-2b85c67dfc66	t	POSITANO STREET 9 ENTRANCE A SOFIA, SOFIA, 1000
-d5b0d2d7-3101-4eb4-99c3-98fe93c80e89	MAEAMCM1XXX	8bbb7968-0d75-4f8f-a90e-cc1dc845c25e	t	3 BOULEVARD PRINCESSE CHARLOTTE  MONACO, MONACO, 98003
-57112248-28fb-43ca-ac40-9da2eba5f2a1	MAGDCLRMXXX	f30b8f61-97e5-496b-a3ee-a5becac153ce	t	
-0c11e6e8-994f-4597-a14f-31d4aa92174c	MAJSBG21XXX	0c734039-fbb1-48e1-b1b3-0e3fc2998d6c	t	VASIL APRILOV STR 92  PLOVDIV, PLOVDIV, 4000
-654b38d6-ae28-417b-82be-1db341d97f02	MALTMTMTECM	c65f8c5e-3a9f-4678-8545-0b5503b652e8	f	CASTILLE PLACE  VALLETTA, VALLETTA
-90d6a435-8082-44f7-b99e-10c77c3ee7a5	MALTMTMTGCP	c65f8c5e-3a9f-4678-8545-0b5503b652e8	f	VALLETTA, VALLETTA
-138f0925-4fb0-45aa-892d-65e76f9edb83	MALTMTMTXXX	c65f8c5e-3a9f-4678-8545-0b5503b652e8	t	CASTILLE PLACE  VALLETTA, VALLETTA, VLT 1063
-fb8e714f-c7c6-47c4-ad15-a1dd7d6694fc	MBBPPLPWXXX	8477cd38-597c-4322-8555-d7bd35559864	t	GOTTLIEBA DAIMLERA 1  WARSZAWA, MAZOWIECKIE, 02-460
-d3385425-4c16-4e2f-b0bd-92227d9d978d	MBCBCLR1XXX	7f7f0e87-e16c-4b25-9682-d84c06fcc80a	t	AV. PRESIDENTE RIESCO 5711, OF 602 EDIFICIO HUIDOBRO - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-ba9e53e8-721b-4fb4-a690-2b04cddac17e	MBWMMTMT010	3005fb5e-9f32-4901-a30a-ef8d9648b7ea	f	FLOOR 1, THE CENTRE TIGNE POINT SLIEMA, SLIEMA, TPO 0001
-04cf53d2-f189-467e-b509-898f59b344ca	MBWMMTMTXXX	3005fb5e-9f32-4901-a30a-ef8d9648b7ea	t	FLOOR 1, THE CENTRE TIGNE POINT SLIEMA, SLIEMA, TPO 0001
-53113beb-9160-4c5f-80d0-9b92b651ba6e	MCBBCLR1XXX	9422daaa-6c6f-429f-9edc-1baaaa381858	t	
-5ebde1a4-a536-4e19-9c5e-97f73ee7e5c6	MCDABGS1XXX	49f18ae8-3536-4c6d-81ed-fe89ec324ad6	t	TZAR KALOYAN  SOFIA, SOFIA, 1000
-5bc22046-6892-4a6d-906f-7f26ffe64422	MDMZPLP1XXX	540bb788-17f9-4959-9ac9-6a0af88f173b	t	UL. SMIALA 26  WARSZAWA, MAZOWIECKIE, 01-523
-340bc26e-3c38-499e-8f3b-a0ae262cbf37	MDOMPLP1XXX	3cf0fb8d-0c11-41fd-b938-183e53b762ad	t	MILLENNIUM PLAZA AL. JEROZOLIMSKIE 123 A WARSZAWA, MAZOWIECKIE, 02-017
-73312258-7343-4cb1-846c-4dd898253e88	MFCBMTMSXXX	b4661093-1c6e-4b55-9bd7-51ccf21872c9	t	ST. JULIAN'S, STJ 3140
-1b889c17-1b20-4792-8dfa-7a6770a0cd3a	MFMAMTM2XXX	68aa7784-d368-42a2-948c-3e2a84303692	t	FLOOR 3, TOWER BUSINESS CENTRE TOWER STREET BIRKIRKARA, BIRKIRKARA, BKR 4013
-6d79f31a-349b-4a8f-9215-0723b85dc3e4	MGFIMTM1XXX	03ad12e8-36a0-40cb-a245-a28b87ea6cc8	t	MICAN COURT 1 J.F. KENNEDY SQUARE, GOZO VICTORIA, GOZO, VCT 2580
-7fffebcc-fe65-4a14-8f85-d8f1be9880b6	MGRZPLP1XXX	264aa5a2-1f4d-49bf-b3ec-edfe98cb1cb4	t	UL.GRODZKA 3  PRZYSUCHA, POWIAT PRZYSUSKI, 26-400
-6c0ca016-2c6c-4517-bfa5-b155881f782b	MHBFPLPWXXX	b0de223d-e1b1-4271-b745-86437d15e2be	t	CROWN SQUARE PRZYOKOPOWA 31, FLOOR 9 WARSZAWA, MAZOWIECKIE, 01-208
-d833d2b1-7bcf-4d9a-bdc3-02ba2843a333	MIFBALTRXXX	2d7c5a3a-0cff-460b-9470-c31b969cae22	t	BLVD DESHMORET E KOMBIT 3  TIRANA, TIRANA, 1001
-aadd85cb-dcee-46cb-b39f-1c1eb38ece87	MIMKLV22XXX	bde7fb8c-2b7f-4888-9b5d-f359fdf06892	t	FLOOR 6TH, SKANSTES CITY SKANSTES STREET 50 RIGA, RIGA, LV-1013
-46365573-5a9d-4a68-8f4c-493431f74637	MIPYMTM1ALL	27d49973-cd03-4efd-861f-3fab8c818624	f	VALLETTA, VALLETTA
-9854a93c-418f-4941-a38c-569c5ee1d051	MIPYMTM1XXX	27d49973-cd03-4efd-861f-3fab8c818624	t	EUROPA CENTRE 51 ST. ANNE STREET FLORIANA, IL-FURJANA, FRN 9011
-789e0232-9a28-4ea5-9ce7-dcb2391adfdb	MLOBCLR1XXX	675a3cb8-5cd4-4d73-8af6-9deca1a7c80e	t	AV APOQUINDO 3500, FLOOR 9 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-76c72a2a-eb47-4c98-acf2-734e50446d99	MMEBMTM10M2	463fec50-d84c-4309-abaf-078fc671d064	f	QORMI, QORMI
-bab584cf-a040-4bb0-85f9-902019f04fb7	MMEBMTMTXXX	463fec50-d84c-4309-abaf-078fc671d064	t	116 ARCHBISHOP STREET  VALLETTA, VALLETTA, VLT 1444
-199ef8fc-9e74-4bf4-80e7-439ec83de10f	MMSEMCM1XXX	2af9d70b-0b98-4f12-9395-11d3074e8120	t	VILLA LA MADONE 11 BOULEVARD DES MOULINS MONACO, MONACO, 98000
-51fe42aa-08a5-45f7-949a-46657b3c9802	MNAAPLP2XXX	bb578c31-c7a3-4a6a-a886-239b0d673569	t	PLAC UNII, BUDYNEK B PULAWSKA 2, FLOOR 3 WARSZAWA, MAZOWIECKIE, 02-566
-5702c5ae-ca24-49e4-b8ee-c1651b322963	MNEXCLRMXXX	da006820-c933-45c0-b566-720642c0d353	t	AV EL BOSQUE SUR 130, FLOOR 7 CONSORCIO, LAS CONDES - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-1aca32e7-007a-452e-904c-705075250050	MOCBCLR1XXX	91153ccc-8290-49be-a794-29d44bac9533	t	MARIANO SANCHEZ FONTECILLA 310 BIRMANN - SANTIAGO, PROVINCIA DE SANTIAGO, 7550296
-e3d78664-fbcf-4b97-a824-b7720028e396	MOPZPLP2XXX	4ba81de1-98c0-4b2e-a095-26dab61a148a	t	LOK. 700 GRZYBOWSKA 80/82 WARSZAWA, MAZOWIECKIE, 00-844
-26a926d2-da5c-48f1-b796-053eb50ac3d3	MOXSLV21XXX	6d11188a-9a10-4d1d-9f66-bb8e4b3bc959	t	LACPLESA STREET 88  RIGA, RIGA, LV-1003
-72535ace-9cf8-409d-a7e2-8d9730aabff1	MSDMPLP2XXX	5a3a2240-3894-449f-85c3-c33dc64047fd	t	ALEJE JEROZOLIMSKIE 100, FLOOR 6  WARSZAWA, MAZOWIECKIE, 00-807
-e927180e-0772-4f2f-aaf4-0c6f0211a332	MSEMMCMCXXX	399fbafc-61cf-48d2-ac75-67c5167e94f6	t	FLOOR 5, EST-OUEST 24 BOULEVARD PRINCESSE CHARLOTTE, MONTE CARLO MONACO, MONACO, 98000
-febba9f3-9740-44f2-bb64-6a02d314bb23	MSFVMTM1XXX	dc17b03b-f814-4c0b-a43f-e58ffd9cb74b	t	SUITE 2 , FLOOR 2, SKYWAY OFFICES BLOCK B 178 MARINA STREET PIETA, PIETA, PTA 9042
-de4aef08-f81f-4d2a-bfa2-9eb0f885f657	MSZCPLP1XXX	a6992074-d577-474f-83fe-8daafb2ae58f	t	UL.WARSZAWSKA 5  MSZCZONOW, MAZOWIECKIE, 96-320
-3bc7a0f2-7c02-40ee-97e4-7a80d4eb25c6	MTCCMTMTSTJ	c0054e3f-4fd6-4700-8f19-e42992559b91	f	ST JULIANS BUSINESS CENTRE 2 ELIA ZAMMIT STREET - ST. JULIAN'S, ST JULIAN'S, STJ 3153
-6286ceaa-fe27-40f8-8811-d56bff66204b	MTCCMTMTXXX	c0054e3f-4fd6-4700-8f19-e42992559b91	t	ST JULIANS BUSINESS CENTRE 2 ELIA ZAMMIT STREET - ST. JULIAN'S, ST JULIAN'S, STJ 3155
-067cd359-ddef-4b8f-9f3d-72caed7b38ab	MUAABGS1XXX	9d5b894a-7e22-4a33-b9a5-7484162e1c4c	t	VRABCHA 10  SOFIA, SOFIA, 1000
-0657b7b6-b68d-466d-9f2e-bb77bb827616	MULTLV2XXXX	13f4c7bd-8e16-4db5-86ad-d702f6dba11f	t	MUITAS IELA 1  RIGA, RIGA, LV-1010
-734af800-924c-4c7d-89c1-ac7843147ee7	MYFNBGSFXXX	b5960ac8-82fb-4c4e-bfc9-984ee7952f0f	t	TSARIGRADSKO SHOSE BLVD. 111P  SOFIA, SOFIA, 1784
-61f7278c-0073-43ee-a5ae-d070eddafba2	MZNSMTM1XXX	006f3d6e-1bc1-4625-9681-745f476a52d4	t	MZ HOUSE 55 ST RITA STREET RABAT, RABAT, RBT 1523
-85328953-2975-498e-ad8e-bf23084b560f	NACNUYMMXXX	00d85d29-2784-4dec-90bf-e816c3a2ea31	t	JUAN CARLOS GOMEZ 1372  MONTEVIDEO, MONTEVIDEO, 11000
-88fc6eca-a06c-42be-9a2f-d55a3e35c60f	NASBBGSFXXX	022ab7f8-130c-4444-aa34-e2e71ae4ad59	t	DYAKON IGNATIY STREET 1  SOFIA, SOFIA, 1000
-9b96420e-66f9-4dc2-b714-7fd299f701f1	NBPLPLPAXXX	b7ef5266-94eb-43a6-a06f-931de709b85d	t	UL. SWIETOKRZYSKA 11/21  WARSZAWA, MAZOWIECKIE, 00-919
-6ab2f1b2-632d-4e8c-88e5-3959122a0b80	NBPLPLPDDSP	b7ef5266-94eb-43a6-a06f-931de709b85d	f	WARSZAWA, MAZOWIECKIE
-539184f0-2252-4beb-99d2-bd59c6f69787	NBPLPLPDREZ	b7ef5266-94eb-43a6-a06f-931de709b85d	f	UL. SWIETOKRZYSKA 11/21  WARSZAWA, MAZOWIECKIE, 00-919
-cea108d3-bb05-48b8-9af8-d8a27c0b5c02	NBPLPLPDXXX	b7ef5266-94eb-43a6-a06f-931de709b85d	t	UL. SWIETOKRZYSKA 11/21  WARSZAWA, MAZOWIECKIE, 00-919
-a22c0c51-dda8-42b9-b487-03f294517558	NBPLPLPWBAN	b7ef5266-94eb-43a6-a06f-931de709b85d	f	WARSZAWA, MAZOWIECKIE
-d6a2922c-5593-445b-92e4-bbcdccac0eaf	NBPLPLPWCRD	b7ef5266-94eb-43a6-a06f-931de709b85d	f	SWIETOKRZYSKA 11-21  WARSZAWA, MAZOWIECKIE, 00-919
-e0101882-0bd9-4756-b893-d1bd9551b322	NBPLPLPWKIR	b7ef5266-94eb-43a6-a06f-931de709b85d	f	WARSZAWA, MAZOWIECKIE
-3f268438-689d-495c-be30-ef7a0c178ce2	NBPLPLPWSEA	b7ef5266-94eb-43a6-a06f-931de709b85d	f	SWIETOKRZYSKA 11-21  WARSZAWA, MAZOWIECKIE, 00-919
-7c9adc24-b086-405c-829d-670f25482b72	NBPLPLPWSEB	b7ef5266-94eb-43a6-a06f-931de709b85d	f	SWIETOKRZYSKA 11-21  WARSZAWA, MAZOWIECKIE, 00-919
-616957c5-e4bf-4c2c-8d24-ce5a5aa443eb	NBPLPLPWXXX	b7ef5266-94eb-43a6-a06f-931de709b85d	t	SWIETOKRZYSKA 11-21  WARSZAWA, MAZOWIECKIE, 00-919
-74ca6487-c589-47c6-83f1-5572cba7c8b7	NCBAALTXXXX	55009faa-261a-4dc3-9c85-17f0ca6712dc	t	RRUGA E VILAVE, LUNDER 1  TIRANA, TIRANA, 1045
-64d10fec-81e0-4a8c-9f36-4e042ffb99c8	NESBPLPWFMB	c8f2af6e-375b-4ecf-993b-03c2a87dbe02	f	SOLEC 38  WARSZAWA, MAZOWIECKIE, 00-394
-ae083569-67a1-42bb-9471-a4bc437a76e3	NESBPLPWXXX	c8f2af6e-375b-4ecf-993b-03c2a87dbe02	t	UL. WOLOSKA 24  WARSZAWA, MAZOWIECKIE, 02-675
-8128b10d-aa0f-4d15-bb9c-b5b378a950a8	NEVACLR1XXX	213c7e84-986c-4210-8c19-05e2ae7ee612	t	ROSARIO NORTE 555, FLOOR 15 LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-61f40b8f-3d41-4b19-addb-8bf9c53aae3e	NEXDMTM2XXX	8bc9ee20-003f-4b64-9b93-8afdd7f17c86	t	
-2213cde6-a5e2-4897-a366-cc8695935349	NIXGLV21XXX	79e73ff5-e2f6-4326-965b-1d558edf7301	t	MASKAVAS 240-3  RIGA, RIGA, LV-1063
-058e00fc-9fc8-4d7b-a91d-840e7c4bf3d8	NODABGS1XXX	5bd962d4-7bb2-4494-b193-7d2b68653707	t	HRISTO BELCHEV 28  SOFIA, SOFIA, 1000
-1417ee27-4aad-4401-a855-8587a2a514e5	NORDMCM1XXX	e2e7065b-3b4f-4ed1-a07c-2347fcea6d48	t	27 AVENUE DE LA COSTA  MONACO, MONACO, 98001
-21520203-f992-4943-b96f-b5acff04874a	NOSJPLPPXXX	36c81cfb-9393-4bde-8fbe-76b95ced5552	t	UL. MALE GARBARY 8  POZNAN, WIELKOPOLSKIE, 61-756
-adeba7e6-723b-42c9-98d5-797bcd5bee4d	NOSWPLP1XXX	93ff1fe4-ed61-4647-94d9-1518fdadd184	t	RYNEK PULASKIEGO 2  NOWY STAW, POMORSKIE, 82-230
-202b4957-ce61-412c-8c62-13d2b2abf0f7	NWDMPLP2XXX	ef370d97-d36b-4362-8077-681519e78458	t	NOWY SWIAT 64  WARSZAWA, MAZOWIECKIE, 00-357
-bfc9bbc1-713a-47cb-a054-c364914a3c8f	ODMSPLP1XXX	9b66a7f1-e704-4b0e-9dfa-34ea48726c3f	t	RONDO ONZ 1  WARSZAWA, MAZOWIECKIE, 00-124
-050a8e3c-401a-4901-a081-80eefc42e56b	OKBALV21XXX	012889f6-377e-4acc-ac56-2eee283cb2a9	t	E. BIRZNIEKA UPISHA STR. 12  RIGA, RIGA, LV-1050
-da76e954-d88b-486d-92a9-ce0639b553fb	OKOYLV2XXXX	b0976647-ade4-4169-aa8d-d884c0f83271	t	MUITAS IELA 1  RIGA, RIGA, LV-1010
-cc485b53-dcd3-4d42-9aa7-8bfd0bed8566	OPAYLV21XXX	04074ee9-e104-4dbb-8b43-dce0bba05d2a	t	REPUBLIKAS LAUKUMS 3 - 107  RIGA, RIGA, LV-1010
-ebd8e2ca-6b93-411c-81af-9e290c12ac05	OSTRPLP1XXX	9d8965d5-a5cb-420d-b28d-44dc0445e11d	t	UL.KILINSKIEGO 42  OSTROLEKA, MAZOWIECKIE, 07-410
-cd012625-21ae-4cbe-b07e-e87bc20b725f	PABYMTM2XXX	ed3661ab-4468-4790-bfbd-5f6d92892a3e	t	FLOOR 2 14 VAULT, VALLETTA WATERFRONT BIRKIRKARA, BIRKIRKARA, BKR 4013
-2e5c2aa5-18f7-4e54-b02b-71ad164098ba	PAEXLV21XXX	74259f96-4c8f-429f-b59d-69a8f22bdb51	t	REPUBLIKAS SQUARE 2A  RIGA, RIGA, LV-1522
-1b60fbc4-da65-4924-891f-3d028dd5d191	PAMBPLP1XXX	fe6ad914-061e-4836-a38a-bcc98d97df05	t	UL KROLEWSKA 27  WARSZAWA, MAZOWIECKIE, 00-060
-7b8c22cd-f37e-4788-a910-87aeb7e640ab	PANXLV22XXX	dafe12af-1adf-4efe-8fa3-ed7a78aba77b	t	DELU IELA 4  RIGA, RIGA, LV-1004
-5625ab68-c22d-41b6-b198-c0a8bea1ef58	PANXPLP2XXX	0b00ea29-7905-4582-8a7f-15aa8165ce51	t	AL. ALEJE JEROZOLIMSKIE 123A  WARSZAWA, MAZOWIECKIE, 02-017
-9c9eddea-f067-44f7-b5c2-b6b04bafb1c9	PAPYMTMTXXX	52e5ed01-67de-449b-8f86-718fdd6cb77b	t	31 SLIEMA ROAD  GZIRA, GZIRA, GZR 1637
-da4e0666-0f6a-4f8b-a370-700fbcb83b9a	PARBPLPXXXX	1d0070eb-00b4-4d8a-bcf0-95b62e59606a	t	UL. WRONIA 31  WARSZAWA, MAZOWIECKIE, 00-846
-fffe16e4-e3bb-4e8d-9683-20315bfff391	PARXLV22TIP	f8e9f760-6b7d-4bb3-b2fa-c400563a8a43	f	REPUBLIKAS LAUKUMS 2A  RIGA, RIGA, LV-1010
-69febf79-c6c1-4a7a-8203-a1b097867d95	PARXLV22XXX	f8e9f760-6b7d-4bb3-b2fa-c400563a8a43	t	REPUBLIKAS LAUKUMS 2A  RIGA, RIGA, LV-1010
-0dfa8134-9946-475a-b863-d20ed0d24f8c	PATCBGSFXXX	8052afda-4889-4001-8caa-be9388c84b42	t	JAMES BOURCHIER 76A HILL TOWER 1 SOFIA, SOFIA, 1407
-6a1114f9-06aa-4636-87ce-5022606ecc6a	PAUUMTM1XXX	af4ef4a5-34b4-4ede-9f3d-b4617c04770e	t	SUITE 3, FLOOR 2, SKYWAY OFFICES BLOCK A 177 MARINA STREET PIETA, PIETA, PTA 9072
-8fdbf8e0-2ad5-4b35-b146-a2a4fa39a771	PAYELV21XXX	24dfa394-39e5-45a5-9485-9cc760f4f025	t	ZEMGALU IELA 23A - 19  RIGA, RIGA, LV-1006
-8620b6f9-7bd5-46ca-a67e-53c9b0d94a05	PBSTPLP1XXX	8ac9ca3b-79d3-4838-9352-2166fbf7485f	t	PL.KAZIMIERZA WLK. 7  INOWLODZ, LODZKIE, 97-215
-c66ef4e5-0a68-496e-b84c-f782857c11c1	PCBCCLRMCLR	e989b108-0e8a-4975-aef7-5b2aefbd6b3e	f	
-fcb5994b-4001-4f17-a815-d9ec61088677	PCBCCLRMXXX	e989b108-0e8a-4975-aef7-5b2aefbd6b3e	t	
-03aacfe6-c030-43cc-a495-41356c020a57	PCBCPLPWXXX	e9dacb00-da7a-4159-bf31-606dabf2278e	t	WARSAW FINANCIAL CENTER UL.EMILII PLATER 53, FLOOR 15 WARSZAWA, MAZOWIECKIE, 00-113
-e1e6f615-a557-48d9-a79a-d48a24fe6e6b	PCCACLR2XXX	e676bacf-cc4f-4f0d-a310-203396bc1624	t	CERRO COLORADO 5240, FLOOR 8 TORRE 2 - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-e19ea714-8f33-4eae-a783-3022c115d1a5	PCCACLRMXXX	e676bacf-cc4f-4f0d-a310-203396bc1624	t	CERRO COLORADO 5240, FLOOR 8 TORRE DEL PARQUE II - SANTIAGO, PROVINCIA DE SANTIAGO, 7560995
-4a156002-1828-44ee-b22e-57da6c15e295	PCCACLRZXXX	e676bacf-cc4f-4f0d-a310-203396bc1624	t	
-ab1974a4-eaf5-48d5-9a74-c79790f5fe28	PDKFMTM1XXX	362da1aa-0859-46e2-a967-4337e5dbb535	t	ST. GEORGES ROAD  - ST. JULIAN'S ST. JULIAN'S, STJ 3208
-cfd28c49-305b-4487-9e2b-b9f4b5061ff1	PESIMTM1XXX	7c0215a5-f92d-460a-b57b-fe998dacee04	t	FLOOR 1 SQAQ IL-FAWWARA SLIEMA, SLIEMA, SLM 1670
-febc35fc-5dd7-4ae1-8a49-ffe85a2a514d	PHPYMTM1XXX	143506b0-0840-4b09-a6cc-f2508138e7fc	t	SOUTH STREET  VALLETTA, VALLETTA, VLT 1103
-0288a2b6-4498-4261-ad8a-bd9e9a606bb0	PICTMCMCCOR	d0a8ce23-d0de-47ae-9953-7e394dbca981	f	VILLA MIRAFLORES 2 AVENUE SAINT-MICHEL MONACO, MONACO, 98000
-bef912f3-2dde-460d-84cf-45304551fc24	PICTMCMCXXX	d0a8ce23-d0de-47ae-9953-7e394dbca981	t	VILLA MIRAFLORES 2 AVENUE SAINT-MICHEL MONACO, MONACO, 98000
-d75ee3cc-fc3a-4d14-aa23-78ff7750a574	PKOPPLPWCUS	6a65c5b2-079b-4b19-a5c6-bd33c4681d91	f	ZUBRA 1, FLOOR 9  WARSZAWA, MAZOWIECKIE, 01-066
-75895744-b6d9-46a8-959e-a78cb8eaa64d	PKOPPLPWKRA	6a65c5b2-079b-4b19-a5c6-bd33c4681d91	f	JOSEPHA CONRADA STR 37  KRAKOW, MALOPOLSKIE, 31-357
-75acc377-10c9-48e4-8354-fe3a51111245	PKOPPLPWSMK	6a65c5b2-079b-4b19-a5c6-bd33c4681d91	f	WOLOSKA 18, FLOOR V  WARSZAWA, MAZOWIECKIE, 02-675
-5470e4b6-92c9-4f90-b139-d5daef3e4055	PKOPPLPWXXX	6a65c5b2-079b-4b19-a5c6-bd33c4681d91	t	ZUBRA 1  WARSZAWA, WARSZAWA, 01-066
-7105f59b-bf48-4828-8b0b-fecde3f8afd8	PMMCMCMCXXX	58d31b72-9d12-427d-8900-a07c28e07d6c	t	7 RUE DU GABIAN  MONACO, MONACO, 98000
-53385b66-21fd-4870-9e88-def33d4e0c96	POCZPLP4XXX	cf0c7eb7-26f2-45b1-a2b9-304727983de6	t	JAGIELLONSKA 17  - BYDGOSZCZ KUJAWSKO-POMORSKIE, 85-959
-3ad75ec7-eba0-4e50-a5db-5ba67eaa9442	PODSPLA1XXX	2050518c-c800-462f-bba9-1716f7c3bb39	t	MICKIEWICZA 7  SANOK, PODKARPACKIE, 38-500
-5f92c173-d7a5-4c28-bae6-fd1beafca010	PODSPLP1XXX	2050518c-c800-462f-bba9-1716f7c3bb39	t	UL. KOSCIUSZKI 22  SANOK, PODKARPACKIE, 38-500
-4e7c6387-e514-40cc-a48d-0bf5d060a880	POLUPLPRXXX	9557c312-e82e-4a36-9dab-71c42f287c6a	t	UL. GRZYBOWSKA 81  WARSZAWA, MAZOWIECKIE, 00-844
-d3b8f7a4-de72-44bb-945b-22e2f5baaba0	POPOPLP1XXX	ffdc3a2c-61fe-439d-8c2b-83c60da095ff	t	UL. RAKOWIECKA 26  WARSZAWA, MAZOWIECKIE, 00-940
-cd7c98e8-e930-40a2-b2e0-19c2dfb0ce6f	POROPLP1XXX	a5b69c56-92ef-4c0e-a1aa-1323249524f7	t	KRUCZA 5/11D  WARSZAWA, MAZOWIECKIE, 00-548
-10a6b6c5-451d-495d-a79e-be4a20cfea39	POSDPLP1XXX	bcf2a64d-e30d-4a93-b3ab-9e838637e27f	t	UL. RYNEK 6A  - KEDZIERZYN-KOZLE POWIAT KEDZIERZYNSKO-KOZIELSKI 47-200
-93f696ee-5052-4247-99d6-5ac188ed8ab8	POSOMCM1XXX	74bc69fc-8c6e-491f-917e-47d1c3193695	t	3 RUE PRINCESSE FLORESTINE  MONACO, MONACO, 98011
-d4c0d35b-00fc-4efd-8bb5-490faaaf9979	POZNPLP1XXX	caf7d260-511f-4178-aa48-655be2df8855	t	UL. WARSZAWSKA 349  POZNAN, WIELKOPOLSKIE, 61-057
-17ae2ce6-4be4-4440-86c8-5b7146050427	PPABPLPKXXX	e0f621a6-1d86-4f32-9a1c-c8a0167a7a80	t	KASPRZAKA STREET 2  WARSZAWA, MAZOWIECKIE, 01-211
-5fbe55cf-7e93-4c4c-9d8e-30446303aa45	PRBACLR1XXX	82c7602c-55bd-4185-9f2d-9f26a990bb79	t	
-fdb18196-b1dd-4dd9-82e9-55fe9e7f14f1	PRBAUYMMXXX	bda993c0-e008-4f13-8665-c023c9cbee40	t	L A. DE HERRERA 1248 WORLD TRADE CENTER TORRE II, OF. 111 MONTEVIDEO, MONTEVIDEO, 13000
-0b9f2787-a3ce-4e9f-80c5-4d58365189e6	PRCBBGSFXXX	04504633-f3ed-4072-92a8-d4b36d73088d	t	TODOR ALEXANDROV BLVD 26  SOFIA, SOFIA, 1303
-ee7c252c-d302-4d1f-adc5-8ea972a495be	PSATPLPWXXX	fd3907f1-1af7-4d91-b189-69f0ded4f6f1	t	KONSTRUKTORSKA 12A  WARSZAWA, MAZOWIECKIE, 02-673
-1fb4e665-860a-436e-98ab-4775a36fc38e	PTFIPLP1XXX	73976ee2-77d7-4800-97d3-1f6738dd760e	t	UL GROJECKA 5  WARSZAWA, MAZOWIECKIE, 02-019
-c942c9e1-c8da-4cdf-9aeb-1e3042784bfe	PTFIPLPWAAP	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-845385a7-04f3-4f98-802f-66dcb7887019	PTFIPLPWADG	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-3ede1151-852e-41cf-bffb-4d8a60a61311	PTFIPLPWAKG	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-777d7efa-6975-483b-b7f2-3586551eaf3c	PTFIPLPWAKT	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-f114ef89-2866-446a-9fcb-992080a3eed6	PTFIPLPWALF	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-cd61ea7c-7a03-4ee0-90f5-ac0153e17c0c	PTFIPLPWALS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-02ae31bd-f202-444c-8b1f-46d8b34f77d7	PTFIPLPWAMM	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-af5996e5-1eb4-4350-a5fd-5901777a6956	PTFIPLPWAMS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-08be89f0-b055-45b6-ae01-0ad230e0a38d	PTFIPLPWARA	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-ddd1b0ab-aa52-4911-be32-42a9807dede4	PTFIPLPWARE	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-e5e16b6e-6733-49d9-a4f0-58ca05b5848c	PTFIPLPWARJ	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-c6092d8a-54b6-4d38-bcfb-1640d1568476	XSGOCLR1XXX	fa876a98-fc93-48fa-ad6b-fb21e28e7bc7	t	
-cd0f36d8-27d3-415d-b2c8-03faa6a293bb	PTFIPLPWARW	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-730d021e-6db4-4247-9169-d235beb83dc6	PTFIPLPWARZ	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-91d76e23-703b-4a98-abce-4998f90b6aff	PTFIPLPWASZ	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-aeafc7bd-eff4-409c-9719-ddb5e873e26d	PTFIPLPWBUR	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-0ba08eb6-3c9d-4582-87bb-ca0b15e7fbb4	PTFIPLPWDEL	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-2304c258-9939-4be6-b1b5-ede076f5a976	PTFIPLPWDIA	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-dcd70c40-5362-4882-930f-9df6038e05ef	PTFIPLPWDUS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-3d2b62b7-fd34-458e-a7ac-58300e8702cb	PTFIPLPWE20	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-d2bf0c7c-2f01-4497-a802-cbbdf4fdbf19	PTFIPLPWE30	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-18a9ee75-020f-449b-b937-4dcd389c88fa	PTFIPLPWE40	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-628ea930-ceda-4ba4-858d-89b9b7983c65	PTFIPLPWE50	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-461d49c7-8faa-4d12-84fb-d63c14954ae3	PTFIPLPWE60	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-ed7f4189-cb30-4fbe-9e34-4740a3aab55b	PTFIPLPWE70	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-50d04c8d-f8f0-490b-95c0-14069d19b000	PTFIPLPWECH	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-94680d6a-bc4f-4446-ae11-eaa236f7bbf9	PTFIPLPWEOS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-39ef4550-68c9-470c-8217-9c0d08ed6340	PTFIPLPWEWZ	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-7f6c4c18-caa5-4b74-9ab6-65f3909cf5f9	PTFIPLPWFAP	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-5a6df0c1-c7b7-41a8-bad7-65e1f754645f	PTFIPLPWFBL	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-c7acd634-902b-4a9c-a6e3-96d8989153e3	PTFIPLPWFDL	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-25770ac3-ea31-4c60-a270-be4f85992b0a	PTFIPLPWFEO	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-53a18d3c-4695-468f-9322-7f3b9422ce5f	PTFIPLPWFGD	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-9eb34522-f9b8-4285-9e81-125bece7517d	PTFIPLPWFGM	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-0de9ed3a-6a42-448a-98cd-2e891f0a4717	PTFIPLPWFGS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-bbb4f636-79da-4edb-a303-25c1194aefda	PTFIPLPWFIB	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-032f8124-f426-496a-b721-0345e460ba3a	PTFIPLPWFMS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-5d4e12d7-24b6-48b3-a70d-c8f84da37cee	PTFIPLPWFNK	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-a82fea6b-6d84-46cc-a532-08ada7cd2a2f	PTFIPLPWFOD	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-c3f3f75e-d37d-4292-86ae-862175ef3aa0	PTFIPLPWFOS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-c2ef2c27-8cc4-473a-b6c4-45835c5ab0c6	PTFIPLPWFOW	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-41dcf89e-e570-4fc6-a03f-6f4186ea7259	PTFIPLPWFSD	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-b19b25ab-a82d-4c24-9c4d-c5c5b5ca55f8	PTFIPLPWFSF	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-1a62b6fa-5b15-493d-8c54-3e14b254fedb	PTFIPLPWFSK	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-067591e3-9282-4635-9808-44e83cea6671	PTFIPLPWFSO	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-66991fdc-08eb-4373-b548-cb09f7e40e10	PTFIPLPWFSS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-d3ea2a19-0829-4b24-95aa-bfeeaf4b95fd	PTFIPLPWFSW	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-dd6feae1-74c9-4fc5-9f81-a7f4eb0dd260	PTFIPLPWGAM	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-de580b39-276f-4b51-a450-2148ce19ae8c	PTFIPLPWGPL	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-29e750a1-6fbd-44b3-9327-8a4cab867b23	PTFIPLPWMDG	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-4c16852f-0a66-4338-a668-c4aedc5e6fab	PTFIPLPWMER	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-8020a3be-3ebd-42cc-b560-8ad3ccbc7e4e	PTFIPLPWNED	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-bb0b8f9b-e492-4efa-accf-987b16cf458b	PTFIPLPWOBG	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-14b8bca4-8097-4893-991c-0ed3339bedf5	PTFIPLPWOGL	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-6934a664-3f74-4011-9666-c10db537b01d	PTFIPLPWOKP	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-cc09caac-b6cf-401e-b525-4c021b6bd4e1	PTFIPLPWORP	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-de6881c4-e2d1-4510-bb8f-348fc46a15de	PTFIPLPWP25	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-75f1f521-a60b-4e63-9ae6-ea02cc164b56	PTFIPLPWP30	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-9b5c9263-7fe1-4c8b-aa13-893c5dbba70f	PTFIPLPWP35	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-720ec547-48d5-44e1-9de9-0e3fd7b977eb	PTFIPLPWP40	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-7888be8d-be8b-46ba-b034-fb5a93c02dff	PTFIPLPWP45	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-608e74cd-3ff6-4697-8f6e-d4816bc671d2	PTFIPLPWP50	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-a57c77fc-8aa9-43ee-942f-b064c551a3c1	PTFIPLPWP55	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-9257e8a3-a2b5-430d-ad88-ef72cfa3a2f5	PTFIPLPWP60	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-38636592-b38b-46a2-9e36-3d3505f73b7f	PTFIPLPWP65	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-4f0525d8-bc1e-4cbc-aa84-c516d1a14892	PTFIPLPWPAK	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-b77a9cb2-e2b6-4568-917f-46459f0dcc47	PTFIPLPWPAS	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-61c7f4e7-21ad-4400-8ec3-c0fd9d8c70f0	PTFIPLPWPOB	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-03f49334-c9e8-4c5a-9df4-f45fab228dac	PTFIPLPWPPI	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-ef74900a-dba4-4cb1-a76c-4b5148b10231	PTFIPLPWRUB	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-41d195c1-4f5c-480d-998b-9c2b3e14beed	PTFIPLPWSGM	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-72bcc35d-f3b1-4ebc-afcc-cd6b3d9c32d1	PTFIPLPWSIG	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-817f3d43-9f1e-4086-a22f-19130f83c53c	PTFIPLPWSUR	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-b5bfe51d-2620-4dde-9f17-02b91bbc7919	PTFIPLPWSZA	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-858a8b50-aa21-4cfb-85d9-36cc56552ca5	PTFIPLPWSZM	73976ee2-77d7-4800-97d3-1f6738dd760e	f	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-c71792d8-f2a0-47c6-9050-391ba56e36ad	PTFIPLPWXXX	73976ee2-77d7-4800-97d3-1f6738dd760e	t	UL CHLODNA 52  WARSZAWA, MAZOWIECKIE, 00-872
-f54fe94a-07e0-4c11-b9a0-e611942f580b	PUPPALTRXXX	a786549b-f754-4231-940d-e2d53f98e988	t	TWIN TOWERS 1ST TOWER BLVD.DESHMORET E KOMBIT TIRANA, TIRANA, 1010
-39307992-14d3-4916-8ddc-0ebf2a87ce27	PYALALT2XXX	ee9e18d8-1b8f-4ec0-a810-84af54f02778	t	PALLATI DONIKA, FLOOR KATI 3 RR. FADIL RADA TIRANA, TIRANA, 1001
-48d04829-2928-4c60-9120-2676f8ad0aea	PYMNBGS2XXX	c539632a-55bc-4763-ba60-202125d9d8d4	t	STR. BULGARIA 49 BUSINESS CENTER VITOSHA, FLOOR 1 SOFIA, SOFIA, 1404
-bb2dcb5f-3c85-4276-96c8-e04fff188ef2	PYMXMTMAXXX	df1df1da-6720-4488-a882-61415875e576	t	THE PENTHOUSE TAZ-ZWEJT STREET SAN GWANN, SAN GWANN, SGN 3000
-262be3e7-0e09-4f97-9850-2988df3b63b3	PYMXMTMTMAL	df1df1da-6720-4488-a882-61415875e576	f	BLOCK A, FLOOR 2, CAPITAL BUSINESS CENTRE TRIQ TAZ- ZWEJT SAN GWANN, SAN GWANN, SGN 3000
-a221b89f-d287-4e3c-9679-366efe5faf4b	PYMXMTMTXXX	df1df1da-6720-4488-a882-61415875e576	t	FLOOR 2, CAPITAL BUSINESS CENTRE BLOCK A TRIQ TAZ- ZWEJT SAN GWANN, SAN GWANN, SGN 3000
-d2dcdd5b-90ad-4831-a886-f50beb8a729c	PZUAPLP1XXX	5ed7c4ec-56a6-4869-891e-8165059df5e0	t	AL. JANA PAWLA II 24  WARSZAWA, MAZOWIECKIE, 00-133
-b7f1f0a1-f5b4-4dc3-802d-f78cb02a9b17	PZUSPLPPXXX	ee29832c-d038-40ee-8ac7-7e30b5daa760	t	PZU PARK RONDO IGNACEGO DASZYNSKIEGO 4 WARSZAWA, MAZOWIECKIE, 00-843
-11d5c256-7b8d-42e9-a6a0-8ead072792a3	PZUZPLPPXXX	fe19f8ba-ed27-40d7-8ff7-20958315e9fb	t	PZU PARK RONDO IGNACEGO DASZYNSKIEGO 4 WARSZAWA, MAZOWIECKIE, 00-843
-9eac6c88-a5e2-4f9e-b9b3-d316b7d973b4	RAFAUYM1XXX	3ebff7a9-76da-4c32-9135-c083bad59924	t	18 DE JULIO 1883  MONTEVIDEO, MONTEVIDEO, 11200
-31cfff11-9502-4700-8b1e-27a21adae558	RAMUBGS1XXX	99577410-f04f-48d8-bdec-0474e23e574b	t	VAPTZAROV BLVD, PHASE2 NIKOLA I 55 BUSINESS CENTER EXPO 2000 SOFIA, SOFIA, 1407
-889d249f-8dee-4749-96bd-fa1e82d17963	RATIPLP1XXX	9d772d8b-5ca9-4301-abdf-f42c649d0522	t	KRASINSKIEGO 16  POZNAN, WIELKOPOLSKIE, 60-830
-76eb4547-c102-4623-9ba0-43d0d66deeca	RBTTAWAWXXX	f9090a13-2ba0-45ee-9d4e-531fd64ee946	t	ITALIESTRAAT 36  - ORANJESTAD ORANJESTAD-WEST AND ORANJESTAD-EAST
-1050fa53-8123-41c9-acab-9ff1acd1ab19	REBCCLR1XXX	cb9f6470-9b94-4d8f-8d92-a84f02030182	t	
-adf959b9-9a1b-4535-8cc3-5b5d3a2b6f8d	RECVMTM1001	17001c7d-6c3e-427c-924e-8a825e863f8d	f	SUITE 2, FLOOR 3 1 BREWERY STREET MRIEHEL, BIRKIRKARA , BKR 3000
-2b86c135-0139-49a2-832d-895c33c315c5	RECVMTM1XXX	17001c7d-6c3e-427c-924e-8a825e863f8d	t	SUITE 2, FLOOR 3 1 BREWERY STREET MRIEHEL, BIRKIRKARA , BKR 3000
-3ab5c1e4-6b96-432e-a2a9-95d164a7f124	REIABG21XXX	ccbb2bf5-050b-4432-bffe-1193b3a395d4	t	ST. CONSTANTINE AND HELENA ADMINISTRATIVE BUILDING VARNA, VARNA, 9000
-b5437366-1e7a-4111-8d40-d70ef6e08a21	REVCMTM2XXX	d911c403-e70c-4187-853d-04fa4a891042	t	FLOOR 1, CENTRAL NORTH BUSINESS CENTRE SQAQ IL-FAWWARA SLIEMA, SLIEMA, SLM 1670
-da341f76-cfea-4bd8-9f21-01845a907624	RFAABG21XXX	c219f282-bb8a-4bf8-868a-2eab3290236e	t	ALEXANDER S. PUSHKIN 24  VARNA, VARNA, 9002
-7ab11a8f-b635-4c41-a9b6-8483e4ee9c55	RHBHPLPWXXX	14db5adf-29c0-4b6a-b253-ba773753edb6	t	PROSTA 18  WARSZAWA, MAZOWIECKIE, 00-850
-f708bb79-2991-4f57-8774-5dc27bcb111c	RIBRLV22TIP	b4025598-fcda-4630-95b0-ef9c23ece2ef	f	J. ALUNANA 2  RIGA, RIGA, LV-1010
-c53667ed-e7da-44c9-8936-5fed639b1acf	RIBRLV22XXX	b4025598-fcda-4630-95b0-ef9c23ece2ef	t	J. ALUNANA 2  RIGA, RIGA, LV-1010
-34ca5ff3-b9fb-421c-9f66-3e4ce05442fa	RIFSMTM1XXX	ad723942-0c0e-465d-974f-5b5344a075c0	t	FLOOR 3, AIRWAYS HOUSE HIGH STREET SLIEMA, SLIEMA, SLM 1549
-d6c2e6e1-48d1-4f0e-bbc7-9b312af34e13	RIKOLV2XIPA	03235c9a-2906-465f-a9db-097bf6db4185	f	SKANSTES STREET 12  RIGA, RIGA, LV-1013
-ac1c5a10-06ea-41af-959b-59d47e51bba3	RIKOLV2XXXX	03235c9a-2906-465f-a9db-097bf6db4185	t	SKANSTES STREET 12  RIGA, RIGA, LV-1013
-6337113b-31f1-4084-bc4a-41a6b26f0cf3	RMMNMTM2XXX	d31b06b9-b5aa-4dad-a36f-9340a07afda4	t	113B PAOLA ROAD  TARXIEN, TARXIEN, TXN 1807
-43cd4ad1-544a-4d14-ab8c-ebfa17f6950c	RPLYCLRMXXX	9e405125-82fd-44ec-8059-88c59a048302	t	
-3fb44c3a-6248-48e8-9a5f-081f1074cb3b	RSTAALTTXXX	59232f1a-5575-4607-bf9c-0c24943e468b	t	AP 3, KATI 1, FLOOR 3 RRUGA NIKOLLA TUPE 1, NJESIA BASHKIAKE 5 TIRANA, TIRANA, 1001
-05da11f9-7495-4476-958b-ba923b75ca77	RTLDMCMCXXX	8230ad8d-233c-4194-b5f7-42fe780933f5	t	4-6 RUELLE SAINTE DEVOTE  MONACO, MONACO, 98000
-86746aa9-d83f-40e9-a667-738ade36f4f6	RTMBLV2XTIP	86a728f3-f29e-4b4c-9ae2-53ea3fe16631	f	VESETAS STREET 7  RIGA, RIGA, LV-1013
-f5f73b32-92c7-47f0-841f-cb67cade73dd	RTMBLV2XXXX	86a728f3-f29e-4b4c-9ae2-53ea3fe16631	t	VESETAS STREET 7  RIGA, RIGA, LV-1013
-aeaba102-16ea-4d23-ab40-0f3286d2c5ea	RTSXUYM1XXX	ec6e35df-a43a-4bf1-af5a-577189940909	t	DIAGONAL FABINI 777  MONTEVIDEO, MONTEVIDEO, 11100
-85e051d6-93f2-4cfe-a094-4393449be2db	RZBBBGSFXXX	609b2655-6cdc-4b3e-980f-22b4d850e390	t	VITOSHA BLVD. 89B MILLENNIUM CENTER SOFIA, SOFIA, 1463
-79bf1f49-0944-4f2b-84a1-672b8df699bc	RZBMMTM1XXX	1d34bc8c-943e-4aa7-b99d-9e1b4074c334	t	IL-PIAZZETTA 52 TOWER ROAD SLIEMA, SLIEMA, SLM 1607
-6c1fbb7e-8831-4677-bb7e-eb8bd1b82c3d	SANMCLR1XXX	cec0f720-71df-47ba-9223-7b45414d2447	t	BANDERA 140, FLOOR 16 LAS CONDES SANTIAGO, PROVINCIA DE SANTIAGO 8320000
-a3e92e52-75ef-44de-b8a2-8faeb944b7b8	SATGBGS1XXX	52cddc46-7c7c-48da-bd17-6244d5ee9fce	t	ST. TODOR ALEXANDROV 141  SOFIA, SOFIA, 1309
-47c88688-8eb3-4ae0-a785-a2875757f738	SATTBGS1XXX	89041c13-7f26-42e4-96b7-01176b84170b	t	BULGARIA BLVD 58  SOFIA, SOFIA, 1680
-05f67408-73f7-4550-91fa-8c0fea84b37a	SBELPLP1XXX	379441e4-4480-48f3-a824-66d0109b6533	t	UL.PILSUDSKIEGO 14  BELCHATOW, LODZKIE, 97-400
-607a7188-045f-437f-9d19-50ea9230a0c8	SBLEPLP1XXX	8f6d8be2-4b46-48fe-954c-3184173976d6	t	ZACISZE 14/16  ELBLAG, WARMINSKO-MAZURSKIE, 82-300
-eb6eae6e-a7ef-4854-8d66-d111343b78fb	SBMMMCMCXXX	b96aca7d-a685-4df8-9091-a236be585e0f	t	PLACE DU CASINO  MONACO, MONACO, 98000
-498eb940-2c63-40e4-b5bc-ae5169a7fb2b	SBMTMTMTXXX	7e057a56-7b7e-4c07-88a4-1a1416a074bc	t	TOWNSQUARE 101 TRIQ IX XATT TA QUI SI SANA SLIEMA, SLIEMA, SLM 3112
-a91ebd19-9a1a-4d9c-885e-e8034b3a9447	SCCUPLP1XXX	8435c03b-cfaa-41bf-9ae3-671a661277a1	t	FOCUS AL. ARMII LUDOWEJ 26 WARSZAWA, MAZOWIECKIE, 00-609
-adf8959f-f36f-4ce5-846f-3bedffc2c9eb	SCFBPLPWXXX	d4ca5d18-fdac-412c-b000-3231a32e58c6	t	LEGNICKA 48 B  WROCLAW, DOLNOSLASKIE, 54-202
-7373ba5d-b90a-480c-9550-3be53f1f32cf	SDBMMCM2TPS	5486aa50-73e9-4a53-bed5-18bf5fc8d19a	f	LE PARK PALACE 27 AVENUE DE LA COSTA MONACO, MONACO, 98000
-d53f8a9c-af18-4afc-b1d3-0fa03872dce3	SDBMMCM2XXX	5486aa50-73e9-4a53-bed5-18bf5fc8d19a	t	LE PARK PALACE 27 AVENUE DE LA COSTA MONACO, MONACO, 98000
-aeef6124-595b-4df5-9c78-ae1cf766924a	SECTCLR1850	438d7143-adcd-4616-a276-04a4b8747822	f	AVDA. AMERICO VESPUCIO SUR 100 OFICINA 102 LAS CONDES - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-9f48c084-9981-4bab-ac06-105a00209673	SECTCLR1XXX	438d7143-adcd-4616-a276-04a4b8747822	t	AVDA. AMERICO VESPUCIO SUR 100 OFICINA 102 LAS CONDES - SANTIAGO PROVINCIA DE SANTIAGO, 8320000
-5cd9284a-eb8f-437b-94a3-76498909c3ef	SECTLV21961	00aa65b6-828d-46a9-ad8b-2695b32cbd27	f	FLOOR 6 DUNTES IELA 6 RIGA, RIGA, LV-1013
-1716a3d4-750f-4ad0-889a-5705f6e3cc3e	SECTLV21XXX	00aa65b6-828d-46a9-ad8b-2695b32cbd27	t	FLOOR 6 DUNTES IELA 6 RIGA, RIGA, LV-1013
-9e03afbe-b70f-4c95-a465-43986d08322c	SECTPLP14D0	8371b744-1ff7-4410-b326-d12151f38b0b	f	BUDYNEK UBC SZTURMOWA 442-742, 2A WARSZAWA, MAZOWIECKIE, 02-678
-e387e43f-fb40-4489-8253-d9fd9b968754	SECTPLP14N1	8371b744-1ff7-4410-b326-d12151f38b0b	f	UL. MICKIEWICZA 52  WRONKI, WIELKOPOLSKIE, 64-510
-918732de-3264-471f-a519-912a1b597aed	SECTPLP1XXX	8371b744-1ff7-4410-b326-d12151f38b0b	t	BUDYNEK UBC SZTURMOWA 442-742, 2A WARSZAWA, MAZOWIECKIE, 02-678
-a80d3091-ba9d-40e5-9037-8ffdca8cbcdb	SEFMLV21XXX	8655cf6a-c175-4f67-bb50-f9c5e867ee0a	t	DZIRNAVU STREET 57A-4  RIGA, RIGA, LV-1010
-a8cfbc87-6434-4dfe-840f-82f3a0ca7024	SESNPLP1XXX	664ee641-bf01-4e99-849b-58aac028db73	t	MILLENIUM PLAZA SOWINSKIEGO 46 KATOWICE, SLASKIE, 40-018
-8f7ad9fb-0c39-406c-aa3a-cbd2d6fe5c03	SEUAMCM1XXX	4b495125-f025-4242-b446-db97f9d7272e	t	ATHOS PALACE 2 RUE DE LA LUJERNETTA MONACO, MONACO, 98000
-b65cffa9-8830-4612-b0c3-24626cce346b	SGBTMCMCXXX	0e4af169-4661-4905-9f76-b3e3f38217a6	t	IMMEUBLE LE REGINA 13-15 BOULEVARD DES MOULINS MONACO, MONACO, 98000
-1462bab0-e3b6-4125-ade7-cbfc8fb15413	SGSBALTXXXX	cd8140b7-452e-4a33-b1ef-7cd30dea9b76	t	RR 'DESHMORET E 4 SHKURTIT' 6  TIRANA, TIRANA, 1010
-1a570421-947d-42a2-bb72-51dc26355ffd	SGTMMCM1XXX	0e4af169-4661-4905-9f76-b3e3f38217a6	t	13-15 BOULEVARD DES MOULINS  MONACO, MONACO, 98000
-3a38e83c-9961-44b6-8676-f0c57966fc1b	SHQHALT1XXX	5bd20ac5-ac68-4403-b4f2-b70b19bd72e2	t	POSTAVE DREJTORIA E PERGJITHSHME E RR.'RESHIT COLLAKU' 4 TIRANA, TIRANA, 1005
-00694cb1-4616-41df-807a-ed4dc30874bf	SIAFLV21XXX	a9d347f1-002e-4bdb-8ad4-5e2de92cc6ce	t	BALASTA DAMBIS 70-6  RIGA, RIGA, LV-1048
-df15d1ee-5551-421f-81f5-5fd069c97cf3	SIAILV21XXX	8898a6dd-8dba-4000-a064-4cd0765bf0d6	t	MASKAVAS STR. 240  RIGA, RIGA, LV-1063
-b5ef8f49-ad57-4a1b-9514-4334921e9abe	SIAXLV22XXX	33bdf837-5d65-4e0d-bc29-0ef118d9689d	t	DZIRNAVU 42  RIGA, RIGA, LV-1010
-d0425768-ac67-4ca6-8964-4441f222dc38	SIMZLV21XXX	762ecfc7-e209-4e1e-8732-66a10d5cd127	t	BLAUMANA STR. 5A-10  RIGA, RIGA, LV-1011
-b2ccd0f4-c229-49bb-9ef5-347de0145ff5	SKARPLP1XXX	6964c2a9-f622-4ab8-8279-2a802560ce94	t	UL.ZEROMSKIEGO 7A  SKARYSZEW, MAZOWIECKIE, 26-640
-b9e5ebe7-8fed-4b5b-a4ff-c2df688e3b93	SKOKPLPWXXX	73f2b215-acca-471d-831f-4827f378e140	t	WLADYSLAWA IV 22  SOPOT, POMORSKIE, 81-743
-8b922295-514d-4f9c-bc6f-d91967fcf2c4	SKSMBGS1XXX	916a9320-e941-4cc8-a49f-753655560b73	t	EKZARH YOSIF STR 31  SOFIA, SOFIA, 1000
-44489079-2ad8-4fe6-8f23-396f520536ac	SLIPPLP1XXX	955c9f7c-345d-4ab4-90a4-a6bdf8eb20f1	t	SANDOMIERSKA 8  LIPSKO, MAZOWIECKIE, 27-300
-f2fde9d9-c623-450e-8a8b-a04531914a94	SMCTMCM1P02	1aedc438-f6c1-494f-9450-248759e1588b	f	41 BD DES MOULINS  MONACO, MONACO, 98000
-3822727f-b3ef-4951-a9e9-fa6256702e6c	SMCTMCM1P04	1aedc438-f6c1-494f-9450-248759e1588b	f	24 AV DE FONTVIEILLE  MONACO, MONACO, 98000
-e975c77e-c67b-470d-b52a-a623f439c994	SMCTMCM1XXX	1aedc438-f6c1-494f-9450-248759e1588b	t	45 RUE GRIMALDI  MONACO, MONACO, 98000
-e388a995-29fd-4a24-9098-09c04dd12f3b	SOFKBG21XXX	072154a8-ffb0-4add-9639-6c6462daa537	t	TZAR SIMEON VELKI BLVD 112  STARA ZAGORA, STARA ZAGORA, 6000
-5b0419e3-e6fc-4586-99d8-b9f5cdc8afb7	SOGEMCM1TPS	64a571a5-4040-4d48-a332-f8e49ea7a85b	f	PALAIS DE LA SCALA 16 AVENUE DE LA COSTA MONACO, MONACO, 98007
-c43f0d5d-5125-44de-95cf-0963a896a1c5	SOGEMCM1XXX	64a571a5-4040-4d48-a332-f8e49ea7a85b	t	PALAIS DE LA SCALA 16 AVENUE DE LA COSTA MONACO, MONACO, 98007
-85b907dd-06f6-4f79-ad0d-b96397a96405	SOGEPLPWXXX	4f3f0bea-a6bd-4d45-b2ff-c4ae1abd6fa1	t	BUILDING B STUDIO OFFICE COMPLEX LUCKA STREET 9 WARSZAWA, MAZOWIECKIE, 00-842
-94769899-ba49-4a79-8629-400e0ec1da27	SOIUBGS1XXX	4e75e8e7-8899-40eb-9d8f-05d116e3704a	t	NIKOLAY HAYTOV 3A ESTE BUILDING SOFIA, SOFIA, 1113
-ee5009b6-0aa6-4327-8aa0-e5b6ae8e7e94	SOMBBGSFXXX	0104f463-97e6-4400-97ca-f62ae769fe29	t	VRABCHA STREET 6  SOFIA, SOFIA, 1000
-4db2ed1d-93fd-4622-b2c1-ca9e17dadee8	SOOZPLPPXXX	a753a25e-bf21-4739-8670-19e848b3d43d	t	SPOLDZIELCZA 6  LUBLIN, LUBELSKIE, 20-402
-f4ef6f27-e975-4138-b092-6e97d1a684c0	SOPBPLP1XXX	0f916396-443a-4b9c-bed4-9078b0eeccf2	t	UL.PULASKIEGO 8  PABIANICE, LODZKIE, 95-200
-f6d8e005-ccb3-4499-9c29-6bc4cbec44aa	SPBCPLPPXXX	ff05de4a-93ab-4c3d-ab3d-fa77e0fa457b	t	KOLLATAJA 8  - BYDGOSZCZ KUJAWSKO-POMORSKIE, 85-080
-895ad355-49f4-4362-867d-b275eaf8acc3	SPBNPLP1XXX	67d55863-5785-457d-aa32-496ffc4d1670	t	KOSCIUSZKI 2A  BRANSK, PODLASKIE, 17-120
-41a4516d-5f6c-4996-81b8-51f56207e88b	SPBOPLP1XXX	9d8965d5-a5cb-420d-b28d-44dc0445e11d	t	KAMIONKA 27  - BRODNICA KUJAWSKO-POMORSKIE, 87-300
-bd6e2cf7-dc85-4e61-8cfc-c835822ee0a9	SPBOPLPWXXX	9d8965d5-a5cb-420d-b28d-44dc0445e11d	t	KAMIONKA 27  - BRODNICA KUJAWSKO-POMORSKIE, 87-300
-726f695d-d7cf-4601-8edb-12513a8db6a8	SPCEPLP1XXX	8ccb5d98-ab4e-44e9-a382-99d88ae60211	t	UL. DR ZIELINSKIEGO 4  CZERSK, POMORSKIE, 89-650
-cebdb595-f6fc-404a-a3b9-b39ec2563ffe	SPCYPLP1XXX	08e5b887-c433-4a6a-a4c7-ddaed14dd36c	t	CHYNOW 55 A  CHYNOW, POWIAT GROJECKI, 05-650
-0c413564-e5bd-427c-ad1c-eff0c556ad1c	SPDDPLP1XXX	fc4f86f1-7578-4632-a537-5be3e0470ab1	t	KURPIOWSKA 10  KADZIDLO, POWIAT OSTROLECKI, 07-420
-37611a1c-4441-4229-b4a3-4dbb9922fcb0	SPERPLP1XXX	d4cf53f1-383a-4854-a322-9a2494bedaed	t	MOSTOWA 31  PRZEDBORZ, LODZKIE, 97-570
-cffe195a-9039-44db-8bbd-db645c551d1c	SPGBPLP1XXX	59a2a766-91c8-4af8-982f-0c8067c29436	t	DOBRZYKOWSKA 1  GABIN, MAZOWIECKIE, 09-530
-c27652ef-cfb0-4dcb-9f57-ee55f0f45273	SPGIPLP1XXX	0baf3246-744d-4bda-b2bb-4aca807a5a8b	t	UL.PLOCKA 14  GLINOJECK, MAZOWIECKIE, 06-450
-bad3b155-0ebd-43f4-bd92-6f41056f7648	SPHLPLP1XXX	e5ff80b2-e603-4d1c-9526-9216198394a9	t	PILSUDSKIEGO 36  HALINOW, MAZOWIECKIE, 05-074
-d8cf05b8-3b49-4b6e-b3e9-4e85b1867595	SPILPLP1XXX	79146e5f-4149-4175-9f42-5fd5c5159861	t	UL. PLOCKA 4  ILOW, MAZOWIECKIE, 01-231
-74380171-4624-4b4d-ae0b-0e756a86d523	SPJEPLP1XXX	b868006e-5a36-4872-9803-37d4754da7fe	t	WARECKA  JEDLINSK, SLASKIE, 26-660
-99a3864a-cbe7-4281-a3b8-eb177f07aa7c	SPMLPLP1XXX	71e89fd7-5b59-48e8-8552-5d3fb04b0e43	t	MARCA 17, 32  MALBORK, POMORSKIE, 82-200
-28a6badd-5570-4d30-acfd-6647e127e6d8	SPMPPLP1XXX	8a2e6f8a-ef5c-46f3-8dda-a1e0be8aad83	t	UL.WARMINSKA 12  PLOCK, MAZOWIECKIE, 09-408
-8e6dd958-6f8f-49f0-b4e7-7f562de22681	SPNIPLP1XXX	a6b0cd69-635d-4de9-8a29-e6da67d33dc9	t	UL.KOSCIUSZKI 19  NASIELSK, MAZOWIECKIE, 05-190
-6aaf5fb0-b66e-4ed7-ae8b-e2de74bf2c38	SPOPPLP1XXX	5d04a346-b26c-4b54-a4bd-b5d58ee8bf78	t	PL.KOSCIUSZKI 3  OPOCZNO, LODZKIE, 26-300
-986923e0-27e3-4f51-9f1c-c17e1ce39cf4	SPPUPLP1XXX	040cc211-6b26-4ff7-be95-f5322b740283	t	KOSCIUSZKI 1  PULTUSK, MAZOWIECKIE, 06-100
-f2964a39-8c7e-4a75-9a21-a3eb7ebd31d6	SPTOPLP1XXX	e1a9db00-3222-4407-89b5-6d5dac167c6d	t	UL. SOKOLA 12  TARNOBRZEG, TARNOBRZEG, 39-400
-3ab0740f-42fb-44d9-80fb-6106cb01b6f6	SPWCPLP1XXX	4e0933a4-4221-4514-a742-8d73bf5ca474	t	BANKOWA 1  GLOWACZOW, MAZOWIECKIE, 26-903
-7eefecff-24f6-4b23-ac8d-d378c239322b	SPWSPLP1XXX	91a9525b-4ae8-4775-bd71-18dacb7ab994	t	STRAZACKA 1  WISKITKI, MAZOWIECKIE, 96-315
-dde2c594-4f4e-40a9-a0af-ebf9d074edb1	SPYYPLP1XXX	e60ecfbc-38df-4926-8f70-7eda3749aef6	t	PLAC WOLNOSCI 56  - MYSZYNIEC POWIAT OSTROLECKI, 07-430
-e743ee3b-28ed-4589-9973-22995a7fa447	SPZKPLP1XXX	1a623637-8383-402c-836f-42e90090f857	t	UL. DUNAJEWSKIEGO 7  KRAKOW, MALOPOLSKIE, 31-133
-7db28e80-3ad4-4053-a9f7-817924f823b1	SPZOPLP1XXX	5d285e48-2083-4c4b-9fd6-dbc404abde35	t	ALEJA JANA PAWLA II 25  ZWOLEN, MAZOWIECKIE, 26-700
-5c2dbfc9-cdeb-4734-9da2-e0f054788427	SPZUPLP1XXX	af5d3fe6-4689-4df8-9254-6297981543d9	t	PLAC WOLNOSCI 3  ZUROMIN, MAZOWIECKIE, 09-300
-ded5663a-6591-4786-84ef-47ee1ce0addf	SRZKPLPKXXX	1a623637-8383-402c-836f-42e90090f857	t	DUNAJEWSKIEGO 7  KRAKOW, MALOPOLSKIE, 31-133
-bccbcbf6-ca29-42bd-ac0f-d619577c36d1	SSTYPLP1XXX	d1c81528-24b1-424f-8e3f-1c3a4f2d040c	t	WARSZAWSKA 29  STRYKOW, POWIAT ZGIERSKI, 95-010
-128eb077-0759-4d4b-8054-267cdbb32f50	STANALT1ELX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	ELBASAN, ELBASAN, 3001
-10dbfbe9-1427-4193-8f7e-3308910a8f99	STANALT1GJX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	GJIROKASTER, GJIROKASTER, 6001
-5c089f24-182e-4c0b-a8f5-e8fa67cff55f	STANALT1KOX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	KORCE, KORCE, 7000
-a6bbb1da-5c21-40e6-a185-ed62bcf8de9d	STANALT1LUX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	LUSHNJE, FIER, 9001
-b240d403-f57c-4ad8-8716-3d13efbed973	STANALT1SHX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	SHKODER, SHKODER, 4001
-85aa4a35-098c-4c3b-a08e-71784723268e	STANALT1SPX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	f	TIRANA, TIRANA, 1001
-ae7bb0fc-59a3-4369-a805-1a5c7f4a7e80	STANALTAXXX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	t	TIRANA, TIRANA, 1001
-96d34e09-a021-4e13-af17-98bcd1283660	STANALTRXXX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	t	SHESHI SKONDERBEJ 1  TIRANA, TIRANA, 1001
-acb3b594-af75-4c0a-8141-4412026176bf	STANALTSXXX	e7ec208a-64bf-496d-a5aa-a9b650a7eab9	t	SHESHI SKENDERBEJ 1  TIRANA, TIRANA, 1001
-c3e393e9-54f1-4179-9c51-5504d8e5bd2f	STIIPLP1XXX	755aefc0-0d21-4997-b832-40c4f9c9e603	t	BIALA 24  STARA BIALA, MAZOWIECKIE, 09-411
-7eba3970-2a96-4152-9d34-e06e73cae0f0	STSABGSFXXX	8ae57dbe-d6ac-42da-be0d-5563dfe7a33b	t	MOSKOVSKA STREET 19  SOFIA, SOFIA, 1040
-941e61a9-7984-4d44-a354-8152fb05f9bc	STSFBGS1XXX	aa0ba58d-4541-4c7e-89ab-c09fba26f078	t	CHERVENA STENA STR 1 FLOOR 2 OFFICE B-2, LOZENEC DISTRICT SOFIA, SOFIA, 1421
-63f00821-3268-432e-a3ad-cfd28e9cd018	STVVBGS1XXX	3ede7622-44c9-4d48-9a58-8b44b77df067	t	KOZYAK STREET 2 BLOCK 29, ENTRANCE B, OFFICE 4 SOFIA, SOFIA, 1408
-a03c0417-7612-4a19-907d-e3491a0d60af	SURIUYMMXXX	79d96c38-7447-400b-abe6-83163b9c4bde	t	RINCON 530  MONTEVIDEO, MONTEVIDEO, 11000
-28e7ebb8-a6a2-4617-befa-06a4619a99bd	SUSRPLP1XXX	1618da58-728a-4314-92d9-910d140c084d	t	CENTRUM JASNA JASNA 14/16A WARSZAWA, MAZOWIECKIE, 00-041
-6d2f86c4-6d6a-4015-bad0-a1eeba6bb697	SYPLMTM2XXX	049408f6-527d-41bb-98c5-2cdc293c8181	t	68, NORTHFIELDS PENTHOUSE 9 INDEPENDENCE AVENUE MOSTA, MOSTA
-3b258239-de13-463a-8905-dfb1fd5915bc	SYSPMTM1XXX	1e2f192e-8c55-4a7f-8e19-71c1f3d9d6f6	t	54 SIR LUIGI CAMILLERI STREET  SLIEMA, SLIEMA, SLM 1840
-548508c5-996d-484b-ba84-8264d9639917	SZLLPLP1XXX	59f38c61-d2d6-49d8-bdc3-4dd2d0fac019	t	M.KONOPNICKIEJ 12  LECZYCA, LODZKIE, 99-100
-0e1d8b17-6c1e-4b6b-b5bf-de671f57ace9	SZTUPLP1XXX	5758a2c7-d4a7-4df8-8bdc-f7892067f878	t	U.L. CYBERNETYKI 7  WARSZAWA, MAZOWIECKIE, 02-677
-b479711d-e5b3-4ddf-9716-fce1b7d547fd	TASGBGS1XXX	145e411d-34c6-4580-9286-2adf27703ec1	t	TINTYAVA STR 13B IZTOK RESIDENTIAL COMPLEX, ENT A, FLOOR 2 SOFIA, SOFIA, 1113
-5e40e3bd-9d2f-4e93-befd-65cf606de78d	TBDMPLP1XXX	b0b2ea6a-c737-4430-8dbc-e0990e188de8	t	OGRODOWA STREET, CITY GATE 58, A FLOOR 12 WARSZAWA, MAZOWIECKIE, 00-786
-0c041651-2b31-4553-a951-c1711607f1c5	TBIBBGSFXXX	29993a9b-1b3a-49d9-9744-cc8cf8d5b618	t	DIMITAR HADJIKOCEV STR 52-54  SOFIA, SOFIA, 1421
-fc170b77-6384-4cd7-be94-b5091ded3b44	TBIEBGS1XXX	f5600e69-31af-4ec2-a953-e1bf312cf36d	t	TINTYAVA STR 13B IZTOK RESIDENTIAL COMPLEX, ENT A, FLOOR 2 SOFIA, SOFIA, 1113
-f21bc7fe-84d0-4777-b6a8-85c20e6d2184	TCBOCLR1XXX	9b1e41bb-baee-47d4-95ff-5f8bccfeb8a8	t	EL GOLF 40, FLOOR 9 LAS CONDES SANTIAGO, PROVINCIA DE SANTIAGO 8320000
-d2158cff-38d5-4d15-8b2d-1016f842e26e	TCZBBGSFXXX	f3cc45e6-b1c3-4046-98fe-08c5586b1c1e	t	TSAR SAMUIL STR. 87  SOFIA, SOFIA, 1000
-c88afa13-c319-4298-8a4d-94f31bb20456	TEGECLR1XXX	af24ca3b-3fd3-4c0f-a9bb-dfc10980d3f7	t	
-6551a2fe-0218-4b97-9e76-746fa6c33451	TEPJBGSFXXX	db8751ae-302d-4853-8293-98f077b535b2	t	STRANDZHA STR 52  SOFIA, SOFIA, 1303
-249b1f5f-875d-452b-9348-8edddf8632b2	TESMBGS1XXX	975d8961-3e82-41d1-8520-7ed4ee7285b8	t	TODOR ALEXANDROV BLVD 141  SOFIA, SOFIA, 1309
-d9eec406-1699-48e5-ab72-b8aca4c8bf94	TESTBGS1XXX	b2ca9d66-202f-4c0a-8c23-c7478eea200d	t	AKSAKOV 28  SOFIA, SOFIA, 1000
-6d8fe752-6a48-4230-8f15-1317086ff865	TEXIBGSFXXX	25d336c0-b882-4d0b-9e3b-cad66fead189	t	TODOR ALEXANDROV BLVD 117  SOFIA, SOFIA, 1303
-4f304abb-519d-43fb-973d-b255a241836e	TGAFMTM1001	2218646f-1558-4144-8b1d-04d95b3f3274	f	MRIEHEL, BIRKIRKARA
-1871af99-10ea-4550-8c28-04eaf400b5f0	TGAFMTM1002	2218646f-1558-4144-8b1d-04d95b3f3274	f	MRIEHEL, BIRKIRKARA
-9a3cdac4-fdc8-46fe-af94-cee87b33724c	TGAFMTM1003	2218646f-1558-4144-8b1d-04d95b3f3274	f	MRIEHEL, BIRKIRKARA
-cfeee649-fd3d-4911-9318-f2cbdcdc39c1	TGAFMTM1XXX	2218646f-1558-4144-8b1d-04d95b3f3274	t	FLOOR 3 BREWERY STREET BIRKIRKARA, BIRKIRKARA, BKR 3000
-75e5ad53-4514-4f17-8cbb-6a8c7dbbb018	TGBAMTMTXXX	c3077843-f2b9-4fcf-9ca0-66f563d37c82	t	STRAND TOWERS 36 THE STRAND SLIEMA, SLIEMA, SLM 1022
-a35bee21-eae2-4db0-ad7c-40182526b4e5	TGBPMTMTXXX	bafb0438-7771-4a33-87a7-83ce01b98260	t	FLOOR 5, W BUSINESS CENTRE 1 TRIQ DUN KARM BIRKIRKARA, BIRKIRKARA, BKR 9033
-5fa5c5ad-4626-4142-98f0-3b0a0ed601c9	TGENUYM1XXX	e69436d1-8ffa-4203-b1f2-4943fadcd9d6	t	COLONIA 1089  MONTEVIDEO, MONTEVIDEO, 11100
-535a805a-7a47-4a47-bad5-77a9f88cf119	THRIBGS2XXX	84bed634-1497-42b1-80fc-eb25381e43c8	t	OKOLOVRASTEN PAT 251G MM BUSINESS CENTER, FLOOR 4, MLADOST 4 SOFIA, SOFIA, 1715
-ec452156-ab43-4ad8-85f1-d41f129d3051	TIMVMTM2XXX	27e98558-2a96-414d-b4f4-307935e1910f	t	ARAGON HOUSE BUSINESS CENTRE DRAGONARA ROAD - ST. JULIAN'S, ST JULIAN'S, STJ 3140
-e448c598-6567-40b5-b120-d688f5aedd3d	TIRBALTRXXX	28e5ae90-aeb1-4457-99d2-5024cf59531d	t	IBRAHIM RUGOVA STR  TIRANA, TIRANA, 1019
-ce397fa5-e659-4156-bd86-34c11ff33067	TOBAPLPWXXX	b377d2d4-9f7f-4287-92ad-656d1f0ee0f2	t	ORION POSTEPU 18B, FLOOR 3 WARSZAWA, MAZOWIECKIE, 02-676
-02eacad1-85bc-4018-87eb-66b0b31aae96	TOFNPLPPXXX	be904e89-524a-4a9e-bdc3-a103ce93a0c0	t	PZU PARK RONDO IGNACEGO DASZYNSKIEGO 4 WARSZAWA, MAZOWIECKIE, 00-843
-7e8e4a34-e973-4193-b8c4-6643f616eeb9	TPEOPLPWAAS	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-0afe8904-cdef-4d19-a080-b71ff8b6e5b1	TPEOPLPWARW	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-bc06d3f4-c056-4598-9914-73e3eb41ea30	TPEOPLPWASZ	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-0c66cea5-46e7-4bf8-911c-7fe590bba5c9	TPEOPLPWAUS	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-db2720cf-1099-49c9-894d-33502d1d1f70	TPEOPLPWB15	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-cea7b348-f6c5-4f46-9b6a-3d254ff51607	TPEOPLPWBOW	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-6da76c62-448f-41aa-a7d0-d194b90905eb	TPEOPLPWCHI	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-e69aa93e-8dc1-4aa6-b57a-5ce720181f65	TPEOPLPWDA2	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-57e3ba3c-41ca-4ea2-8efa-2167b6767e89	TPEOPLPWDUS	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-bdb17538-1377-4ffe-b6d4-66ae39a3eb65	TPEOPLPWEKO	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-ec4c0b4e-9001-4ff7-883d-addd36498396	TPEOPLPWKOM	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-a1e4970d-f41f-4304-8390-4e328ccd2c04	TPEOPLPWKON	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-7822929a-9ed9-49a2-b911-d788258b288c	TPEOPLPWKOP	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-8cdee8f4-a873-46aa-8f40-02284c1ad8ae	TPEOPLPWMEG	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-305f5228-9edb-46e4-aede-e74c746f6d0d	TPEOPLPWOBP	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-0cf14352-9194-4309-92d6-3fd215f5577b	TPEOPLPWODO	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-ced14edc-8778-4ecc-a132-bd2b0c54b6c8	TPEOPLPWODP	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-f480c54a-f7ed-435b-9643-3d782a212cb0	TPEOPLPWOEP	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-b2c65b31-a81f-4427-89ed-2a71b9eaa386	TPEOPLPWOST	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-97a8cf63-23bd-4a55-abcc-498f0ae5873e	TPEOPLPWP20	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-6a913f9e-ae97-45f2-b105-db3f4089588d	TPEOPLPWP25	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-bb676081-f48a-4c26-b07d-92b79b92495c	TPEOPLPWP30	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-48125065-6bb4-48d6-96c1-f5df52505cbd	TPEOPLPWP35	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-66a994bc-77b4-485a-bdb6-9aa442c33e6d	TPEOPLPWP40	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-4f2717cc-98d2-4b4c-b2fe-d5bb5cb26b88	TPEOPLPWP45	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-5aa18d55-bce3-4adc-8cf4-fa6533014216	TPEOPLPWP50	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-1223170b-0c41-4b4e-8aad-5cde0d824325	TPEOPLPWP55	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-3fabe85b-392a-4d5c-b7b7-8ffd5fe8b93d	TPEOPLPWP60	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-78ec6017-8188-4c80-a3fc-c03de8d86739	TPEOPLPWP65	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-5488b625-507c-4404-bfd5-3385ecf2f69b	TPEOPLPWPAD	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-ffe9b397-374d-4d3b-8cef-91cc4ba809f2	TPEOPLPWPAE	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-b59a849d-cb11-4202-906e-07d7f8a80fbe	TPEOPLPWPDA	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-aeb59ef9-1393-47e8-b3cf-e5ead9d0be3f	TPEOPLPWPDS	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-e951d95f-e9c6-42ac-8a6d-9bdb44f60725	TPEOPLPWPFI	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-09a1e466-0166-4aad-bf03-500c02a10488	TPEOPLPWPOD	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-9f3c222b-cd7d-492e-8569-261c4f58ab21	TPEOPLPWPOS	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-2ef13763-fd39-4571-af33-593c533b1647	TPEOPLPWSEN	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-f31218e9-8b74-48ee-a70b-accd9a9fd3bf	TPEOPLPWSGD	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-dbd7ca13-59b4-4907-a1fd-0adedd4f2d29	TPEOPLPWSGF	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-f537d624-65b4-4536-ae7e-76eb4a4cae31	TPEOPLPWSGK	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-185b3ffe-3326-4140-9a61-dbe343977bc2	TPEOPLPWSIN	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-faa59da8-d8d2-42cd-b188-baf469f92d00	TPEOPLPWSRR	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-09f2868a-129b-41c1-b2e3-2b5f90b8669c	TPEOPLPWSTW	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-bbfe628e-84d9-48a3-bf36-59ebbab50dd7	TPEOPLPWXXX	3851be17-47da-4824-bdbb-874eff335024	t	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-ed204c04-1091-4a73-a389-f6e3aa1568f7	TPEOPLPWZRA	3851be17-47da-4824-bdbb-874eff335024	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-3370604a-7c09-4c84-b592-4a1f6d630588	TPEOPLPWZRO	6d3302b0-beae-4d97-8ad3-034bb25e2dd5	f	FOREST ZUBRA 1, FLOOR 1 WARSZAWA, MAZOWIECKIE, 01-066
-b8ebbb33-a2fc-4cfc-a104-1a9d3e8820ba	TPFSUYM1XXX	0dbd6284-883e-4a61-9297-3b8c55dc789d	t	RUTA 8 17500 EDIFICIO 1, OFICINA 007, ZONAMERICA MONTEVIDEO, MONTEVIDEO, 91600
-0289e1a2-1b9c-45b2-802c-c1d40afc7c7f	TPMLMTMTXXX	1507db49-81fa-4501-985b-bb1d9dfa8900	t	FLOOR 1, SOHO STRAND FAWWARA BUILDING TRIQ I-IMSIDA GZIRA, GZIRA, GZR 1401
-0703d30e-ef61-4618-9ca1-5ff84123a2fb	TPROLV22XXX	0b3b6348-68ef-4634-9f07-6390fd1bc85a	t	KR. VALDEMARA 62  RIGA, RIGA, LV-1013
-92ee22a2-2d43-4081-b574-5f3db4be9cbd	TRELLV22XXX	8b9f9b20-046c-46f6-8c64-421dd888b346	t	SMILSU STREET 1  RIGA, RIGA, LV-1919
-b467951e-6f7e-4169-b4c9-4bdf707e5ae4	TRELLV22ZIB	8b9f9b20-046c-46f6-8c64-421dd888b346	f	SMILSU STREET 1  RIGA, RIGA, LV-1919
-e46fed79-52f4-40de-b630-abd0701bba2c	TRIVBGS1XXX	7d35bf7d-19f4-4ac7-8014-a3d2b9346720	t	CHERNI VRAH BLVD 43  SOFIA, SOFIA, 1407
-be7497e0-00dd-4c9f-9b28-6d2dbf951ec8	TROBCLR1XXX	0b5bbf4a-c131-486a-8f09-e1b1f811a4e4	t	AV APOQUINDO 3721, FLOOR 16 TORRE LAS CONDES - SANTIAGO, PROVINCIA DE SANTIAGO, 8320000
-d6f47121-a2e4-4123-91f9-b797b72cea90	TROMPLP1XXX	757f1a30-2a64-43ae-97bf-9d934f7851ed	t	MOGILSKA 65  KRAKOW, MALOPOLSKIE, 31-545
-462fbacd-4e0f-40de-ac22-9ccec4990a74	TRPEMTM1XXX	de40bbaa-6d44-40e9-936e-e331bff977b1	t	FLOOR 1, MWH BUILDING ORATORY STREET NAXXAR, NAXXAR, NXR 2504
-18c929c4-98f8-4d76-98a3-30cf30503ff1	TRPEMTMTXXX	de40bbaa-6d44-40e9-936e-e331bff977b1	t	FLOOR 3, THE WATERCOURSE  BIRKIRKARA, BIRKIRKARA, CBD 2010
-2dee99dd-bbd9-4c5e-99fd-6bfab7070091	TRTEMTM1XXX	6310fe9f-3729-45a3-81e3-67e3732d1e4e	t	VALLETTA BUILDINGS SOUTH STREET VALLETTA, VALLETTA, VLT 1103
-7e043bd0-a29b-42a1-b42f-8680cbc34a13	TRUDBG21XXX	e3c852cf-68ad-49ee-8770-90b50609a9cb	t	KARNIGRADSKA 19  SOFIA, SOFIA, 1000
-fec1cc32-0bc7-49cd-8087-1ba640bf74b6	TRUMMTM2XXX	f260f1c1-6af7-455f-aa0c-0a5634c81b8e	t	FLOOR 3, QUAD CENTRAL Q3 TRIQ L-ESPLORATURI, ZONE 1, CENTRAL BIRKIRKARA, BIRKIRKARA, CBD 1040
-5f54d71d-d2b1-473e-8f70-6ec481cd7e87	UAFAUYM1XXX	558c904a-1688-4c22-ac1d-872bef4faa2a	t	SAN JOSE 1104  MONTEVIDEO, MONTEVIDEO, 11100
-d008a94e-3aef-445f-bbd4-dfca58fba878	UBATBGS1XXX	b056b991-02e9-4ff4-9a71-0c43d6611dba	t	SVETA SOFIA STR 5  SOFIA, SOFIA, 1000
-2c33c972-3720-45d0-9f35-861ef08dee35	UBBSBGSFXXX	80e9c166-c674-46b4-813a-cdceac1597e9	t	VITOSHA BLVD 89B MILLENNIUM CENTER SOFIA, SOFIA, 1463
-41f2c0c8-93f9-4f74-893f-cf48928e1ce3	UBPGMCMXT2S	ef830bc7-ae9d-4115-a8a1-bbca4f5605f7	f	LA BELLE EPOQUE 17 AVENUE D'OSTENDE MONACO, MONACO, 98000
-72e59203-ae30-4dc9-ab54-a93b220ae712	UBPGMCMXXXX	ef830bc7-ae9d-4115-a8a1-bbca4f5605f7	t	LA BELLE EPOQUE 17 AVENUE D'OSTENDE MONACO, MONACO, 98000
-51985320-7b57-4a01-8891-1c2b328b4138	UBSWMCMXXXX	5713e1f3-6fd4-4017-b8ab-99dd225b75dc	t	BRETAGNE 2 AVENUE DE GRANDE MONACO, MONACO, 98007
-e349fa98-0a1f-4b30-9a08-9bd700fd81ec	UGMJBG21XXX	60c3a704-f1b8-4e40-ae11-c5f179e76685	t	VASIL APRILOV STR 92  PLOVDIV, PLOVDIV, 4000
-5c6a8e0e-5a32-4888-92ab-60e81a4a94e5	UNALALTRXXX	50fe1e45-f399-4b77-80e3-8c18ddac7afb	t	FLOOR 13 BULVARDI ZOGU I, KATESH PERBALLE STACIONIT TE TRENIT TIRANA, TIRANA, 1016
-5787faee-2f39-487e-acf4-6caa134fef9b	UNCRBGSF031	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	f	MARIA LOUISA BLVD 2  SOFIA, SOFIA, 1558
-c8559a60-c855-43ac-91ba-bcf13946143e	UNCRBGSF081	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	f	
-1226a7bd-e8f9-43d6-be36-1660bb9516b1	UNCRBGSF426	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	f	MAKEDONIJA STREET 41  SOFIA, SOFIA, 1000
-2c922269-7d2e-49db-a48a-f1b19ce8b542	UNCRBGSF720	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	f	VAZRAZHDANE BOULEVRD 37  PLOVDIV, PLOVDIV, 4018
-f790b61f-328e-4a47-90ba-6d7afb5060f5	UNCRBGSF733	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	f	DR. PUSKIULEV STREET 1  VARNA, VARNA, 9000
-f9483a03-cde0-48ea-9ed1-2050153a868d	UNCRBGSFXXX	3bd72790-be4f-4ab4-beb5-b239a48f4b1a	t	SVETA NEDELYA SQUARE 7  SOFIA, SOFIA, 1000
-3266955a-c939-4efc-82cc-c2eaa8d8e8e9	UNLALV2XTPS	afc01ce2-5be8-4e41-a5af-87e54d4c4ce2	f	SEB FINANCE CENTER MEISTARU 1, VALDLAUCI, KEKAVAS PAG RIGA, RIGA, LV-1076
-63360a71-ab2a-4662-ab02-96c5cff0469f	UNLALV2XXXX	afc01ce2-5be8-4e41-a5af-87e54d4c4ce2	t	SEB FINANCE CENTER MEISTARU 1, VALDLAUCI, KEKAVAS PAG RIGA, RIGA, LV-1076
-d731f63f-39bb-42ce-94ad-15980b1522f4	UNOGMTM1XXX	6c52a7cf-eb14-415e-97a7-52a7d46b2651	t	FLAT 12, REGENT HOUSE BISAZZA STREET SLIEMA, SLIEMA, SLM 1640
-75f00496-b098-4a27-bc69-c2640032e45a	UPSAPLP1XXX	d39c9d8d-9b84-4186-b89c-54a5c60fe0b3	t	WARSAW FINANCIAL CENTER EMILII PLATER 53 WARSZAWA, MAZOWIECKIE, 00-113
-33237e07-a414-4b8a-a07c-fe183192cd87	USALALTRXXX	73494a3d-6c19-4de7-9d25-ead9e3ddbd00	t	RRUGA ISMAIL QEMALI 27  TIRANA, TIRANA, 1001
-e49450b2-fe66-42b3-94de-98f1ce4f290a	USINPLP1XXX	e1b93249-09a8-4c12-a4b5-4aa0dd8a503a	t	UL. CHORZOWSKA 50  KATOWICE, SLASKIE, 40-121
-68735a97-944c-4a23-8ecb-9b6cb2a9f3f5	VAFEBG21XXX	bf62bd27-419d-4033-be72-a8828d0d112d	t	VLADISLAV VARNENCHIK BLVD 186 FLOOR 3, OFFICE 4.035 VARNA, VARNA, 9000
-cf4b7258-d1ee-41bc-9455-c652d53514b8	VAFMMTM1XXX	a76d0da9-cf0d-4c78-be42-dadd4e8217b9	t	FLOOR 6, THE MALL  FLORIANA, IL-FURJANA, FRN 1470
-5f8e6d25-caaa-43a1-9dad-028c584cff9e	VAFRMTM1XXX	478039f7-8896-4e1a-8742-b403092f2100	t	FLOOR 3 TRIQ IL-BIRRERIJA MRIEHEL, BIRKIRKARA , BKR 3000
-f8dd64ea-f173-4ed9-85b5-8988c0ea951d	VALLMTMTXXX	06c08718-c9c2-491e-8e6c-6fc2a1882bbd	t	BOV CENTRE TRIQ IL-KANUN,, ZONE 4 CENTRAL BUSINESS DISTRICT - SANTA VENERA, SANTA VENERA, CBD 4060
-e9d94725-d803-451a-bd0a-e427669d4df9	VARLUYM1XXX	67de7e80-d921-4d3e-85b8-ca05c5831f2b	t	MISIONES 1428  MONTEVIDEO, MONTEVIDEO, 11000
-19a2b142-18f5-4225-a937-17b5ec98ff4e	VENUMCMCXXX	53f72136-c221-41d6-a1fb-d19ba977bcb0	t	VILLA BIJOU 19 AVENUE DE LA COSTA MONACO, MONACO, 98000
-d477975f-aedc-400f-be43-ca74ec0ef624	VGAGBGSFXXX	5b32cafa-1782-4573-8d41-a4bc14963c69	t	CHRISTOPHER COLUMBUS BLVD 43 FLOOR 9 SOFIA, SOFIA, 1521
-39c2cbcf-5fce-4639-a29b-30e487c01347	VMCEBG21XXX	206af6a0-bce5-4a82-805f-8d0d33c39cc5	t	VLADISLAV VARNENCHIK BLVD 186 FLOOR 3, OFFICE 4.035 VARNA, VARNA, 9009
-e60b8e30-51c7-4ff9-ac6c-dad640376ba4	VOCBMTMTXXX	5aa34a1b-a659-4e57-92e0-9f2ce92d389a	t	FLOOR 2, THE EMPORIUM C DE BROCKTORFF STREET MSIDA, MSIDA, MSD 1421
-f71f60ae-4fb8-4fc3-9d4e-b98c606998b6	VOWAPLP1XXX	e638fe94-5565-4c9e-9e32-6a6232ea6694	t	RONDO ONZ 1  WARSZAWA, MAZOWIECKIE, 00-124
-c7e20d72-44ac-4639-b03b-fb2f718a9246	VOWAPLP9XXX	e638fe94-5565-4c9e-9e32-6a6232ea6694	t	RONDO ONZ 1  WARSZAWA, MAZOWIECKIE, 00-124
-1a2ed5a8-3822-4a0b-bd1d-626c684ae272	VPAYBGS2XXX	e9bbb815-6e45-41d7-b778-382043296b4c	t	SVETA NEDELYA SQ. 16 FLOOR 2, OFFICE 3 SOFIA, SOFIA, 1000
-d871ffcf-a742-4167-9a86-948047b60d36	VPAYMTM2XXX	2e467d64-6f26-4343-bf27-42191a28c98d	t	FLOOR 5, OHEA BUILDINGS 6 SIR WILLIAM REID STREET GZIRA, GZIRA, GZR 1362
-08bc9101-8bdc-4001-955c-0867c233f73e	VPAYPLP2XXX	e28ca672-c7db-4e7a-b75f-c52f2155cbcf	t	VILLA METRO BUSINESS HOUSE 145 WARSZAWA, MAZOWIECKIE, 02-715
-49ae21a2-7f24-4b9f-b4bc-2f523d6bfa55	WARTPLPWXXX	3d1a7079-a006-4554-8513-1a07b9053c73	t	RONDO I. DASZYNSKIEGO 1  WARSZAWA, MAZOWIECKIE, 00-843
-db38acf8-8b67-46f8-baf2-b4b0cec9b8da	WASWPLP1XXX	29412c62-e5da-4f59-9d40-94c394a5729f	t	ZASTAWSKA 8  WASEWO, MAZOWIECKIE, 07-311
-336b90fe-2fc1-4e6d-bd29-56cf2cdaea01	WBKPPLP1CCP	aac67f61-13dc-46ac-bd33-80039f4ab6e2	f	WROCLAW, DOLNOSLASKIE
-56a4cdbe-073a-4367-b1f3-7e31906330b6	WBKPPLPPCBM	aac67f61-13dc-46ac-bd33-80039f4ab6e2	f	PLAC WOLNOSCI 15  POZNAN, WIELKOPOLSKIE, 60-967
-094c53b0-1b66-44fa-9f7c-c910fbab2e13	WBKPPLPPXXX	aac67f61-13dc-46ac-bd33-80039f4ab6e2	t	AL. JANA PAWLA II 17  WARSZAWA, MAZOWIECKIE, 00-854
-0291826e-59a4-4daa-b223-50798844e0dd	WCFSPLP1XXX	7df476f6-4a7d-4261-b461-78e93d07f08b	t	BUDYNEK SKYLIGHT ZLOTE TARASY UL. ZLOTA 59 WARSZAWA, MAZOWIECKIE, 00-120
-e9258c0c-f7f0-4678-a9ea-c1bcec4e4eb8	WCORMTMQXXX	cf96f88f-c61c-4cc0-82ca-0b345e3b8211	t	FJVA BUSINESS CENTRE B2 INDUSTRY STREET, ZONE 5, CENTRAL BUSINESS QORMI, QORMI, CBD 5030
-e39737e3-ae45-4d8c-8736-c452825584b0	WOSILV21XXX	960fcf88-ad01-4f44-b211-50bba44deec6	t	MASKAVAS STR. 240-3  RIGA, RIGA, LV-1063
-042c64b5-7c91-46b1-b9a1-b4427683a4d8	WSPDPLPPXXX	03fed218-ba79-4599-9872-828c0351489c	t	UL. GIZEWIUSZA 2A  PISZ, WARMINSKO-MAZURSKIE, 12-200
-77ab88bf-0653-4971-90d1-96142fb37f9c	XBULBGS1XXX	cfc4536a-55f0-433f-85fa-5c2b93fd90c0	t	TRI USHI STREET 10 FLOOR 12 SOFIA, SOFIA, 1000
-44957c12-13e3-4d45-bc91-955f06f198e1	XMALMTMTXXX	c796dd3f-a302-4515-9126-deee8997bd38	t	GARRISON CHAPEL CASTILE PLACE VALLETTA, VALLETTA, VLT 1063
-d9eb7be7-d4e9-4228-82c5-8a856cbb73db	XMNTUYM1XXX	266648d7-063d-44b2-a71b-691b91a2d981	t	MISIONES 1400  MONTEVIDEO, MONTEVIDEO, 11000
-5f4ed8d8-5843-43b3-93d0-7bddaa89d0ac	XPATBGSFXXX	0d63e2a6-7d97-4c35-afc2-4764217ed772	t	GEORGI RAKOVSKI STR 96A  SOFIA, SOFIA, 1000
-1e18b7c5-3fb7-4308-b55f-82d95a092a47	XRISLV21XXX	b8598090-973f-4561-8fe7-d4a0b13b047a	t	RIGA STOCK EXCHANGE DOMA LAUKUMS 6 RIGA, RIGA, LV-1885
-\.
-
-
---
--- TOC entry 4722 (class 2606 OID 25142)
--- Name: banks banks_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.banks
-    ADD CONSTRAINT banks_pkey PRIMARY KEY (id);
-
-
---
--- TOC entry 4728 (class 2606 OID 25144)
--- Name: countries countries_iso2_code_key; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.countries
-    ADD CONSTRAINT countries_iso2_code_key UNIQUE (iso2_code);
-
-
---
--- TOC entry 4730 (class 2606 OID 25146)
--- Name: countries countries_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.countries
-    ADD CONSTRAINT countries_pkey PRIMARY KEY (id);
-
-
---
--- TOC entry 4735 (class 2606 OID 25148)
--- Name: swift_codes swift_codes_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.swift_codes
-    ADD CONSTRAINT swift_codes_pkey PRIMARY KEY (id);
-
-
---
--- TOC entry 4737 (class 2606 OID 25150)
--- Name: swift_codes swift_codes_swift_code_key; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.swift_codes
-    ADD CONSTRAINT swift_codes_swift_code_key UNIQUE (swift_code);
-
-
---
--- TOC entry 4726 (class 2606 OID 25152)
--- Name: banks unique_bank; Type: CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.banks
-    ADD CONSTRAINT unique_bank UNIQUE (name, country_id);
-
-
---
--- TOC entry 4723 (class 1259 OID 25153)
--- Name: idx_banks_country_id; Type: INDEX; Schema: public; Owner: postgres
---
-
-CREATE INDEX idx_banks_country_id ON public.banks USING btree (country_id);
-
-
---
--- TOC entry 4724 (class 1259 OID 25154)
--- Name: idx_banks_name; Type: INDEX; Schema: public; Owner: postgres
---
-
-CREATE INDEX idx_banks_name ON public.banks USING btree (name);
-
-
---
--- TOC entry 4731 (class 1259 OID 25155)
--- Name: idx_countries_iso2; Type: INDEX; Schema: public; Owner: postgres
---
-
-CREATE INDEX idx_countries_iso2 ON public.countries USING btree (iso2_code);
-
-
---
--- TOC entry 4732 (class 1259 OID 25156)
--- Name: idx_swift_codes_bank_id; Type: INDEX; Schema: public; Owner: postgres
---
-
-CREATE INDEX idx_swift_codes_bank_id ON public.swift_codes USING btree (bank_id);
-
-
---
--- TOC entry 4733 (class 1259 OID 25157)
--- Name: idx_swift_codes_swift_code; Type: INDEX; Schema: public; Owner: postgres
---
-
-CREATE INDEX idx_swift_codes_swift_code ON public.swift_codes USING btree (swift_code);
-
-
---
--- TOC entry 4738 (class 2606 OID 25158)
--- Name: banks banks_country_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.banks
-    ADD CONSTRAINT banks_country_id_fkey FOREIGN KEY (country_id) REFERENCES public.countries(id) ON DELETE CASCADE;
-
-
---
--- TOC entry 4739 (class 2606 OID 25163)
--- Name: swift_codes swift_codes_bank_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
---
-
-ALTER TABLE ONLY public.swift_codes
-    ADD CONSTRAINT swift_codes_bank_id_fkey FOREIGN KEY (bank_id) REFERENCES public.banks(id) ON DELETE CASCADE;
-
-
---
--- TOC entry 4894 (class 0 OID 0)
--- Dependencies: 10
--- Name: SCHEMA public; Type: ACL; Schema: -; Owner: postgres
---
-
-REVOKE USAGE ON SCHEMA public FROM PUBLIC;
-GRANT ALL ON SCHEMA public TO PUBLIC;
-
-
--- Completed on 2025-02-06 20:48:44
-
---
--- PostgreSQL database dump complete
---
-
@@ -0,0 +1,244 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// migrationFiles holds the schema migrations named {version}_{name}.up.sql and {version}_{name}.down.sql.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+var migrationFileRegex = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// migrationLockID is the key of the advisory lock held while migrating,
+// so that servers starting at the same time do not apply a migration twice.
+const migrationLockID = 7285031
+
+// Migration is a single versioned schema change.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+	Step    func(ctx context.Context, tx *sql.Tx) error // run after Up in its transaction, nil for most migrations
+}
+
+// MigrationStatus is a migration with the time it was applied, nil when it is pending.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrations returns the embedded migrations ordered by version.
+func Migrations() ([]Migration, error) {
+	names, err := fs.Glob(migrationFiles, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, path := range names {
+		name := path[len("migrations/"):]
+		match := migrationFileRegex.FindStringSubmatch(name)
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", name)
+		}
+		version, _ := strconv.Atoi(match[1])
+
+		content, err := migrationFiles.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migration.Step = migrationSteps[migration.Version]
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrateUp applies all pending migrations in order, each in its own transaction,
+// and returns the migrations applied.
+func MigrateUp(database *sql.DB) ([]Migration, error) {
+	var applied []Migration
+	err := withMigrationLock(database, func(conn *sql.Conn, done map[int]time.Time) error {
+		migrations, err := Migrations()
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			err := inTx(conn, migration.Up, migration.Step, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("migration %d_%s failed: %v", migration.Version, migration.Name, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// MigrateDown reverts the latest steps applied migrations in reverse order
+// and returns the migrations reverted.
+func MigrateDown(database *sql.DB, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := withMigrationLock(database, func(conn *sql.Conn, done map[int]time.Time) error {
+		migrations, err := Migrations()
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			err := inTx(conn, migration.Down, nil, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+			if err != nil {
+				return fmt.Errorf("reverting migration %d_%s failed: %v", migration.Version, migration.Name, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// MigrationStatuses returns every embedded migration with the time it was applied.
+func MigrationStatuses(database *sql.DB) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := withMigrationLock(database, func(conn *sql.Conn, done map[int]time.Time) error {
+		migrations, err := Migrations()
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			status := MigrationStatus{Migration: migration}
+			if appliedAt, ok := done[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withMigrationLock runs fn on a single connection holding the migration lock, passing the
+// applied migrations. The schema_migrations table is created on first use; a database created
+// from the former pg_dump init script is recorded as having the initial schema applied.
+func withMigrationLock(database *sql.DB, fn func(conn *sql.Conn, done map[int]time.Time) error) error {
+	ctx := context.Background()
+	conn, err := database.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %v", err)
+	}
+	defer conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, migrationLockID)
+
+	var exists, legacy bool
+	err = conn.QueryRowContext(ctx, `
+		SELECT to_regclass('public.schema_migrations') IS NOT NULL, to_regclass('public.swift_codes') IS NOT NULL
+	`).Scan(&exists, &legacy)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		_, err := conn.ExecContext(ctx, `
+			CREATE TABLE schema_migrations (
+				version bigint PRIMARY KEY,
+				name character varying(255) NOT NULL,
+				applied_at timestamp with time zone DEFAULT now() NOT NULL
+			)
+		`)
+		if err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %v", err)
+		}
+		if legacy {
+			if _, err := conn.ExecContext(ctx, `INSERT INTO schema_migrations (version, name) VALUES (1, 'initial_schema')`); err != nil {
+				return err
+			}
+		}
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	done := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return err
+		}
+		done[version] = appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	rows.Close()
+
+	return fn(conn, done)
+}
+
+// inTx runs a migration script, its Go step when not nil and the statement recording it in a single transaction.
+func inTx(conn *sql.Conn, script string, step func(ctx context.Context, tx *sql.Tx) error, record string, args ...any) error {
+	ctx := context.Background()
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return err
+	}
+	if step != nil {
+		if err := step(ctx, tx); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
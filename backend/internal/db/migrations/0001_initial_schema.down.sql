@@ -0,0 +1,4 @@
+DROP FUNCTION IF EXISTS delete_swift_code(character varying);
+DROP TABLE IF EXISTS swift_codes;
+DROP TABLE IF EXISTS banks;
+DROP TABLE IF EXISTS countries;
@@ -0,0 +1,66 @@
+-- Schema of the original pg_dump: countries, banks and SWIFT codes.
+
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE countries (
+    id uuid DEFAULT uuid_generate_v4() NOT NULL,
+    iso2_code character varying(2) NOT NULL,
+    name character varying(100) NOT NULL,
+    CONSTRAINT countries_pkey PRIMARY KEY (id),
+    CONSTRAINT countries_iso2_code_key UNIQUE (iso2_code)
+);
+
+CREATE TABLE banks (
+    id uuid DEFAULT uuid_generate_v4() NOT NULL,
+    name character varying(255) NOT NULL,
+    country_id uuid NOT NULL,
+    CONSTRAINT banks_pkey PRIMARY KEY (id),
+    CONSTRAINT unique_bank UNIQUE (name, country_id),
+    CONSTRAINT banks_country_id_fkey FOREIGN KEY (country_id) REFERENCES countries(id) ON DELETE CASCADE
+);
+
+CREATE TABLE swift_codes (
+    id uuid DEFAULT uuid_generate_v4() NOT NULL,
+    swift_code character varying(11) NOT NULL,
+    bank_id uuid NOT NULL,
+    is_headquarter boolean NOT NULL,
+    address character varying(255) NOT NULL,
+    CONSTRAINT swift_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT swift_codes_swift_code_key UNIQUE (swift_code),
+    CONSTRAINT swift_codes_bank_id_fkey FOREIGN KEY (bank_id) REFERENCES banks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_countries_iso2 ON countries USING btree (iso2_code);
+CREATE INDEX idx_banks_country_id ON banks USING btree (country_id);
+CREATE INDEX idx_banks_name ON banks USING btree (name);
+CREATE INDEX idx_swift_codes_bank_id ON swift_codes USING btree (bank_id);
+CREATE INDEX idx_swift_codes_swift_code ON swift_codes USING btree (swift_code);
+
+-- delete_swift_code removes a SWIFT code together with its bank and country once they have no SWIFT codes left.
+CREATE FUNCTION delete_swift_code(swift_code_input character varying) RETURNS boolean
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    bank_id_var UUID;
+    country_id_var UUID;
+BEGIN
+    DELETE FROM swift_codes
+    WHERE swift_code = swift_code_input
+    RETURNING bank_id INTO bank_id_var;
+
+    IF bank_id_var IS NULL THEN
+        RETURN FALSE;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM swift_codes WHERE bank_id = bank_id_var) THEN
+        SELECT country_id INTO country_id_var FROM banks WHERE id = bank_id_var;
+        DELETE FROM banks WHERE id = bank_id_var;
+
+        IF NOT EXISTS (SELECT 1 FROM banks WHERE country_id = country_id_var) THEN
+            DELETE FROM countries WHERE id = country_id_var;
+        END IF;
+    END IF;
+
+    RETURN TRUE;
+END;
+$$;
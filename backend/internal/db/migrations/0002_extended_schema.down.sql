@@ -0,0 +1,17 @@
+DROP TABLE IF EXISTS national_bank_codes;
+DROP TABLE IF EXISTS clearing_codes;
+
+DROP INDEX IF EXISTS idx_swift_codes_town_name;
+DROP INDEX IF EXISTS idx_swift_codes_address_trgm;
+DROP INDEX IF EXISTS idx_banks_name_trgm;
+
+ALTER TABLE swift_codes
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS street,
+    DROP COLUMN IF EXISTS postal_code,
+    DROP COLUMN IF EXISTS town_name,
+    DROP COLUMN IF EXISTS address_ascii;
+
+ALTER TABLE banks DROP CONSTRAINT IF EXISTS unique_bank;
+ALTER TABLE banks ADD CONSTRAINT unique_bank UNIQUE (name, country_id);
+ALTER TABLE banks DROP COLUMN IF EXISTS name_ascii, DROP COLUMN IF EXISTS name_key;
@@ -0,0 +1,42 @@
+-- Columns and tables added to the original schema: search keys and ASCII forms of bank names and addresses,
+-- structured addresses, clearing codes and national bank codes. Every statement tolerates a database created
+-- by an init script that already had some of them. The search keys and ASCII forms of existing rows are filled
+-- in afterwards by a Go step with the transliteration rules of the API, which also restores unique_bank.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE banks ADD COLUMN IF NOT EXISTS name_key character varying(255);
+ALTER TABLE banks ADD COLUMN IF NOT EXISTS name_ascii character varying(255);
+ALTER TABLE banks DROP CONSTRAINT IF EXISTS unique_bank;
+
+ALTER TABLE swift_codes ADD COLUMN IF NOT EXISTS address_ascii character varying(255) DEFAULT '' NOT NULL;
+ALTER TABLE swift_codes ADD COLUMN IF NOT EXISTS town_name character varying(100) DEFAULT '' NOT NULL;
+ALTER TABLE swift_codes ADD COLUMN IF NOT EXISTS postal_code character varying(20) DEFAULT '' NOT NULL;
+ALTER TABLE swift_codes ADD COLUMN IF NOT EXISTS street character varying(255) DEFAULT '' NOT NULL;
+ALTER TABLE swift_codes ADD COLUMN IF NOT EXISTS time_zone character varying(64) DEFAULT '' NOT NULL;
+
+CREATE TABLE IF NOT EXISTS clearing_codes (
+    id uuid DEFAULT uuid_generate_v4() NOT NULL,
+    swift_code_id uuid NOT NULL,
+    scheme character varying(5) NOT NULL,
+    code character varying(35) NOT NULL,
+    CONSTRAINT clearing_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT unique_clearing_code UNIQUE (scheme, code),
+    CONSTRAINT clearing_codes_swift_code_id_fkey FOREIGN KEY (swift_code_id) REFERENCES swift_codes(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS national_bank_codes (
+    id uuid DEFAULT uuid_generate_v4() NOT NULL,
+    country_iso2 character varying(2) NOT NULL,
+    bank_code character varying(20) NOT NULL,
+    swift_code character varying(11) NOT NULL,
+    CONSTRAINT national_bank_codes_pkey PRIMARY KEY (id),
+    CONSTRAINT unique_national_bank_code UNIQUE (country_iso2, bank_code),
+    CONSTRAINT national_bank_codes_swift_code_fkey FOREIGN KEY (swift_code) REFERENCES swift_codes(swift_code) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_banks_name_trgm ON banks USING gin (name_key gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_swift_codes_address_trgm ON swift_codes USING gin (address_ascii gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_swift_codes_town_name ON swift_codes USING btree (upper((town_name)::text));
+CREATE INDEX IF NOT EXISTS idx_clearing_codes_swift_code_id ON clearing_codes USING btree (swift_code_id);
+CREATE INDEX IF NOT EXISTS idx_national_bank_codes_swift_code ON national_bank_codes USING btree (swift_code);
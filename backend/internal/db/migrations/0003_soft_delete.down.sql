@@ -0,0 +1,37 @@
+-- Deleted SWIFT codes are removed for good before returning to hard deletes.
+
+SELECT purge_swift_codes('infinity');
+
+DROP FUNCTION IF EXISTS purge_swift_codes(timestamp with time zone);
+
+CREATE OR REPLACE FUNCTION delete_swift_code(swift_code_input character varying) RETURNS boolean
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    bank_id_var UUID;
+    country_id_var UUID;
+BEGIN
+    DELETE FROM swift_codes
+    WHERE swift_code = swift_code_input
+    RETURNING bank_id INTO bank_id_var;
+
+    IF bank_id_var IS NULL THEN
+        RETURN FALSE;
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM swift_codes WHERE bank_id = bank_id_var) THEN
+        SELECT country_id INTO country_id_var FROM banks WHERE id = bank_id_var;
+        DELETE FROM banks WHERE id = bank_id_var;
+
+        IF NOT EXISTS (SELECT 1 FROM banks WHERE country_id = country_id_var) THEN
+            DELETE FROM countries WHERE id = country_id_var;
+        END IF;
+    END IF;
+
+    RETURN TRUE;
+END;
+$$;
+
+DROP INDEX IF EXISTS idx_swift_codes_deleted_at;
+
+ALTER TABLE swift_codes DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,42 @@
+-- Soft delete of SWIFT codes: deleted codes keep their row with the time of deletion until they are purged,
+-- and their banks and countries are kept as well.
+
+ALTER TABLE swift_codes ADD COLUMN deleted_at timestamp with time zone;
+
+CREATE INDEX idx_swift_codes_deleted_at ON swift_codes USING btree (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- delete_swift_code marks a SWIFT code as deleted.
+CREATE OR REPLACE FUNCTION delete_swift_code(swift_code_input character varying) RETURNS boolean
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    UPDATE swift_codes
+    SET deleted_at = now()
+    WHERE swift_code = swift_code_input AND deleted_at IS NULL;
+
+    RETURN FOUND;
+END;
+$$;
+
+-- purge_swift_codes removes the SWIFT codes deleted before the given time, then the banks and countries
+-- left without SWIFT codes, and returns the number of SWIFT codes removed.
+CREATE FUNCTION purge_swift_codes(deleted_before timestamp with time zone) RETURNS integer
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    purged integer;
+BEGIN
+    DELETE FROM swift_codes
+    WHERE deleted_at < deleted_before;
+
+    GET DIAGNOSTICS purged = ROW_COUNT;
+
+    DELETE FROM banks b
+    WHERE NOT EXISTS (SELECT 1 FROM swift_codes sc WHERE sc.bank_id = b.id);
+
+    DELETE FROM countries c
+    WHERE NOT EXISTS (SELECT 1 FROM banks b WHERE b.country_id = c.id);
+
+    RETURN purged;
+END;
+$$;
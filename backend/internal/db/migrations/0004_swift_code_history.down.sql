@@ -0,0 +1,4 @@
+DROP TRIGGER swift_codes_history ON swift_codes;
+DROP FUNCTION record_swift_code_change();
+DROP FUNCTION swift_code_snapshot(swift_codes);
+DROP TABLE swift_code_history;
@@ -0,0 +1,97 @@
+-- Change history of SWIFT codes: every create, update, delete, restore and purge is recorded by a trigger
+-- with the values before and after the change. before is NULL when the SWIFT code did not exist or was
+-- deleted, after is NULL when it no longer exists or is deleted. The actor is read from the swift.actor
+-- setting of the transaction and defaults to the database user.
+
+CREATE TABLE swift_code_history (
+    id bigserial NOT NULL,
+    swift_code character varying(11) NOT NULL,
+    operation character varying(10) NOT NULL,
+    before jsonb,
+    after jsonb,
+    actor character varying(255) NOT NULL,
+    changed_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT swift_code_history_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_swift_code_history_swift_code ON swift_code_history USING btree (swift_code, changed_at, id);
+CREATE INDEX idx_swift_code_history_changed_at ON swift_code_history USING btree (changed_at);
+
+-- swift_code_snapshot returns a SWIFT code with its bank and country in the format of the API.
+CREATE FUNCTION swift_code_snapshot(code swift_codes) RETURNS jsonb
+    LANGUAGE sql
+    AS $$
+    SELECT jsonb_build_object(
+        'address', code.address,
+        'addressAscii', code.address_ascii,
+        'townName', code.town_name,
+        'postalCode', code.postal_code,
+        'street', code.street,
+        'timeZone', code.time_zone,
+        'bankName', b.name,
+        'bankNameAscii', b.name_ascii,
+        'countryISO2', c.iso2_code,
+        'countryName', c.name,
+        'isHeadquarter', code.is_headquarter,
+        'swiftCode', code.swift_code
+    )
+    FROM (SELECT 1) AS one
+    LEFT JOIN banks b ON b.id = code.bank_id
+    LEFT JOIN countries c ON c.id = b.country_id;
+$$;
+
+-- record_swift_code_change adds a change of a SWIFT code to the history. Updates leaving the
+-- SWIFT code unchanged, or changing a deleted SWIFT code, are not recorded.
+CREATE FUNCTION record_swift_code_change() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    operation_var character varying(10);
+    before_var jsonb;
+    after_var jsonb;
+    code_var character varying(11);
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        operation_var := 'create';
+        after_var := swift_code_snapshot(NEW);
+        code_var := NEW.swift_code;
+    ELSIF TG_OP = 'DELETE' THEN
+        operation_var := CASE WHEN OLD.deleted_at IS NULL THEN 'delete' ELSE 'purge' END;
+        before_var := swift_code_snapshot(OLD);
+        code_var := OLD.swift_code;
+    ELSE
+        code_var := NEW.swift_code;
+        IF OLD.deleted_at IS NULL AND NEW.deleted_at IS NULL THEN
+            operation_var := 'update';
+            before_var := swift_code_snapshot(OLD);
+            after_var := swift_code_snapshot(NEW);
+            IF before_var = after_var THEN
+                RETURN NULL;
+            END IF;
+        ELSIF OLD.deleted_at IS NULL THEN
+            operation_var := 'delete';
+            before_var := swift_code_snapshot(OLD);
+        ELSIF NEW.deleted_at IS NULL THEN
+            after_var := swift_code_snapshot(NEW);
+            operation_var := CASE WHEN swift_code_snapshot(OLD) = after_var THEN 'restore' ELSE 'create' END;
+        ELSE
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    INSERT INTO swift_code_history (swift_code, operation, before, after, actor)
+    VALUES (code_var, operation_var, before_var, after_var,
+            COALESCE(NULLIF(current_setting('swift.actor', true), ''), current_user));
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER swift_codes_history
+    AFTER INSERT OR UPDATE OR DELETE ON swift_codes
+    FOR EACH ROW EXECUTE FUNCTION record_swift_code_change();
+
+-- SWIFT codes stored before the history existed start with a create.
+INSERT INTO swift_code_history (swift_code, operation, after, actor)
+SELECT sc.swift_code, 'create', swift_code_snapshot(sc), 'migration'
+FROM swift_codes sc
+WHERE sc.deleted_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE audit_log;
+DROP FUNCTION reject_audit_log_change();
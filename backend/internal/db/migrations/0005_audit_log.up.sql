@@ -0,0 +1,35 @@
+-- Audit log of the write requests made to the API. Entries can only be appended.
+
+CREATE TABLE audit_log (
+    id bigserial NOT NULL,
+    logged_at timestamp with time zone NOT NULL,
+    actor character varying(255) NOT NULL,
+    ip character varying(64) NOT NULL,
+    request_id character varying(128) NOT NULL,
+    method character varying(10) NOT NULL,
+    path text NOT NULL,
+    status integer NOT NULL,
+    detail text DEFAULT '' NOT NULL,
+    errors jsonb,
+    CONSTRAINT audit_log_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_audit_log_logged_at ON audit_log USING btree (logged_at);
+CREATE INDEX idx_audit_log_actor ON audit_log USING btree (actor, id);
+
+-- reject_audit_log_change keeps the audit log append-only.
+CREATE FUNCTION reject_audit_log_change() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION reject_audit_log_change();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_log_change();
@@ -0,0 +1,13 @@
+-- API keys authenticating callers of the API. Only the SHA-256 hash of a key is stored;
+-- its prefix identifies the key in listings.
+
+CREATE TABLE api_keys (
+    id bigserial NOT NULL,
+    name character varying(255) NOT NULL,
+    prefix character varying(16) NOT NULL,
+    key_hash character(64) NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    revoked_at timestamp with time zone,
+    CONSTRAINT api_keys_pkey PRIMARY KEY (id),
+    CONSTRAINT api_keys_key_hash_key UNIQUE (key_hash)
+);
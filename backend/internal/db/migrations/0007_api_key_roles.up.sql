@@ -0,0 +1,7 @@
+-- Roles of the API keys. Keys created before roles existed could do everything and become admin keys;
+-- new keys are editor keys unless created with another role.
+
+ALTER TABLE api_keys ADD COLUMN role character varying(16) DEFAULT 'admin' NOT NULL
+    CONSTRAINT api_keys_role_check CHECK (role IN ('reader', 'editor', 'admin'));
+
+ALTER TABLE api_keys ALTER COLUMN role SET DEFAULT 'editor';
@@ -0,0 +1,3 @@
+DROP TRIGGER countries_history ON countries;
+DROP TRIGGER banks_history ON banks;
+DROP FUNCTION record_swift_code_rename();
@@ -0,0 +1,44 @@
+-- Renames of banks and countries in the history: the upserts of POST, PUT and the importer rename an existing
+-- bank or country, which changes every SWIFT code of it. Each rename is recorded as an update of every SWIFT
+-- code that is not deleted, before the change of the SWIFT code written by the same statement, whose before
+-- then holds the new names.
+
+-- record_swift_code_rename adds an update of every SWIFT code of the renamed bank or country to the history.
+-- It runs before the row is changed, so the snapshots still hold the old names, which are replaced by the new.
+CREATE FUNCTION record_swift_code_rename() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+DECLARE
+    renamed_var jsonb;
+BEGIN
+    IF TG_TABLE_NAME = 'banks' THEN
+        IF NEW.name IS NOT DISTINCT FROM OLD.name AND NEW.name_ascii IS NOT DISTINCT FROM OLD.name_ascii THEN
+            RETURN NEW;
+        END IF;
+        renamed_var := jsonb_build_object('bankName', NEW.name, 'bankNameAscii', NEW.name_ascii);
+    ELSE
+        IF NEW.name IS NOT DISTINCT FROM OLD.name THEN
+            RETURN NEW;
+        END IF;
+        renamed_var := jsonb_build_object('countryName', NEW.name);
+    END IF;
+
+    INSERT INTO swift_code_history (swift_code, operation, before, after, actor)
+    SELECT sc.swift_code, 'update', swift_code_snapshot(sc), swift_code_snapshot(sc) || renamed_var,
+           COALESCE(NULLIF(current_setting('swift.actor', true), ''), current_user)
+    FROM swift_codes sc
+    JOIN banks b ON b.id = sc.bank_id
+    WHERE sc.deleted_at IS NULL
+      AND (TG_TABLE_NAME = 'banks' AND b.id = OLD.id OR TG_TABLE_NAME = 'countries' AND b.country_id = OLD.id)
+    ORDER BY sc.swift_code;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER banks_history
+    BEFORE UPDATE ON banks
+    FOR EACH ROW EXECUTE FUNCTION record_swift_code_rename();
+
+CREATE TRIGGER countries_history
+    BEFORE UPDATE ON countries
+    FOR EACH ROW EXECUTE FUNCTION record_swift_code_rename();
@@ -0,0 +1,3 @@
+-- Name given by the caller in the X-Actor header, recorded apart from the authenticated actor.
+
+ALTER TABLE audit_log ADD COLUMN claimed_actor character varying(255) DEFAULT '' NOT NULL;
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/internal/audit"
+	"backend/internal/auth"
+	"backend/internal/models"
+)
+
+// AuditHandler handles GET requests to /v1/audit listing the audit log of write requests, newest first.
+// The entries can be limited to a time range, from inclusive and to exclusive, and to a single actor.
+func (h *Handler) AuditHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleAdmin) {
+		return
+	}
+	if h.Audit == nil {
+		writeJSONError(w, http.StatusNotFound, "Audit log is disabled")
+		return
+	}
+
+	limit, err := parseLimit(r, defaultPageSize, maxPageSize)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit – %v.", err))
+		return
+	}
+
+	// one extra entry tells whether there is a next page
+	filter := audit.Filter{Actor: strings.TrimSpace(r.URL.Query().Get("actor")), Limit: limit + 1}
+	if filter.From, err = parseTimeParam(r, "from"); err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid from – %v.", err))
+		return
+	}
+	if filter.To, err = parseTimeParam(r, "to"); err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid to – %v.", err))
+		return
+	}
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid time range – from must be before to.")
+		return
+	}
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		values, err := decodeCursor(cursor, 1)
+		if err == nil {
+			filter.Before, err = strconv.ParseInt(values[0], 10, 64)
+		}
+		if err != nil || filter.Before <= 0 {
+			writeJSONError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	entries, err := h.Audit.List(filter)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	response := models.AuditLog{Entries: entries}
+	if len(entries) > limit {
+		response.Entries = entries[:limit]
+		next := encodeCursor(strconv.FormatInt(response.Entries[limit-1].ID, 10))
+		response.Next = &next
+	}
+
+	respondWithJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/internal/auth"
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
+)
+
+// BanksHandler handles HTTP requests to the /v1/banks and /v1/banks/{id} endpoints.
+func (h *Handler) BanksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
+	bankID := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/banks"), "/")
+	if bankID == "" {
+		country := strings.TrimSpace(r.URL.Query().Get("country"))
+		if country != "" && !validation.CountryIsoRegex.MatchString(country) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid Country ISO2 Code format – it must be exactly 2 letters.")
+			return
+		}
+		h.listBanks(w, r, strings.ToUpper(country))
+		return
+	}
+
+	h.getBank(w, bankID)
+}
+
+// getBank writes a single bank with its headquarters and branch SWIFT codes.
+func (h *Handler) getBank(w http.ResponseWriter, bankID string) {
+	if !validation.UUIDRegex.MatchString(bankID) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid bank ID format – it must be a UUID.")
+		return
+	}
+
+	bank, err := h.Store.GetBank(strings.ToLower(bankID))
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, bank)
+}
+
+// listBanks writes a page of banks ordered by name, optionally limited to a single country.
+func (h *Handler) listBanks(w http.ResponseWriter, r *http.Request, countryISO2Code string) {
+	limit, err := parseLimit(r, defaultPageSize, maxPageSize)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit – %v.", err))
+		return
+	}
+
+	page := store.Page{Limit: limit + 1}
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		values, err := decodeCursor(cursor, 2)
+		if err != nil || !validation.UUIDRegex.MatchString(values[1]) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+		page.After = values
+	}
+
+	banks, err := h.Store.ListBanks(countryISO2Code, page)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	list := models.BankList{Banks: banks}
+	if len(list.Banks) > limit {
+		list.Banks = list.Banks[:limit]
+		last := list.Banks[limit-1]
+		next := encodeCursor(validation.SearchKey(last.Name), last.ID)
+		list.Next = &next
+	}
+
+	respondWithJSON(w, http.StatusOK, list)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+
+	"backend/internal/auth"
+	"backend/internal/models"
+	"backend/internal/validation"
+)
+
+// default and maximum number of bank search results
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchBanksHandler handles GET requests searching banks by name, ignoring case and accents.
+// Exact, prefix and substring matches rank above trigram similarity matches;
+// with includeAddress=true the addresses of the bank's SWIFT codes are searched as well.
+func (h *Handler) SearchBanksHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
+	query := r.URL.Query()
+
+	q := validation.NormalizeText(query.Get("q"))
+	if utf8.RuneCountInString(q) < 2 || len(q) > 255 {
+		writeJSONError(w, http.StatusBadRequest, "Search query must be between 2 and 255 characters")
+		return
+	}
+
+	key := validation.SearchKey(q)
+
+	limit, err := parseLimit(r, defaultSearchLimit, maxSearchLimit)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit – %v.", err))
+		return
+	}
+
+	includeAddress := false
+	if value := query.Get("includeAddress"); value != "" {
+		includeAddress, err = strconv.ParseBool(value)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid includeAddress – it must be true or false.")
+			return
+		}
+	}
+
+	results, err := h.Store.SearchBanks(key, includeAddress, limit)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	response := models.BankSearchResponse{Query: q, Results: results}
+	respondWithJSON(w, http.StatusOK, response)
+}
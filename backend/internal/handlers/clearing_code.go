@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"backend/internal/auth"
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
+)
+
+// ClearingCodesHandler handles HTTP requests to the /v1/swift-codes/{code}/clearing-codes endpoints.
+// The remaining path segments, if any, identify a single clearing code as {scheme}/{code}.
+func (h *Handler) ClearingCodesHandler(w http.ResponseWriter, r *http.Request, swiftCodeParam string, segments []string) {
+	swiftCode, ok := parseSwiftCodeParam(w, swiftCodeParam)
+	if !ok {
+		return
+	}
+
+	switch {
+	case len(segments) == 0 && r.Method == http.MethodGet:
+		h.listClearingCodes(w, swiftCode)
+	case len(segments) == 0 && r.Method == http.MethodPost:
+		h.createClearingCode(w, r, swiftCode)
+	case len(segments) == 0:
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+	case len(segments) != 2:
+		writeJSONError(w, http.StatusNotFound, "Resource not found")
+	default:
+		scheme, code, validationErrors := validation.NormalizeClearingCode(segments[0], segments[1])
+		if len(validationErrors) > 0 {
+			writeValidationErrors(w, validationErrors)
+			return
+		}
+		clearingCode := models.ClearingCode{Scheme: scheme, Code: code}
+
+		switch r.Method {
+		case http.MethodGet:
+			h.getClearingCode(w, swiftCode, clearingCode)
+		case http.MethodPut:
+			h.updateClearingCode(w, r, swiftCode, clearingCode)
+		case http.MethodDelete:
+			h.deleteClearingCode(w, swiftCode, clearingCode)
+		default:
+			writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		}
+	}
+}
+
+// GetClearingCodeHandler handles GET requests resolving a national clearing code to its SWIFT code.
+func (h *Handler) GetClearingCodeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/clearing-codes"), "/"), "/")
+	if len(segments) != 2 {
+		writeJSONError(w, http.StatusBadRequest, "Clearing scheme and code are required")
+		return
+	}
+
+	scheme, code, validationErrors := validation.NormalizeClearingCode(segments[0], segments[1])
+	if len(validationErrors) > 0 {
+		writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	match := models.ClearingCodeMatch{Scheme: scheme, Code: code}
+	swiftCode, err := h.Store.GetSwiftCodeByClearingCode(models.ClearingCode{Scheme: scheme, Code: code})
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+	match.SwiftCode = swiftCode
+
+	respondWithJSON(w, http.StatusOK, match)
+}
+
+// listClearingCodes writes the clearing codes of a SWIFT code.
+func (h *Handler) listClearingCodes(w http.ResponseWriter, swiftCode string) {
+	clearingCodes, err := h.Store.ListClearingCodes(swiftCode)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	list := models.SwiftCodeClearingCodes{SwiftCode: swiftCode, ClearingCodes: clearingCodes}
+	respondWithJSON(w, http.StatusOK, list)
+}
+
+// decodeClearingCode reads and validates a clearing code from the request body.
+func decodeClearingCode(w http.ResponseWriter, r *http.Request) (models.ClearingCode, bool) {
+	var body models.ClearingCode
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeDecodeError(w, err)
+		return body, false
+	}
+
+	if body.Scheme == "" || body.Code == "" {
+		writeJSONError(w, http.StatusBadRequest, "Missing required fields: scheme and code are required")
+		return body, false
+	}
+
+	scheme, code, validationErrors := validation.NormalizeClearingCode(body.Scheme, body.Code)
+	if len(validationErrors) > 0 {
+		writeValidationErrors(w, validationErrors)
+		return body, false
+	}
+
+	return models.ClearingCode{Scheme: scheme, Code: code}, true
+}
+
+// createClearingCode links a new clearing code to a SWIFT code.
+func (h *Handler) createClearingCode(w http.ResponseWriter, r *http.Request, swiftCode string) {
+	body, ok := decodeClearingCode(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.Store.CreateClearingCode(swiftCode, body)
+	switch {
+	case errors.Is(err, store.ErrConflict):
+		writeJSONError(w, http.StatusConflict, "Clearing code is already assigned")
+	case err != nil:
+		handleDBError(w, err)
+	default:
+		respondWithJSON(w, http.StatusCreated, body)
+	}
+}
+
+// getClearingCode writes a single clearing code of a SWIFT code.
+func (h *Handler) getClearingCode(w http.ResponseWriter, swiftCode string, clearingCode models.ClearingCode) {
+	clearingCode, err := h.Store.GetClearingCode(swiftCode, clearingCode)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, clearingCode)
+}
+
+// updateClearingCode replaces a clearing code of a SWIFT code with the one from the request body.
+func (h *Handler) updateClearingCode(w http.ResponseWriter, r *http.Request, swiftCode string, clearingCode models.ClearingCode) {
+	body, ok := decodeClearingCode(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.Store.UpdateClearingCode(swiftCode, clearingCode, body)
+	switch {
+	case errors.Is(err, store.ErrConflict):
+		writeJSONError(w, http.StatusConflict, "Clearing code is already assigned")
+	case err != nil:
+		handleDBError(w, err)
+	default:
+		respondWithJSON(w, http.StatusOK, body)
+	}
+}
+
+// deleteClearingCode removes a clearing code from a SWIFT code.
+func (h *Handler) deleteClearingCode(w http.ResponseWriter, swiftCode string, clearingCode models.ClearingCode) {
+	if err := h.Store.DeleteClearingCode(swiftCode, clearingCode); err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "Clearing code deleted successfully"})
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"backend/internal/auth"
+	"backend/internal/models"
+	"backend/internal/validation"
+)
+
+// CountriesHandler handles HTTP requests to the /v1/countries/ endpoints.
+func (h *Handler) CountriesHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/countries"), "/")
+	segments := strings.Split(path, "/")
+	switch {
+	case path == "":
+		h.listCountries(w)
+	case len(segments) == 1:
+		h.getCountry(w, segments[0])
+	case len(segments) == 2 && segments[1] == "banks":
+		h.getBanksByCountry(w, r, segments[0])
+	default:
+		writeJSONError(w, http.StatusNotFound, "Resource not found")
+	}
+}
+
+// listCountries writes all countries with their statistics.
+func (h *Handler) listCountries(w http.ResponseWriter) {
+	countries, err := h.Store.ListCountries()
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	list := models.CountryList{Countries: make([]models.Country, 0, len(countries))}
+	for _, country := range countries {
+		list.Countries = append(list.Countries, withISOCodes(country))
+	}
+
+	respondWithJSON(w, http.StatusOK, list)
+}
+
+// getCountry writes a single country with its statistics.
+func (h *Handler) getCountry(w http.ResponseWriter, countryISO2Code string) {
+	if !validation.CountryIsoRegex.MatchString(countryISO2Code) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid Country ISO2 Code format – it must be exactly 2 letters.")
+		return
+	}
+
+	country, err := h.Store.GetCountry(strings.ToUpper(countryISO2Code))
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, withISOCodes(country))
+}
+
+// withISOCodes adds the alpha-3 and numeric codes of the ISO 3166 registry to a country.
+func withISOCodes(country models.Country) models.Country {
+	if entry, ok := validation.LookupCountry(country.CountryISO2); ok {
+		country.CountryISO3 = entry.Alpha3
+		country.CountryNumeric = entry.Numeric
+	}
+	return country
+}
+
+// withCountryISOCodes adds the alpha-3 and numeric codes of the ISO 3166 registry to the SWIFT codes of a country.
+func withCountryISOCodes(countrySwiftCodes models.SwiftCodeByCountryISO2) models.SwiftCodeByCountryISO2 {
+	if entry, ok := validation.LookupCountry(countrySwiftCodes.CountryISO2); ok {
+		countrySwiftCodes.CountryISO3 = entry.Alpha3
+		countrySwiftCodes.CountryNumeric = entry.Numeric
+	}
+	return countrySwiftCodes
+}
+
+// getBanksByCountry writes a page of the banks of a single country.
+func (h *Handler) getBanksByCountry(w http.ResponseWriter, r *http.Request, countryISO2Code string) {
+	if !validation.CountryIsoRegex.MatchString(countryISO2Code) {
+		writeJSONError(w, http.StatusBadRequest, "Invalid Country ISO2 Code format – it must be exactly 2 letters.")
+		return
+	}
+	countryISO2Code = strings.ToUpper(countryISO2Code)
+
+	exists, err := h.Store.CountryExists(countryISO2Code)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+	if !exists {
+		writeJSONError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	h.listBanks(w, r, countryISO2Code)
+}
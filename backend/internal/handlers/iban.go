@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"backend/internal/auth"
+	"backend/internal/iban"
+	"backend/internal/models"
+	"backend/internal/store"
+)
+
+// GetIBANHandler handles GET requests that validate an IBAN and resolve the SWIFT code of its bank.
+func (h *Handler) GetIBANHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
+	value := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/v1/iban/"))
+	if value == "" {
+		writeJSONError(w, http.StatusBadRequest, "IBAN is required")
+		return
+	}
+
+	parsed, err := iban.Parse(value)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid IBAN: %v", err))
+		return
+	}
+
+	details := models.IBANDetails{
+		IBAN:        parsed.String(),
+		Printed:     parsed.Printed(),
+		CountryISO2: parsed.CountryISO2,
+		CheckDigits: parsed.CheckDigits,
+		BBAN:        parsed.BBAN,
+		BankCode:    parsed.BankCode,
+	}
+
+	swiftCode, err := h.Store.GetSwiftCodeByBankCode(parsed.CountryISO2, parsed.BankCode)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		// the IBAN is valid but its bank code has not been mapped
+	case err != nil:
+		handleDBError(w, err)
+		return
+	default:
+		details.SwiftCode = &swiftCode
+	}
+
+	respondWithJSON(w, http.StatusOK, details)
+}
@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/internal/auth"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
+)
+
+// maxBatchSize limits the number of SWIFT codes accepted in a single batch request.
+const maxBatchSize = 1000
+
+// maxBatchBodySize limits the size of a batch request body in bytes.
+const maxBatchBodySize = 4 << 20
+
+// batch modes
+const (
+	batchModeAtomic     = "atomic"
+	batchModeBestEffort = "bestEffort"
+)
+
+// per-item batch statuses
+const (
+	batchStatusCreated    = "created"
+	batchStatusConflict   = "conflict"
+	batchStatusInvalid    = "invalid"
+	batchStatusFailed     = "failed"
+	batchStatusNotApplied = "notApplied"
+)
+
+// PostSwiftCodesBatchHandler handles POST requests adding many SWIFT codes at once.
+// The body is either a JSON array or an application/x-ndjson stream of SWIFT codes.
+func (h *Handler) PostSwiftCodesBatchHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	if !authorize(w, r, auth.RoleEditor) {
+		return
+	}
+
+	mode := r.URL.Query().Get("mode")
+	if mode == "" {
+		mode = batchModeAtomic
+	}
+	if mode != batchModeAtomic && mode != batchModeBestEffort {
+		writeJSONError(w, http.StatusBadRequest, "Invalid mode – it must be either atomic or bestEffort.")
+		return
+	}
+
+	items, status, message := decodeSwiftCodeBatch(w, r)
+	if status != 0 {
+		writeJSONError(w, status, message)
+		return
+	}
+
+	response := models.SwiftCodeBatchResponse{
+		Mode:    mode,
+		Results: make([]models.SwiftCodeBatchResult, len(items)),
+	}
+
+	valid := true
+	for i := range items {
+		result := &response.Results[i]
+		result.Index = i
+		if problem := prepareSwiftCodeBranch(&items[i]); problem != nil {
+			result.Status = batchStatusInvalid
+			result.Error = problem.Detail
+			result.Errors = problem.Errors
+			valid = false
+		}
+		result.SwiftCode = items[i].SwiftCode
+		result.ParticipantType = validation.ParticipantType(items[i].SwiftCode)
+	}
+	if !valid {
+		auditBatchErrors(r, response.Results)
+	}
+
+	if mode == batchModeAtomic {
+		h.insertBatchAtomic(w, items, middleware.RequestActor(r), &response, valid)
+	} else {
+		h.insertBatchBestEffort(w, items, middleware.RequestActor(r), &response)
+	}
+}
+
+// auditBatchErrors reports the field errors of the invalid items to the audit log, each field prefixed
+// with the index of its item, since the batch response is a result list rather than a problem.
+func auditBatchErrors(r *http.Request, results []models.SwiftCodeBatchResult) {
+	var indexes []string
+	var fieldErrors []models.FieldError
+	for _, result := range results {
+		if result.Status != batchStatusInvalid {
+			continue
+		}
+		indexes = append(indexes, strconv.Itoa(result.Index))
+		for _, fieldError := range result.Errors {
+			fieldError.Field = fmt.Sprintf("[%d].%s", result.Index, fieldError.Field)
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+	middleware.SetAuditProblem(r, models.NewValidationProblem("Invalid SWIFT codes at indexes: "+strings.Join(indexes, ", "), fieldErrors))
+}
+
+// insertBatchAtomic inserts all items in one transaction, rolling everything back on the first failure.
+func (h *Handler) insertBatchAtomic(w http.ResponseWriter, items []models.SwiftCodeBranch, actor string, response *models.SwiftCodeBatchResponse, valid bool) {
+	if !valid {
+		markNotApplied(response)
+		respondWithJSON(w, http.StatusBadRequest, response)
+		return
+	}
+
+	results, err := h.Store.CreateSwiftCodes(items, actor)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	status := http.StatusCreated
+	for i, err := range results {
+		result := &response.Results[i]
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			result.Status = batchStatusConflict
+			result.Error = "SWIFT code already exists"
+			if status == http.StatusCreated {
+				status = http.StatusConflict
+			}
+		case err != nil:
+			log.Printf("Batch insert error: %v", err)
+			result.Status = batchStatusFailed
+			result.Error = "Failed to insert SWIFT code"
+			status = http.StatusInternalServerError
+		default:
+			result.Status = batchStatusCreated
+		}
+	}
+
+	if status != http.StatusCreated {
+		markNotApplied(response)
+		respondWithJSON(w, status, response)
+		return
+	}
+
+	response.Created = len(items)
+	respondWithJSON(w, http.StatusCreated, response)
+}
+
+// insertBatchBestEffort inserts every valid item independently and reports each outcome.
+func (h *Handler) insertBatchBestEffort(w http.ResponseWriter, items []models.SwiftCodeBranch, actor string, response *models.SwiftCodeBatchResponse) {
+	for i, item := range items {
+		result := &response.Results[i]
+		if result.Status == batchStatusInvalid {
+			response.Failed++
+			continue
+		}
+
+		err := h.Store.CreateSwiftCode(item, actor)
+		switch {
+		case errors.Is(err, store.ErrConflict):
+			result.Status = batchStatusConflict
+			result.Error = "SWIFT code already exists"
+			response.Failed++
+		case err != nil:
+			log.Printf("Batch insert error: %v", err)
+			result.Status = batchStatusFailed
+			result.Error = "Failed to insert SWIFT code"
+			response.Failed++
+		default:
+			result.Status = batchStatusCreated
+			response.Created++
+		}
+	}
+
+	status := http.StatusCreated
+	if response.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	respondWithJSON(w, status, response)
+}
+
+// markNotApplied marks every item that did not fail as not applied and counts the failures.
+func markNotApplied(response *models.SwiftCodeBatchResponse) {
+	for i := range response.Results {
+		result := &response.Results[i]
+		switch result.Status {
+		case batchStatusInvalid, batchStatusConflict, batchStatusFailed:
+			response.Failed++
+		default:
+			result.Status = batchStatusNotApplied
+			result.Error = ""
+			result.Errors = nil
+		}
+	}
+}
+
+// decodeSwiftCodeBatch reads a JSON array or an NDJSON stream of SWIFT codes, one item at a time,
+// stopping as soon as the body exceeds maxBatchBodySize or holds more than maxBatchSize items.
+// It returns a non-zero status and an error message when the body is rejected.
+func decodeSwiftCodeBatch(w http.ResponseWriter, r *http.Request) ([]models.SwiftCodeBranch, int, string) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBatchBodySize))
+	decoder.DisallowUnknownFields()
+
+	var items []models.SwiftCodeBranch
+	rejected := func(format string, err error) ([]models.SwiftCodeBranch, int, string) {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, http.StatusRequestEntityTooLarge, fmt.Sprintf("Batch body must be at most %d bytes", maxBatchBodySize)
+		}
+		return nil, http.StatusBadRequest, fmt.Sprintf(format, len(items), err)
+	}
+	tooMany := func() ([]models.SwiftCodeBranch, int, string) {
+		return nil, http.StatusRequestEntityTooLarge, fmt.Sprintf("Batch must contain at most %d SWIFT codes", maxBatchSize)
+	}
+
+	if mediaType == "application/x-ndjson" {
+		for {
+			var item models.SwiftCodeBranch
+			err := decoder.Decode(&item)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return rejected("Invalid NDJSON at item %d: %v", err)
+			}
+			if len(items) == maxBatchSize {
+				return tooMany()
+			}
+			items = append(items, item)
+		}
+	} else {
+		if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+			if err == nil {
+				err = errors.New("the body must be a JSON array")
+			}
+			return rejected("Invalid JSON at item %d: %v", err)
+		}
+		for decoder.More() {
+			if len(items) == maxBatchSize {
+				return tooMany()
+			}
+			var item models.SwiftCodeBranch
+			if err := decoder.Decode(&item); err != nil {
+				return rejected("Invalid JSON at item %d: %v", err)
+			}
+			items = append(items, item)
+		}
+		if _, err := decoder.Token(); err != nil {
+			return rejected("Invalid JSON at item %d: %v", err)
+		}
+		if _, err := decoder.Token(); err != io.EOF {
+			if err == nil {
+				err = errors.New("unexpected data after the array")
+			}
+			return rejected("Invalid JSON at item %d: %v", err)
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, http.StatusBadRequest, "Batch must contain at least one SWIFT code"
+	}
+
+	return items, 0, ""
+}
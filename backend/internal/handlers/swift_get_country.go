@@ -1,17 +1,26 @@
 package handlers
 
 import (
-	"database/sql"
-	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
-	"backend/internal/models"
+	"backend/internal/auth"
+	"backend/internal/store"
 	"backend/internal/validation"
 )
 
+// pageParams are the query parameters answered by getSwiftCodesByCountryPage.
+var pageParams = []string{"limit", "offset", "cursor", "sort", "isHeadquarter", "town", "includeDeleted"}
+
 // getswiftcodesbycountryhandler handles GET requests for a single country ISO2 code.
+// With asOf the SWIFT codes of the country are returned as they were at that time.
 func (h *Handler) GetSwiftCodesByCountryHandler(w http.ResponseWriter, r *http.Request) {
+	if !authorize(w, r, auth.RoleReader) {
+		return
+	}
+
 	countryISO2Code := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/v1/swift-codes/country/"))
 	if countryISO2Code == "" {
 		writeJSONError(w, http.StatusBadRequest, "Country ISO2 Code is required")
@@ -25,40 +34,124 @@ func (h *Handler) GetSwiftCodesByCountryHandler(w http.ResponseWriter, r *http.R
 
 	countryISO2Code = strings.ToUpper(countryISO2Code)
 
-	var countrySwiftCodes models.SwiftCodeByCountryISO2
-	var swiftCodes sql.NullString
-	var countryName sql.NullString
-
-	err := h.DB.QueryRow(`
-		SELECT 
-			c.name AS country_name,
-			COALESCE(json_agg(json_build_object(
-				'bankName', b.name,
-				'address', sc.address,
-				'countryISO2', c.iso2_code,
-				'isHeadquarter', sc.is_headquarter,
-				'swiftCode', sc.swift_code
-			)), '[]'::json) AS swift_codes
-		FROM countries c
-		LEFT JOIN banks b ON b.country_id = c.id
-		LEFT JOIN swift_codes sc ON sc.bank_id = b.id
-		WHERE c.iso2_code = $1
-		GROUP BY c.name;
-	`, countryISO2Code).Scan(&countryName, &swiftCodes)
+	asOf, err := parseAsOf(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid asOf – %v.", err))
+		return
+	}
+
+	query := r.URL.Query()
+	for _, param := range pageParams {
+		if !query.Has(param) {
+			continue
+		}
+		if !asOf.IsZero() {
+			writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("asOf cannot be used together with %s", param))
+			return
+		}
+		h.getSwiftCodesByCountryPage(w, r, countryISO2Code)
+		return
+	}
+
+	if !asOf.IsZero() {
+		h.getSwiftCodesByCountryAsOf(w, countryISO2Code, asOf)
+		return
+	}
 
+	countrySwiftCodes, err := h.Store.GetCountrySwiftCodes(countryISO2Code)
 	if err != nil {
 		handleDBError(w, err)
 		return
 	}
 
-	countrySwiftCodes.CountryISO2 = countryISO2Code
-	countrySwiftCodes.CountryName = countryName.String
+	respondWithJSON(w, http.StatusOK, withCountryISOCodes(countrySwiftCodes))
+}
+
+// getSwiftCodesByCountryPage handles by-country requests using paging, sorting or filtering parameters.
+// Offset and cursor pagination are mutually exclusive; a cursor to the next page is returned either way.
+func (h *Handler) getSwiftCodesByCountryPage(w http.ResponseWriter, r *http.Request, countryISO2Code string) {
+	query := r.URL.Query()
+
+	limit, err := parseLimit(r, defaultPageSize, maxPageSize)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit – %v.", err))
+		return
+	}
+
+	sort := query.Get("sort")
+	if sort == "" {
+		sort = store.SortSwiftCode
+	}
+	if sort != store.SortSwiftCode && sort != store.SortBankName {
+		writeJSONError(w, http.StatusBadRequest, "Invalid sort – it must be either swiftCode or bankName.")
+		return
+	}
+
+	offset := 0
+	if value := query.Get("offset"); value != "" {
+		offset, err = strconv.Atoi(value)
+		if err != nil || offset < 0 {
+			writeJSONError(w, http.StatusBadRequest, "Invalid offset – it must be a non-negative number.")
+			return
+		}
+	}
+
+	cursor := query.Get("cursor")
+	if cursor != "" && offset > 0 {
+		writeJSONError(w, http.StatusBadRequest, "Offset and cursor cannot be used together")
+		return
+	}
+
+	var filter store.SwiftCodeFilter
+	if value := query.Get("isHeadquarter"); value != "" {
+		isHeadquarter, err := strconv.ParseBool(value)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid isHeadquarter – it must be true or false.")
+			return
+		}
+		filter.IsHeadquarter = &isHeadquarter
+	}
+	if town := strings.TrimSpace(query.Get("town")); town != "" {
+		if len(town) > 100 || !validation.TownNameRegex.MatchString(town) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid town – it must be a town name of at most 100 characters.")
+			return
+		}
+		filter.Town = strings.ToUpper(town)
+	}
+	var ok bool
+	if filter.IncludeDeleted, ok = includeDeletedParam(w, r); !ok {
+		return
+	}
+
+	// one extra row tells whether there is a next page
+	page := store.Page{Limit: limit + 1, Offset: offset, Sort: sort}
+	if cursor != "" {
+		keyLength := 1
+		if sort == store.SortBankName {
+			keyLength = 2
+		}
+		page.After, err = decodeCursor(cursor, keyLength)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	countrySwiftCodes, err := h.Store.ListCountrySwiftCodes(countryISO2Code, filter, page)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
 
-	if swiftCodes.Valid {
-		json.Unmarshal([]byte(swiftCodes.String), &countrySwiftCodes.SwiftCodes)
-	} else {
-		countrySwiftCodes.SwiftCodes = []models.SwiftCodeDetails{}
+	if len(countrySwiftCodes.SwiftCodes) > limit {
+		countrySwiftCodes.SwiftCodes = countrySwiftCodes.SwiftCodes[:limit]
+		last := countrySwiftCodes.SwiftCodes[limit-1]
+		next := encodeCursor(last.SwiftCode)
+		if sort == store.SortBankName {
+			next = encodeCursor(validation.SearchKey(last.BankName), last.SwiftCode)
+		}
+		countrySwiftCodes.Next = &next
 	}
 
-	respondWithJSON(w, http.StatusOK, countrySwiftCodes)
+	respondWithJSON(w, http.StatusOK, withCountryISOCodes(countrySwiftCodes))
 }
@@ -3,25 +3,93 @@ package handlers
 import (
 	"database/sql"
 	"net/http"
+	"strings"
+
+	"backend/internal/audit"
+	"backend/internal/auth"
+	"backend/internal/store"
 )
 
-// Handler is a structure that stores a reference to the database.
+// Handler is a structure that stores a reference to the data layer.
 type Handler struct {
-	DB *sql.DB
+	Store store.Store
+	Audit audit.Sink // the audit log read by AuditHandler, nil when it is disabled
 }
 
-// NewHandler creates a new handler with a reference to the database.
+// NewHandler creates a new handler backed by the PostgreSQL database.
 func NewHandler(db *sql.DB) *Handler {
-	return &Handler{DB: db}
+	return NewStoreHandler(store.NewPostgres(db))
+}
+
+// NewStoreHandler creates a new handler backed by the given store.
+func NewStoreHandler(s store.Store) *Handler {
+	return &Handler{Store: s}
+}
+
+// swiftCodeRoles are the roles needed by the methods of the SWIFT code routes, and clearingCodeRoles those
+// of the clearing code routes. Other methods need the reader role and are rejected by the routes.
+var (
+	swiftCodeRoles = map[string]auth.Role{
+		http.MethodPost:   auth.RoleEditor,
+		http.MethodPut:    auth.RoleEditor,
+		http.MethodPatch:  auth.RoleEditor,
+		http.MethodDelete: auth.RoleAdmin,
+	}
+	clearingCodeRoles = map[string]auth.Role{
+		http.MethodPost:   auth.RoleEditor,
+		http.MethodPut:    auth.RoleEditor,
+		http.MethodDelete: auth.RoleEditor,
+	}
+)
+
+// requiredRole returns the role a method needs according to roles.
+func requiredRole(roles map[string]auth.Role, method string) auth.Role {
+	if role, ok := roles[method]; ok {
+		return role
+	}
+	return auth.RoleReader
 }
 
 // SwiftHandler handles HTTP requests to the /v1/swift-codes/ endpoint.
 func (h *Handler) SwiftHandler(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(strings.TrimPrefix(r.URL.Path, "/v1/swift-codes"), "/")
+	segments := strings.Split(path, "/")
+	if len(segments) > 1 {
+		switch {
+		case segments[1] == "clearing-codes":
+			if authorize(w, r, requiredRole(clearingCodeRoles, r.Method)) {
+				h.ClearingCodesHandler(w, r, segments[0], segments[2:])
+			}
+		case segments[1] == "restore" && len(segments) == 2:
+			if authorize(w, r, auth.RoleEditor) {
+				h.RestoreSwiftCodeHandler(w, r, segments[0])
+			}
+		case segments[1] == "history" && len(segments) == 2:
+			if authorize(w, r, auth.RoleReader) {
+				h.SwiftCodeHistoryHandler(w, r, segments[0])
+			}
+		default:
+			writeJSONError(w, http.StatusNotFound, "Resource not found")
+		}
+		return
+	}
+
+	if !authorize(w, r, requiredRole(swiftCodeRoles, r.Method)) {
+		return
+	}
 	switch r.Method {
 	case http.MethodGet:
+		if path == "" {
+			h.ListSwiftCodesHandler(w, r)
+			return
+		}
 		h.GetSwiftCodeDetailsHandler(w, r)
 	case http.MethodPost:
 		h.PostSwiftCodeHandler(w, r)
+	case http.MethodPut:
+		h.PutSwiftCodeHandler(w, r)
+	case http.MethodPatch:
+		h.PatchSwiftCodeHandler(w, r)
 	case http.MethodDelete:
 		h.DeleteSwiftCodeHandler(w, r)
 	default:
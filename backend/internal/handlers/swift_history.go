@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+)
+
+// SwiftCodeHistoryHandler handles GET requests to /v1/swift-codes/{code}/history listing the recorded changes of a SWIFT code.
+func (h *Handler) SwiftCodeHistoryHandler(w http.ResponseWriter, r *http.Request, swiftCodeParam string) {
+	if r.Method != http.MethodGet {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	swiftCode, ok := parseSwiftCodeParam(w, swiftCodeParam)
+	if !ok {
+		return
+	}
+
+	changes, err := h.Store.GetSwiftCodeHistory(swiftCode)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, models.SwiftCodeHistory{SwiftCode: swiftCode, Changes: changes})
+}
+
+// getSwiftCodeAsOf responds with a SWIFT code as it was at the given time, a headquarter
+// together with the branches existing then.
+func (h *Handler) getSwiftCodeAsOf(w http.ResponseWriter, swiftCode string, asOf time.Time) {
+	isHeadquarter := strings.HasSuffix(swiftCode, "XXX")
+	prefix := swiftCode
+	if isHeadquarter {
+		prefix = swiftCode[:8]
+	}
+
+	swiftCodes, err := h.Store.ListSwiftCodesAsOf(asOf, "", prefix)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	var found *models.SwiftCodeBranch
+	branches := []models.SwiftCodeDetails{}
+	for i, sc := range swiftCodes {
+		switch {
+		case sc.SwiftCode == swiftCode:
+			found = &swiftCodes[i]
+		case !*sc.IsHeadquarter:
+			branches = append(branches, detailsOf(sc))
+		}
+	}
+	if found == nil || !isHeadquarter && *found.IsHeadquarter {
+		writeJSONError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+	if !isHeadquarter {
+		respondWithJSON(w, http.StatusOK, found)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, models.SwiftCodeHeadquarter{
+		Address:       found.Address,
+		AddressASCII:  found.AddressASCII,
+		TownName:      found.TownName,
+		PostalCode:    found.PostalCode,
+		Street:        found.Street,
+		TimeZone:      found.TimeZone,
+		BankName:      found.BankName,
+		BankNameASCII: found.BankNameASCII,
+		CountryISO2:   found.CountryISO2,
+		CountryName:   found.CountryName,
+		IsHeadquarter: *found.IsHeadquarter,
+		SwiftCode:     found.SwiftCode,
+		Branches:      branches,
+	})
+}
+
+// getSwiftCodesByCountryAsOf responds with the SWIFT codes of a country as they were at the given time.
+func (h *Handler) getSwiftCodesByCountryAsOf(w http.ResponseWriter, countryISO2Code string, asOf time.Time) {
+	swiftCodes, err := h.Store.ListSwiftCodesAsOf(asOf, countryISO2Code, "")
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+	if len(swiftCodes) == 0 {
+		writeJSONError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	countrySwiftCodes := models.SwiftCodeByCountryISO2{
+		CountryISO2: countryISO2Code,
+		CountryName: swiftCodes[0].CountryName,
+		SwiftCodes:  make([]models.SwiftCodeDetails, 0, len(swiftCodes)),
+	}
+	for _, sc := range swiftCodes {
+		countrySwiftCodes.SwiftCodes = append(countrySwiftCodes.SwiftCodes, detailsOf(sc))
+	}
+
+	respondWithJSON(w, http.StatusOK, withCountryISOCodes(countrySwiftCodes))
+}
+
+// detailsOf returns a SWIFT code in the format of listings.
+func detailsOf(sc models.SwiftCodeBranch) models.SwiftCodeDetails {
+	return models.SwiftCodeDetails{
+		Address:       sc.Address,
+		AddressASCII:  sc.AddressASCII,
+		TownName:      sc.TownName,
+		PostalCode:    sc.PostalCode,
+		Street:        sc.Street,
+		TimeZone:      sc.TimeZone,
+		BankName:      sc.BankName,
+		BankNameASCII: sc.BankNameASCII,
+		CountryISO2:   sc.CountryISO2,
+		IsHeadquarter: *sc.IsHeadquarter,
+		SwiftCode:     sc.SwiftCode,
+	}
+}
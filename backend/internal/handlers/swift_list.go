@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
+)
+
+// default and maximum page sizes of the listing endpoints
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+// ListSwiftCodesHandler handles GET requests listing all SWIFT codes page by page.
+// Results are ordered by SWIFT code and paginated with an opaque keyset cursor.
+func (h *Handler) ListSwiftCodesHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit, err := parseLimit(r, defaultPageSize, maxPageSize)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid limit – %v.", err))
+		return
+	}
+
+	var filter store.SwiftCodeFilter
+	var page store.Page
+
+	if country := strings.TrimSpace(query.Get("country")); country != "" {
+		if !validation.CountryIsoRegex.MatchString(country) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid Country ISO2 Code format – it must be exactly 2 letters.")
+			return
+		}
+		filter.CountryISO2 = strings.ToUpper(country)
+	}
+
+	if bank := strings.TrimSpace(query.Get("bank")); bank != "" {
+		if len(bank) > 255 {
+			writeJSONError(w, http.StatusBadRequest, "Bank name must be at most 255 characters")
+			return
+		}
+		filter.BankKey = validation.SearchKey(bank)
+	}
+
+	if town := strings.TrimSpace(query.Get("town")); town != "" {
+		if len(town) > 100 || !validation.TownNameRegex.MatchString(town) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid town – it must be a town name of at most 100 characters.")
+			return
+		}
+		filter.Town = strings.ToUpper(town)
+	}
+
+	if value := query.Get("isHeadquarter"); value != "" {
+		isHeadquarter, err := strconv.ParseBool(value)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid isHeadquarter – it must be true or false.")
+			return
+		}
+		filter.IsHeadquarter = &isHeadquarter
+	}
+
+	if prefix := strings.TrimSpace(query.Get("prefix")); prefix != "" {
+		if !validation.SwiftPrefixRegex.MatchString(prefix) {
+			writeJSONError(w, http.StatusBadRequest, "Invalid prefix – it must be 1 to 11 letters or digits.")
+			return
+		}
+		filter.Prefix = strings.ToUpper(prefix)
+	}
+
+	var ok bool
+	if filter.IncludeDeleted, ok = includeDeletedParam(w, r); !ok {
+		return
+	}
+
+	if cursor := query.Get("cursor"); cursor != "" {
+		page.After, err = decodeCursor(cursor, 1)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	// one extra row tells whether there is a next page
+	page.Limit = limit + 1
+
+	list := models.SwiftCodeList{}
+	list.SwiftCodes, err = h.Store.ListSwiftCodes(filter, page)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	if len(list.SwiftCodes) > limit {
+		list.SwiftCodes = list.SwiftCodes[:limit]
+		next := encodeCursor(list.SwiftCodes[limit-1].SwiftCode)
+		list.Next = &next
+	}
+
+	respondWithJSON(w, http.StatusOK, list)
+}
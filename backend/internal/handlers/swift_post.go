@@ -2,12 +2,14 @@ package handlers
 
 import (
 	"encoding/json"
-	"fmt"
+	"errors"
 	"log"
 	"net/http"
 	"strings"
 
+	"backend/internal/middleware"
 	"backend/internal/models"
+	"backend/internal/store"
 	"backend/internal/validation"
 )
 
@@ -18,91 +20,97 @@ func (h *Handler) PostSwiftCodeHandler(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	decoder.DisallowUnknownFields()
 	if err := decoder.Decode(&body); err != nil {
-		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		writeDecodeError(w, err)
 		return
 	}
 
-	// removal of whitespace characters
+	if problem := prepareSwiftCodeBranch(&body); problem != nil {
+		writeProblem(w, *problem)
+		return
+	}
+
+	err := h.Store.CreateSwiftCode(body, middleware.RequestActor(r))
+	if errors.Is(err, store.ErrConflict) {
+		writeJSONError(w, http.StatusConflict, "SWIFT code already exists")
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to insert SWIFT code")
+		log.Printf("Insert error: %v", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusCreated, swiftCodeMessage("SWIFT code added successfully", body.SwiftCode))
+}
+
+// swiftCodeMessage returns the response of a successful write, with the participant type of a passive or
+// reverse billing SWIFT code.
+func swiftCodeMessage(message, swiftCode string) map[string]string {
+	response := map[string]string{"message": message}
+	if participantType := validation.ParticipantType(swiftCode); participantType != "" {
+		response["participantType"] = participantType
+	}
+	return response
+}
+
+// prepareSwiftCodeBranch normalizes and validates a request body. Names and addresses keep their
+// casing and are composed to Unicode NFC; codes are upper-cased and ASCII forms are filled in.
+// The headquarter flag must match the "XXX" branch suffix of the SWIFT code.
+// It returns a validation problem listing the rejected fields when the body is rejected.
+func prepareSwiftCodeBranch(body *models.SwiftCodeBranch) *models.Problem {
+	// removal of whitespace characters and unicode normalization
 	body.SwiftCode = strings.TrimSpace(body.SwiftCode)
-	body.BankName = strings.TrimSpace(body.BankName)
+	body.BankName = validation.NormalizeText(body.BankName)
 	body.CountryISO2 = strings.TrimSpace(body.CountryISO2)
-	body.CountryName = strings.TrimSpace(body.CountryName)
-	body.Address = strings.TrimSpace(body.Address)
+	body.CountryName = validation.NormalizeText(body.CountryName)
+	body.Address = validation.NormalizeText(body.Address)
+	body.TownName = validation.NormalizeText(body.TownName)
+	body.PostalCode = strings.TrimSpace(body.PostalCode)
+	body.Street = validation.NormalizeText(body.Street)
+	body.TimeZone = strings.TrimSpace(body.TimeZone)
+
+	// the free-text address is derived from the structured address when omitted
+	if body.Address == "" {
+		if derivedErrors := validation.ValidateDerivedAddress(*body); len(derivedErrors) > 0 {
+			problem := models.NewValidationProblem("Invalid fields: "+validation.FieldNames(derivedErrors), derivedErrors)
+			return &problem
+		}
+		body.Address = validation.DeriveAddress(*body)
+	}
 
 	// check of required fields
-	missingFields := validation.ValidateSwiftCodeFields(body)
+	missingFields := validation.ValidateSwiftCodeFields(*body)
 	if len(missingFields) > 0 {
-		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Missing required fields: %v", missingFields))
-		return
+		problem := models.NewValidationProblem("Missing required fields: "+validation.FieldNames(missingFields), missingFields)
+		return &problem
 	}
 
 	// input validation
-	validationErrors := validation.ValidateSwiftCodeBranch(body)
+	validationErrors := validation.ValidateSwiftCodeBranch(*body)
 	if len(validationErrors) > 0 {
-		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Validation errors: %v", validationErrors))
-		return
+		problem := models.NewValidationProblem("Invalid fields: "+validation.FieldNames(validationErrors), validationErrors)
+		return &problem
 	}
 
-	// conversion to uppercase
+	// conversion of codes to uppercase
 	body.SwiftCode = strings.ToUpper(body.SwiftCode)
-	body.BankName = strings.ToUpper(body.BankName)
 	body.CountryISO2 = strings.ToUpper(body.CountryISO2)
-	body.CountryName = strings.ToUpper(body.CountryName)
-	body.Address = strings.ToUpper(body.Address)
+	body.CountryName = validation.CanonicalCountryName(body.CountryISO2)
+	body.PostalCode = strings.ToUpper(body.PostalCode)
+
+	// SWIFT compatible forms of the names
+	body.BankNameASCII = validation.Transliterate(body.BankName)
+	body.AddressASCII = validation.Transliterate(body.Address)
 
 	isHeadquarter := strings.HasSuffix(body.SwiftCode, "XXX")
 	if isHeadquarter != *body.IsHeadquarter {
-		writeJSONError(w, http.StatusBadRequest, "Mismatch between SWIFT code format and headquarter status")
-		return
-	}
-
-	query := `
-WITH country_ins AS (
-    INSERT INTO countries (iso2_code, name)
-    VALUES ($1, $2)
-    ON CONFLICT (iso2_code) DO NOTHING
-    RETURNING id
-), country_sel AS (
-    SELECT id FROM countries WHERE iso2_code = $1
-    UNION ALL
-    SELECT id FROM country_ins LIMIT 1
-), bank_ins AS (
-    INSERT INTO banks (name, country_id)
-    SELECT $3, id FROM country_sel
-    ON CONFLICT (name, country_id) DO NOTHING
-    RETURNING id
-), bank_sel AS (
-    SELECT id FROM banks WHERE name = $3 AND country_id = (SELECT id FROM country_sel)
-    UNION ALL
-    SELECT id FROM bank_ins LIMIT 1
-), swift_ins AS (
-    INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address)
-    SELECT $4, (SELECT id FROM bank_sel), $5, $6
-    ON CONFLICT (swift_code) DO NOTHING
-    RETURNING swift_code
-)
-SELECT COUNT(*) FROM swift_ins;
-	`
-
-	var insertedCount int
-	err := h.DB.QueryRow(query,
-		body.CountryISO2,
-		body.CountryName,
-		body.BankName,
-		body.SwiftCode,
-		*body.IsHeadquarter,
-		body.Address,
-	).Scan(&insertedCount)
-	if err != nil {
-		writeJSONError(w, http.StatusInternalServerError, "Failed to insert SWIFT code")
-		log.Printf("Insert error: %v", err)
-		return
-	}
-
-	if insertedCount == 0 {
-		writeJSONError(w, http.StatusConflict, "SWIFT code already exists")
-		return
+		problem := models.NewValidationProblem("Mismatch between SWIFT code format and headquarter status", []models.FieldError{{
+			Field:   "isHeadquarter",
+			Code:    validation.CodeMismatch,
+			Message: "Headquarter status must be true exactly when the SWIFT code ends with XXX",
+		}})
+		return &problem
 	}
 
-	respondWithJSON(w, http.StatusCreated, map[string]string{"message": "SWIFT code added successfully"})
+	return nil
 }
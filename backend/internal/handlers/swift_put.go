@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
+)
+
+// PutSwiftCodeHandler handles PUT requests replacing an existing SWIFT code.
+func (h *Handler) PutSwiftCodeHandler(w http.ResponseWriter, r *http.Request) {
+	swiftCode, ok := swiftCodeFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var body models.SwiftCodeBranch
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&body); err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	body.SwiftCode = strings.ToUpper(strings.TrimSpace(body.SwiftCode))
+	if normalized, ok := validation.NormalizeSwiftCode(body.SwiftCode); ok {
+		body.SwiftCode = normalized
+	}
+	if body.SwiftCode == "" {
+		body.SwiftCode = swiftCode
+	}
+	if body.SwiftCode != swiftCode {
+		writeJSONError(w, http.StatusBadRequest, "SWIFT code in the body does not match the SWIFT code in the URL")
+		return
+	}
+
+	if body.IsHeadquarter != nil && strings.HasSuffix(swiftCode, "XXX") != *body.IsHeadquarter {
+		writeJSONError(w, http.StatusConflict, "Mismatch between SWIFT code format and headquarter status")
+		return
+	}
+
+	if problem := prepareSwiftCodeBranch(&body); problem != nil {
+		writeProblem(w, *problem)
+		return
+	}
+
+	h.updateSwiftCode(w, body, middleware.RequestActor(r))
+}
+
+// PatchSwiftCodeHandler handles PATCH requests updating the address, bank name or country name of a SWIFT code.
+// When the structured address changes and no address is given, the address is derived anew.
+func (h *Handler) PatchSwiftCodeHandler(w http.ResponseWriter, r *http.Request) {
+	swiftCode, ok := swiftCodeFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var patch models.SwiftCodePatch
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&patch); err != nil {
+		writeDecodeError(w, err)
+		return
+	}
+
+	structuredAddress := patch.TownName != nil || patch.PostalCode != nil || patch.Street != nil
+	if patch.Address == nil && !structuredAddress && patch.TimeZone == nil && patch.BankName == nil && patch.CountryName == nil {
+		writeJSONError(w, http.StatusBadRequest, "At least one of address, townName, postalCode, street, timeZone, bankName or countryName is required")
+		return
+	}
+
+	body, err := h.Store.GetSwiftCode(swiftCode, false)
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	if patch.TownName != nil {
+		body.TownName = *patch.TownName
+	}
+	if patch.PostalCode != nil {
+		body.PostalCode = *patch.PostalCode
+	}
+	if patch.Street != nil {
+		body.Street = *patch.Street
+	}
+	if patch.TimeZone != nil {
+		body.TimeZone = *patch.TimeZone
+	}
+	if patch.Address != nil {
+		body.Address = *patch.Address
+	} else if structuredAddress {
+		body.Address = ""
+	}
+	if patch.BankName != nil {
+		body.BankName = *patch.BankName
+	}
+	if patch.CountryName != nil {
+		body.CountryName = *patch.CountryName
+	}
+
+	if problem := prepareSwiftCodeBranch(&body); problem != nil {
+		writeProblem(w, *problem)
+		return
+	}
+
+	h.updateSwiftCode(w, body, middleware.RequestActor(r))
+}
+
+// swiftCodeFromPath extracts and validates the SWIFT code from the request URL.
+// An 8 character BIC is normalized to the 11 character code of the primary office.
+// It writes an error response and returns false when the code is missing or malformed.
+func swiftCodeFromPath(w http.ResponseWriter, r *http.Request) (string, bool) {
+	return parseSwiftCodeParam(w, strings.TrimPrefix(r.URL.Path, "/v1/swift-codes/"))
+}
+
+// parseSwiftCodeParam validates and normalizes a SWIFT code taken from a URL segment.
+func parseSwiftCodeParam(w http.ResponseWriter, swiftCode string) (string, bool) {
+	swiftCode = strings.TrimSpace(swiftCode)
+	if swiftCode == "" {
+		writeJSONError(w, http.StatusBadRequest, "SWIFT code is required")
+		return "", false
+	}
+
+	swiftCode, ok := validation.NormalizeSwiftCode(swiftCode)
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, "Invalid SWIFT code format – it must be 8 or 11 letters or digits.")
+		return "", false
+	}
+
+	return swiftCode, true
+}
+
+// updateSwiftCode stores a validated SWIFT code over the existing one.
+func (h *Handler) updateSwiftCode(w http.ResponseWriter, body models.SwiftCodeBranch, actor string) {
+	err := h.Store.UpdateSwiftCode(body, actor)
+	if errors.Is(err, store.ErrNotFound) {
+		handleDBError(w, err)
+		return
+	}
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to update SWIFT code")
+		log.Printf("Update error: %v", err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, swiftCodeMessage("SWIFT code updated successfully", body.SwiftCode))
+}
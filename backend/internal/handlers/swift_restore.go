@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"backend/internal/middleware"
+	"backend/internal/store"
+)
+
+// RestoreSwiftCodeHandler handles POST requests to /v1/swift-codes/{code}/restore undoing the deletion of a SWIFT code.
+func (h *Handler) RestoreSwiftCodeHandler(w http.ResponseWriter, r *http.Request, swiftCodeParam string) {
+	if r.Method != http.MethodPost {
+		writeJSONError(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	swiftCode, ok := parseSwiftCodeParam(w, swiftCodeParam)
+	if !ok {
+		return
+	}
+
+	err := h.Store.RestoreSwiftCode(swiftCode, middleware.RequestActor(r))
+	if errors.Is(err, store.ErrConflict) {
+		writeJSONError(w, http.StatusConflict, "SWIFT code is not deleted")
+		return
+	}
+	if err != nil {
+		handleDBError(w, err)
+		return
+	}
+
+	respondWithJSON(w, http.StatusOK, map[string]string{"message": "SWIFT code restored successfully", "swiftCode": swiftCode})
+}
@@ -1,16 +1,79 @@
 package handlers
 
 import (
-	"database/sql"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/auth"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+	"backend/internal/validation"
 )
 
+// writeJSONError returns an error message as an RFC 7807 problem of type about:blank.
 func writeJSONError(w http.ResponseWriter, status int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(map[string]string{"error": message})
+	writeProblem(w, models.NewProblem(status, message))
+}
+
+// writeProblem writes the problem as application/problem+json with its status.
+func writeProblem(w http.ResponseWriter, problem models.Problem) {
+	w.Header().Set("Content-Type", models.ProblemContentType)
+	w.WriteHeader(problem.Status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		log.Printf("Failed to encode problem: %v", err)
+	}
+}
+
+// writeValidationErrors returns a 400 problem listing the rejected fields.
+func writeValidationErrors(w http.ResponseWriter, fieldErrors []models.FieldError) {
+	writeProblem(w, models.NewValidationProblem("Invalid fields: "+validation.FieldNames(fieldErrors), fieldErrors))
+}
+
+// unknownFieldPrefix starts the error returned by a decoder with DisallowUnknownFields.
+const unknownFieldPrefix = "json: unknown field "
+
+// writeDecodeError returns a 400 problem for a request body that could not be decoded.
+// Values of the wrong type and unknown fields are reported as field errors.
+func writeDecodeError(w http.ResponseWriter, err error) {
+	detail := fmt.Sprintf("Invalid JSON: %v", err)
+
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		writeProblem(w, models.NewValidationProblem(detail, []models.FieldError{{
+			Field:   typeErr.Field,
+			Code:    validation.CodeType,
+			Message: fmt.Sprintf("Value must be of type %s", typeErr.Type),
+		}}))
+	case strings.HasPrefix(err.Error(), unknownFieldPrefix):
+		field, _ := strconv.Unquote(strings.TrimPrefix(err.Error(), unknownFieldPrefix))
+		writeProblem(w, models.NewValidationProblem(detail, []models.FieldError{{
+			Field:   field,
+			Code:    validation.CodeUnsupported,
+			Message: "Field is not supported",
+		}}))
+	default:
+		writeJSONError(w, http.StatusBadRequest, detail)
+	}
+}
+
+// authorize checks that the caller of a request has the required role, returning 403 otherwise.
+// Requests without an identity, which did not pass the authentication middleware, are denied.
+func authorize(w http.ResponseWriter, r *http.Request, required auth.Role) bool {
+	identity, ok := middleware.RequestIdentity(r)
+	if ok && identity.Role.Allows(required) {
+		return true
+	}
+	writeJSONError(w, http.StatusForbidden, fmt.Sprintf("Forbidden – the %s role is required.", required))
+	return false
 }
 
 func respondWithJSON(w http.ResponseWriter, status int, payload interface{}) {
@@ -22,10 +85,97 @@ func respondWithJSON(w http.ResponseWriter, status int, payload interface{}) {
 }
 
 func handleDBError(w http.ResponseWriter, err error) {
-	if err == sql.ErrNoRows {
+	if errors.Is(err, store.ErrNotFound) {
 		writeJSONError(w, http.StatusNotFound, "Resource not found")
 	} else {
 		writeJSONError(w, http.StatusInternalServerError, "Database query failed")
 		log.Printf("Database error: %v", err)
 	}
 }
+
+// parseLimit reads the "limit" query parameter, falling back to defaultLimit when it is absent.
+func parseLimit(r *http.Request, defaultLimit, maxLimit int) (int, error) {
+	value := r.URL.Query().Get("limit")
+	if value == "" {
+		return defaultLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 1 || limit > maxLimit {
+		return 0, fmt.Errorf("limit must be a number between 1 and %d", maxLimit)
+	}
+	return limit, nil
+}
+
+// parseIncludeDeleted reads the "includeDeleted" query parameter, false when it is absent.
+func parseIncludeDeleted(r *http.Request) (bool, error) {
+	value := r.URL.Query().Get("includeDeleted")
+	if value == "" {
+		return false, nil
+	}
+	includeDeleted, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.New("includeDeleted must be true or false")
+	}
+	return includeDeleted, nil
+}
+
+// includeDeletedParam reads the "includeDeleted" query parameter of a read, returning 400 when it is
+// invalid and 403 when it is true and the caller is not an admin.
+func includeDeletedParam(w http.ResponseWriter, r *http.Request) (includeDeleted bool, ok bool) {
+	includeDeleted, err := parseIncludeDeleted(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Invalid includeDeleted – %v.", err))
+		return false, false
+	}
+	if includeDeleted && !authorize(w, r, auth.RoleAdmin) {
+		return false, false
+	}
+	return includeDeleted, true
+}
+
+// parseTimeParam reads a query parameter holding an RFC 3339 time or a date, which stands for the
+// start of that day in UTC. The zero time is returned when it is absent.
+func parseTimeParam(r *http.Request, name string) (time.Time, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	day, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be a date such as 2024-05-31 or an RFC 3339 time", name)
+	}
+	return day, nil
+}
+
+// parseAsOf reads the "asOf" query parameter like parseTimeParam, except that a date stands for
+// the end of that day in UTC. The zero time is returned when it is absent.
+func parseAsOf(r *http.Request) (time.Time, error) {
+	asOf, err := parseTimeParam(r, "asOf")
+	if err == nil && len(r.URL.Query().Get("asOf")) == len(time.DateOnly) {
+		// timestamps are stored with microsecond precision
+		asOf = asOf.AddDate(0, 0, 1).Add(-time.Microsecond)
+	}
+	return asOf, err
+}
+
+// encodeCursor packs the sort key of the last returned row into an opaque pagination cursor.
+func encodeCursor(values ...string) string {
+	data, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor unpacks a cursor created by encodeCursor holding exactly n values.
+func decodeCursor(cursor string, n int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil || len(values) != n {
+		return nil, errors.New("invalid cursor")
+	}
+	return values, nil
+}
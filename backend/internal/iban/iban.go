@@ -0,0 +1,118 @@
+// Package iban validates International Bank Account Numbers (ISO 13616) and extracts
+// the national bank identifier used to resolve the BIC of the account holding bank.
+package iban
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidCharacters  = errors.New("IBAN must contain only letters and digits")
+	ErrUnsupportedCountry = errors.New("IBAN country code is not supported")
+	ErrInvalidStructure   = errors.New("IBAN account number does not match the format of its country")
+	ErrInvalidChecksum    = errors.New("IBAN check digits are invalid")
+)
+
+var (
+	ibanRegex    = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+	segmentRegex = regexp.MustCompile(`([0-9]+)([nac])`)
+)
+
+// bbanRegexes holds the compiled BBAN structure of every supported country.
+var bbanRegexes = func() map[string]*regexp.Regexp {
+	classes := map[string]string{"n": "[0-9]", "a": "[A-Z]", "c": "[A-Z0-9]"}
+
+	regexes := make(map[string]*regexp.Regexp, len(formats))
+	for country, f := range formats {
+		pattern, length := "^", 4
+		for _, segment := range segmentRegex.FindAllStringSubmatch(f.bban, -1) {
+			size, _ := strconv.Atoi(segment[1])
+			pattern += fmt.Sprintf("%s{%d}", classes[segment[2]], size)
+			length += size
+		}
+		if length != f.length {
+			panic(fmt.Sprintf("iban: format of %s has length %d, expected %d", country, length, f.length))
+		}
+		regexes[country] = regexp.MustCompile(pattern + "$")
+	}
+	return regexes
+}()
+
+// IBAN is a validated International Bank Account Number.
+type IBAN struct {
+	CountryISO2 string
+	CheckDigits string
+	BBAN        string // basic bank account number
+	BankCode    string // national bank identifier
+}
+
+// String returns the IBAN in electronic format, without spaces.
+func (i IBAN) String() string {
+	return i.CountryISO2 + i.CheckDigits + i.BBAN
+}
+
+// Printed returns the IBAN in paper format, in groups of four characters.
+func (i IBAN) Printed() string {
+	electronic := i.String()
+
+	var groups []string
+	for start := 0; start < len(electronic); start += 4 {
+		end := start + 4
+		if end > len(electronic) {
+			end = len(electronic)
+		}
+		groups = append(groups, electronic[start:end])
+	}
+	return strings.Join(groups, " ")
+}
+
+// Parse validates an IBAN given in electronic or paper format. It checks the
+// country specific length and BBAN structure and the mod-97 check digits.
+func Parse(value string) (IBAN, error) {
+	value = strings.ToUpper(strings.Join(strings.Fields(value), ""))
+	if !ibanRegex.MatchString(value) {
+		return IBAN{}, ErrInvalidCharacters
+	}
+
+	country := value[:2]
+	f, ok := formats[country]
+	if !ok {
+		return IBAN{}, ErrUnsupportedCountry
+	}
+	if len(value) != f.length {
+		return IBAN{}, fmt.Errorf("IBAN of %s must be %d characters long", country, f.length)
+	}
+
+	bban := value[4:]
+	if !bbanRegexes[country].MatchString(bban) {
+		return IBAN{}, ErrInvalidStructure
+	}
+	if mod97(bban+value[:4]) != 1 {
+		return IBAN{}, ErrInvalidChecksum
+	}
+
+	return IBAN{
+		CountryISO2: country,
+		CheckDigits: value[2:4],
+		BBAN:        bban,
+		BankCode:    bban[f.bankStart:f.bankEnd],
+	}, nil
+}
+
+// mod97 computes the remainder of the number formed by replacing every letter
+// with two digits (A = 10 ... Z = 35), as specified by ISO 7064.
+func mod97(value string) int {
+	remainder := 0
+	for _, r := range value {
+		if r >= 'A' && r <= 'Z' {
+			remainder = (remainder*100 + int(r-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(r-'0')) % 97
+		}
+	}
+	return remainder
+}
@@ -0,0 +1,91 @@
+package iban
+
+// format describes the IBAN of a single country as published in the SWIFT IBAN registry.
+// The BBAN structure uses the registry notation: n digits, a upper case letters,
+// c letters or digits. bankStart and bankEnd locate the national bank identifier in the BBAN.
+type format struct {
+	length    int
+	bban      string
+	bankStart int
+	bankEnd   int
+}
+
+var formats = map[string]format{
+	"AD": {24, "4n4n12c", 0, 4},
+	"AE": {23, "3n16n", 0, 3},
+	"AL": {28, "8n16c", 0, 8},
+	"AT": {20, "5n11n", 0, 5},
+	"AZ": {28, "4a20c", 0, 4},
+	"BA": {20, "3n3n8n2n", 0, 3},
+	"BE": {16, "3n7n2n", 0, 3},
+	"BG": {22, "4a4n2n8c", 0, 4},
+	"BH": {22, "4a14c", 0, 4},
+	"BR": {29, "8n5n10n1a1c", 0, 8},
+	"BY": {28, "4c4n16c", 0, 4},
+	"CH": {21, "5n12c", 0, 5},
+	"CR": {22, "4n14n", 0, 4},
+	"CY": {28, "3n5n16c", 0, 3},
+	"CZ": {24, "4n6n10n", 0, 4},
+	"DE": {22, "8n10n", 0, 8},
+	"DK": {18, "4n9n1n", 0, 4},
+	"DO": {28, "4c20n", 0, 4},
+	"EE": {20, "2n2n11n1n", 0, 2},
+	"EG": {29, "4n4n17n", 0, 4},
+	"ES": {24, "4n4n1n1n10n", 0, 4},
+	"FI": {18, "3n11n", 0, 3},
+	"FO": {18, "4n9n1n", 0, 4},
+	"FR": {27, "5n5n11c2n", 0, 5},
+	"GB": {22, "4a6n8n", 0, 4},
+	"GE": {22, "2a16n", 0, 2},
+	"GI": {23, "4a15c", 0, 4},
+	"GL": {18, "4n9n1n", 0, 4},
+	"GR": {27, "3n4n16c", 0, 3},
+	"GT": {28, "4c20c", 0, 4},
+	"HR": {21, "7n10n", 0, 7},
+	"HU": {28, "3n4n1n15n1n", 0, 3},
+	"IE": {22, "4a6n8n", 0, 4},
+	"IL": {23, "3n3n13n", 0, 3},
+	"IQ": {23, "4a3n12n", 0, 4},
+	"IS": {26, "4n2n6n10n", 0, 4},
+	"IT": {27, "1a5n5n12c", 1, 6},
+	"JO": {30, "4a4n18c", 0, 4},
+	"KW": {30, "4a22c", 0, 4},
+	"KZ": {20, "3n13c", 0, 3},
+	"LB": {28, "4n20c", 0, 4},
+	"LC": {32, "4a24c", 0, 4},
+	"LI": {21, "5n12c", 0, 5},
+	"LT": {20, "5n11n", 0, 5},
+	"LU": {20, "3n13c", 0, 3},
+	"LV": {21, "4a13c", 0, 4},
+	"MC": {27, "5n5n11c2n", 0, 5},
+	"MD": {24, "2c18c", 0, 2},
+	"ME": {22, "3n13n2n", 0, 3},
+	"MK": {19, "3n10c2n", 0, 3},
+	"MR": {27, "5n5n11n2n", 0, 5},
+	"MT": {31, "4a5n18c", 0, 4},
+	"MU": {30, "4a2n2n12n3n3a", 0, 6},
+	"NL": {18, "4a10n", 0, 4},
+	"NO": {15, "4n6n1n", 0, 4},
+	"PK": {24, "4a16c", 0, 4},
+	"PL": {28, "8n16n", 0, 8},
+	"PS": {29, "4a21c", 0, 4},
+	"PT": {25, "4n4n11n2n", 0, 4},
+	"QA": {29, "4a21c", 0, 4},
+	"RO": {24, "4a16c", 0, 4},
+	"RS": {22, "3n13n2n", 0, 3},
+	"SA": {24, "2n18c", 0, 2},
+	"SC": {31, "4a2n2n16n3a", 0, 6},
+	"SE": {24, "3n16n1n", 0, 3},
+	"SI": {19, "5n8n2n", 0, 5},
+	"SK": {24, "4n6n10n", 0, 4},
+	"SM": {27, "1a5n5n12c", 1, 6},
+	"ST": {25, "8n11n2n", 0, 4},
+	"SV": {28, "4a20n", 0, 4},
+	"TL": {23, "3n14n2n", 0, 3},
+	"TN": {24, "2n3n13n2n", 0, 2},
+	"TR": {26, "5n1n16c", 0, 5},
+	"UA": {29, "6n19c", 0, 6},
+	"VA": {22, "3n15n", 0, 3},
+	"VG": {24, "4a16n", 0, 4},
+	"XK": {20, "4n10n2n", 0, 4},
+}
@@ -0,0 +1,141 @@
+package importer
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"backend/internal/validation"
+)
+
+// ColumnBankCode is the header of the national bank identifier in a mapping export.
+const ColumnBankCode = "NATIONAL BANK CODE"
+
+// bankCodeColumns lists the headers that must be present in a mapping export.
+var bankCodeColumns = []string{
+	ColumnCountryISO2,
+	ColumnBankCode,
+	ColumnSwiftCode,
+}
+
+var bankCodeRegex = regexp.MustCompile(`^[A-Za-z0-9]{1,20}$`)
+
+// BankCodeRow maps a national bank identifier, as found in IBANs, onto a SWIFT code.
+type BankCodeRow struct {
+	Line        int
+	CountryISO2 string
+	BankCode    string
+	SwiftCode   string
+}
+
+// ParseBankCodes reads a national bank code mapping export and returns its rows in file order.
+// Rows with malformed codes are returned as rejected results.
+func ParseBankCodes(r io.Reader, delimiter rune) ([]BankCodeRow, []Result, error) {
+	reader, columns, err := newReader(r, delimiter, bankCodeColumns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []BankCodeRow
+	var rejected []Result
+	for {
+		record, line, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		field := columns.field(record)
+
+		row := BankCodeRow{
+			Line:        line,
+			CountryISO2: strings.ToUpper(field(ColumnCountryISO2)),
+			BankCode:    strings.ToUpper(field(ColumnBankCode)),
+			SwiftCode:   field(ColumnSwiftCode),
+		}
+
+		if reason := validateBankCodeRow(&row); reason != "" {
+			rejected = append(rejected, Result{Line: line, SwiftCode: row.SwiftCode, Status: StatusRejected, Reason: reason})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rejected, nil
+}
+
+// validateBankCodeRow checks the codes of a mapping row and normalizes its SWIFT code.
+func validateBankCodeRow(row *BankCodeRow) string {
+	if !validation.IsCountryCode(row.CountryISO2) {
+		return "Country ISO2 must be an ISO 3166 country code"
+	}
+	if !bankCodeRegex.MatchString(row.BankCode) {
+		return "National bank code must be 1 to 20 letters or digits"
+	}
+	swiftCode, ok := validation.NormalizeSwiftCode(row.SwiftCode)
+	if !ok {
+		return "SWIFT code must be 8 or 11 letters or digits"
+	}
+	row.SwiftCode = swiftCode
+	return ""
+}
+
+// upsertBankCodeQuery inserts or updates a mapping onto an existing SWIFT code. It returns
+// whether the SWIFT code is known and, when the mapping changed, whether it was inserted.
+const upsertBankCodeQuery = `
+WITH swift_sel AS (
+    SELECT swift_code FROM swift_codes WHERE swift_code = $3 AND deleted_at IS NULL
+), bank_code_ups AS (
+    INSERT INTO national_bank_codes (country_iso2, bank_code, swift_code)
+    SELECT $1, $2, swift_code FROM swift_sel
+    ON CONFLICT (country_iso2, bank_code) DO UPDATE SET swift_code = EXCLUDED.swift_code
+    WHERE national_bank_codes.swift_code IS DISTINCT FROM EXCLUDED.swift_code
+    RETURNING (xmax = 0) AS inserted
+)
+SELECT EXISTS (SELECT 1 FROM swift_sel), (SELECT inserted FROM bank_code_ups);
+`
+
+// ImportBankCodes upserts the mapping rows in transactions of at most batchSize rows.
+// Rows referring to SWIFT codes that are not stored are rejected.
+func ImportBankCodes(db *sql.DB, rows []BankCodeRow, batchSize int) ([]Result, error) {
+	seen := make(map[string]int, len(rows))
+	return inBatches(db, len(rows), batchSize, func(tx *sql.Tx, start, end int) ([]Result, error) {
+		results := make([]Result, 0, end-start)
+		for _, row := range rows[start:end] {
+			result := Result{Line: row.Line, SwiftCode: row.SwiftCode}
+
+			key := row.CountryISO2 + "/" + row.BankCode
+			if firstLine, duplicate := seen[key]; duplicate {
+				result.Status = StatusSkipped
+				result.Reason = fmt.Sprintf("duplicate of line %d", firstLine)
+				results = append(results, result)
+				continue
+			}
+			seen[key] = row.Line
+
+			var known bool
+			var inserted sql.NullBool
+			err := tx.QueryRow(upsertBankCodeQuery, row.CountryISO2, row.BankCode, row.SwiftCode).Scan(&known, &inserted)
+
+			switch {
+			case err != nil:
+				return nil, fmt.Errorf("line %d: failed to upsert bank code %s: %v", row.Line, row.BankCode, err)
+			case !known:
+				result.Status = StatusRejected
+				result.Reason = "unknown SWIFT code"
+			case !inserted.Valid:
+				result.Status = StatusSkipped
+				result.Reason = "already up to date"
+			case inserted.Bool:
+				result.Status = StatusInserted
+			default:
+				result.Status = StatusUpdated
+			}
+			results = append(results, result)
+		}
+		return results, nil
+	})
+}
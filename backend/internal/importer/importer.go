@@ -0,0 +1,416 @@
+package importer
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"backend/internal/models"
+	"backend/internal/validation"
+)
+
+// Column headers of the official SWIFT code spreadsheet export.
+const (
+	ColumnCountryISO2 = "COUNTRY ISO2 CODE"
+	ColumnSwiftCode   = "SWIFT CODE"
+	ColumnCodeType    = "CODE TYPE"
+	ColumnName        = "NAME"
+	ColumnAddress     = "ADDRESS"
+	ColumnTownName    = "TOWN NAME"
+	ColumnCountryName = "COUNTRY NAME"
+	ColumnTimeZone    = "TIME ZONE"
+)
+
+// requiredColumns lists the headers that must be present in every export.
+var requiredColumns = []string{
+	ColumnCountryISO2,
+	ColumnSwiftCode,
+	ColumnName,
+	ColumnAddress,
+	ColumnCountryName,
+}
+
+// Status describes what happened to a single spreadsheet row.
+type Status string
+
+const (
+	StatusInserted Status = "inserted"
+	StatusUpdated  Status = "updated"
+	StatusSkipped  Status = "skipped"
+	StatusRejected Status = "rejected"
+)
+
+// Row is a single parsed spreadsheet row.
+type Row struct {
+	Line     int
+	Branch   models.SwiftCodeBranch
+	CodeType string
+}
+
+// Result is the outcome of importing a single row.
+type Result struct {
+	Line      int
+	SwiftCode string
+	Status    Status
+	Reason    string
+}
+
+// Summary counts results by status.
+type Summary struct {
+	Inserted int
+	Updated  int
+	Skipped  int
+	Rejected int
+}
+
+// Summarize counts the results by status.
+func Summarize(results []Result) Summary {
+	var summary Summary
+	for _, result := range results {
+		switch result.Status {
+		case StatusInserted:
+			summary.Inserted++
+		case StatusUpdated:
+			summary.Updated++
+		case StatusSkipped:
+			summary.Skipped++
+		case StatusRejected:
+			summary.Rejected++
+		}
+	}
+	return summary
+}
+
+// DetectDelimiter peeks at the header line and returns a tab for TSV exports
+// and a comma otherwise.
+func DetectDelimiter(r *bufio.Reader) rune {
+	header, _ := r.Peek(4096)
+	line := string(header)
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+	if strings.Count(line, "\t") > strings.Count(line, ",") {
+		return '\t'
+	}
+	return ','
+}
+
+// Parse reads a spreadsheet export and returns its rows in file order.
+// Rows that cannot be mapped onto a SWIFT code are returned as rejected results.
+func Parse(r io.Reader, delimiter rune) ([]Row, []Result, error) {
+	reader, columns, err := newReader(r, delimiter, requiredColumns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rows []Row
+	var rejected []Result
+	for {
+		record, line, err := readRecord(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		field := columns.field(record)
+
+		row := Row{
+			Line:     line,
+			CodeType: field(ColumnCodeType),
+		}
+		row.Branch = models.SwiftCodeBranch{
+			Address:     validation.NormalizeText(field(ColumnAddress)),
+			BankName:    validation.NormalizeText(field(ColumnName)),
+			CountryISO2: field(ColumnCountryISO2),
+			CountryName: validation.NormalizeText(field(ColumnCountryName)),
+			SwiftCode:   field(ColumnSwiftCode),
+			TownName:    validation.NormalizeText(field(ColumnTownName)),
+			TimeZone:    field(ColumnTimeZone),
+		}
+
+		if reason := prepareBranch(&row.Branch); reason != "" {
+			rejected = append(rejected, Result{Line: line, SwiftCode: row.Branch.SwiftCode, Status: StatusRejected, Reason: reason})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rejected, nil
+}
+
+// ParseJSON reads a JSON array of SWIFT codes in the request format of POST /v1/swift-codes.
+// Items are numbered from 1 in the Line of their rows; isHeadquarter may be omitted and is then
+// derived from the SWIFT code. Items that fail validation are returned as rejected results.
+func ParseJSON(r io.Reader) ([]Row, []Result, error) {
+	var branches []models.SwiftCodeBranch
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&branches); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse JSON: %v", err)
+	}
+
+	var rows []Row
+	var rejected []Result
+	for i, branch := range branches {
+		branch.SwiftCode = strings.TrimSpace(branch.SwiftCode)
+		branch.BankName = validation.NormalizeText(branch.BankName)
+		branch.CountryISO2 = strings.TrimSpace(branch.CountryISO2)
+		branch.CountryName = validation.NormalizeText(branch.CountryName)
+		branch.Address = validation.NormalizeText(branch.Address)
+		branch.TownName = validation.NormalizeText(branch.TownName)
+		branch.PostalCode = strings.ToUpper(strings.TrimSpace(branch.PostalCode))
+		branch.Street = validation.NormalizeText(branch.Street)
+		branch.TimeZone = strings.TrimSpace(branch.TimeZone)
+
+		if reason := prepareBranch(&branch); reason != "" {
+			rejected = append(rejected, Result{Line: i + 1, SwiftCode: branch.SwiftCode, Status: StatusRejected, Reason: reason})
+			continue
+		}
+		rows = append(rows, Row{Line: i + 1, Branch: branch})
+	}
+
+	return rows, rejected, nil
+}
+
+// prepareBranch fills in the derived fields of a normalized SWIFT code and validates it.
+// It returns the reason for rejecting the SWIFT code, or an empty string.
+func prepareBranch(branch *models.SwiftCodeBranch) string {
+	// the export leaves the address blank for some institutions
+	if branch.Address == "" {
+		if derivedErrors := validation.ValidateDerivedAddress(*branch); len(derivedErrors) > 0 {
+			return "Validation errors: " + joinMessages(derivedErrors)
+		}
+		branch.Address = validation.DeriveAddress(*branch)
+	}
+
+	isHeadquarter := strings.HasSuffix(strings.ToUpper(branch.SwiftCode), "XXX")
+	if branch.IsHeadquarter == nil {
+		branch.IsHeadquarter = &isHeadquarter
+	} else if *branch.IsHeadquarter != isHeadquarter {
+		return "Headquarter status must be true exactly when the SWIFT code ends with XXX"
+	}
+
+	if reason := validateRow(*branch); reason != "" {
+		return reason
+	}
+
+	branch.SwiftCode = strings.ToUpper(branch.SwiftCode)
+	branch.CountryISO2 = strings.ToUpper(branch.CountryISO2)
+	branch.CountryName = validation.CanonicalCountryName(branch.CountryISO2)
+	branch.BankNameASCII = validation.Transliterate(branch.BankName)
+	branch.AddressASCII = validation.Transliterate(branch.Address)
+	return ""
+}
+
+// columnIndex maps upper case column headers to their position in a record.
+type columnIndex map[string]int
+
+// field returns a function reading trimmed values of the record by column header.
+func (c columnIndex) field(record []string) func(name string) string {
+	return func(name string) string {
+		i, ok := c[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+}
+
+// newReader reads the header of a spreadsheet export and checks the required columns.
+func newReader(r io.Reader, delimiter rune, required []string) (*csv.Reader, columnIndex, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read header: %v", err)
+	}
+
+	columns := make(columnIndex, len(header))
+	for i, name := range header {
+		name = strings.ToUpper(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		columns[name] = i
+	}
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing required column %q", name)
+		}
+	}
+
+	return reader, columns, nil
+}
+
+// readRecord returns the next non-blank record and the line it starts on.
+func readRecord(reader *csv.Reader) ([]string, int, error) {
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil, 0, err
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse row: %v", err)
+		}
+		if strings.Join(record, "") != "" {
+			line, _ := reader.FieldPos(0)
+			return record, line, nil
+		}
+	}
+}
+
+// validateRow applies the same checks as the POST endpoint.
+func validateRow(branch models.SwiftCodeBranch) string {
+	if missingFields := validation.ValidateSwiftCodeFields(branch); len(missingFields) > 0 {
+		return "Missing required fields: " + joinMessages(missingFields)
+	}
+	if validationErrors := validation.ValidateSwiftCodeBranch(branch); len(validationErrors) > 0 {
+		return "Validation errors: " + joinMessages(validationErrors)
+	}
+	return ""
+}
+
+// joinMessages joins the messages of field errors into a single rejection reason.
+func joinMessages(fieldErrors []models.FieldError) string {
+	messages := make([]string, len(fieldErrors))
+	for i, e := range fieldErrors {
+		messages[i] = e.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// upsertQuery inserts the country and bank when missing and inserts or updates
+// the SWIFT code, restoring it when deleted. No row is returned when the stored code is already up to date.
+const upsertQuery = `
+WITH country_ins AS (
+    INSERT INTO countries (iso2_code, name)
+    VALUES ($1, $2)
+    ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+    WHERE countries.name IS DISTINCT FROM EXCLUDED.name
+    RETURNING id
+), country_sel AS (
+    SELECT id FROM countries WHERE iso2_code = $1
+    UNION ALL
+    SELECT id FROM country_ins LIMIT 1
+), bank_ins AS (
+    INSERT INTO banks (name, name_key, name_ascii, country_id)
+    SELECT $3, $4, $5, id FROM country_sel
+    ON CONFLICT (name_key, country_id) DO NOTHING
+    RETURNING id
+), bank_sel AS (
+    SELECT id FROM banks WHERE name_key = $4 AND country_id = (SELECT id FROM country_sel)
+    UNION ALL
+    SELECT id FROM bank_ins LIMIT 1
+), swift_ups AS (
+    INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii, town_name, time_zone)
+    SELECT $6, (SELECT id FROM bank_sel), $7, $8, $9, $10, $11
+    ON CONFLICT (swift_code) DO UPDATE
+    SET bank_id = EXCLUDED.bank_id, is_headquarter = EXCLUDED.is_headquarter, address = EXCLUDED.address,
+        address_ascii = EXCLUDED.address_ascii, town_name = EXCLUDED.town_name, time_zone = EXCLUDED.time_zone, deleted_at = NULL
+    WHERE (swift_codes.bank_id, swift_codes.is_headquarter, swift_codes.address, swift_codes.town_name, swift_codes.time_zone, swift_codes.deleted_at)
+        IS DISTINCT FROM (EXCLUDED.bank_id, EXCLUDED.is_headquarter, EXCLUDED.address, EXCLUDED.town_name, EXCLUDED.time_zone, NULL)
+    RETURNING (xmax = 0) AS inserted
+)
+SELECT inserted FROM swift_ups;
+`
+
+// Import upserts the rows in transactions of at most batchSize rows.
+// A database error rolls back the current batch and stops the import;
+// results of the batches committed so far are returned with the error.
+func Import(db *sql.DB, rows []Row, batchSize int) ([]Result, error) {
+	seen := make(map[string]int, len(rows))
+	return inBatches(db, len(rows), batchSize, func(tx *sql.Tx, start, end int) ([]Result, error) {
+		return importBatch(tx, rows[start:end], seen)
+	})
+}
+
+// Actor is recorded in the history for the changes made by imports.
+const Actor = "importer"
+
+// inBatches calls importBatch for consecutive ranges of at most batchSize rows,
+// each in its own transaction, and collects the results of the committed batches.
+func inBatches(db *sql.DB, count, batchSize int, importBatch func(tx *sql.Tx, start, end int) ([]Result, error)) ([]Result, error) {
+	if batchSize <= 0 {
+		batchSize = count
+	}
+
+	results := make([]Result, 0, count)
+	for start := 0; start < count; start += batchSize {
+		end := start + batchSize
+		if end > count {
+			end = count
+		}
+
+		tx, err := db.Begin()
+		if err != nil {
+			return results, fmt.Errorf("failed to begin transaction: %v", err)
+		}
+		if _, err := tx.Exec(`SELECT set_config('swift.actor', $1, true)`, Actor); err != nil {
+			tx.Rollback()
+			return results, err
+		}
+
+		batchResults, err := importBatch(tx, start, end)
+		if err != nil {
+			tx.Rollback()
+			return results, err
+		}
+		if err := tx.Commit(); err != nil {
+			return results, fmt.Errorf("failed to commit batch: %v", err)
+		}
+		results = append(results, batchResults...)
+	}
+
+	return results, nil
+}
+
+func importBatch(tx *sql.Tx, rows []Row, seen map[string]int) ([]Result, error) {
+	results := make([]Result, 0, len(rows))
+	for _, row := range rows {
+		result := Result{Line: row.Line, SwiftCode: row.Branch.SwiftCode}
+
+		if firstLine, duplicate := seen[row.Branch.SwiftCode]; duplicate {
+			result.Status = StatusSkipped
+			result.Reason = fmt.Sprintf("duplicate of line %d", firstLine)
+			results = append(results, result)
+			continue
+		}
+
+		seen[row.Branch.SwiftCode] = row.Line
+
+		var inserted bool
+		err := tx.QueryRow(upsertQuery,
+			row.Branch.CountryISO2,
+			row.Branch.CountryName,
+			row.Branch.BankName,
+			validation.SearchKey(row.Branch.BankName),
+			row.Branch.BankNameASCII,
+			row.Branch.SwiftCode,
+			*row.Branch.IsHeadquarter,
+			row.Branch.Address,
+			row.Branch.AddressASCII,
+			row.Branch.TownName,
+			row.Branch.TimeZone,
+		).Scan(&inserted)
+
+		switch {
+		case err == sql.ErrNoRows:
+			result.Status = StatusSkipped
+			result.Reason = "already up to date"
+		case err != nil:
+			return nil, fmt.Errorf("line %d: failed to upsert SWIFT code %s: %v", row.Line, row.Branch.SwiftCode, err)
+		case inserted:
+			result.Status = StatusInserted
+		default:
+			result.Status = StatusUpdated
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"backend/internal/audit"
+	"backend/internal/auth"
+	"backend/internal/models"
+)
+
+// maxAuditedBody is the size of an error response read for its problem detail and field errors.
+const maxAuditedBody = 64 * 1024
+
+// auditRecorder captures the status and the error body of a response.
+type auditRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (a *auditRecorder) WriteHeader(status int) {
+	a.status = status
+	a.ResponseWriter.WriteHeader(status)
+}
+
+func (a *auditRecorder) Write(b []byte) (int, error) {
+	if a.status >= http.StatusBadRequest && a.body.Len() < maxAuditedBody {
+		a.body.Write(b)
+	}
+	return a.ResponseWriter.Write(b)
+}
+
+// SetAuditProblem reports the detail and field errors of a request for the audit log when its response
+// is not a problem, such as the result list of a batch. It does nothing outside AuditMiddleware.
+func SetAuditProblem(r *http.Request, problem models.Problem) {
+	if reported, ok := r.Context().Value(auditProblemKey).(*models.Problem); ok {
+		*reported = problem
+	}
+}
+
+// shareIdentity passes the identity authenticated by AuthMiddleware to an enclosing AuditMiddleware.
+func shareIdentity(r *http.Request, identity auth.Identity) {
+	if shared, ok := r.Context().Value(auditIdentityKey).(*auth.Identity); ok {
+		*shared = identity
+	}
+}
+
+// AuditMiddleware records POST, PUT, PATCH and DELETE requests in the audit log with the caller,
+// its IP address, the request ID and the response status. For rejected requests the problem
+// detail and field errors are recorded as well, as reported with SetAuditProblem or else read
+// from the problem response. GET, HEAD and OPTIONS requests are not recorded. It runs outside
+// AuthMiddleware so that rejected credentials are recorded too; the caller is the identity
+// authenticated inside it, otherwise the actor of the request. The X-Actor header is recorded apart as the
+// claimed actor.
+func AuditMiddleware(sink audit.Sink) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			entry := models.AuditEntry{
+				Time:         time.Now(),
+				ClaimedActor: ClaimedActor(r),
+				IP:           clientIP(r),
+				RequestID:    RequestID(r),
+				Method:       r.Method,
+				Path:         r.URL.Path,
+			}
+
+			recorder := &auditRecorder{ResponseWriter: w, status: http.StatusOK}
+			reported := &models.Problem{}
+			identity := &auth.Identity{}
+			ctx := context.WithValue(r.Context(), auditProblemKey, reported)
+			next.ServeHTTP(recorder, r.WithContext(context.WithValue(ctx, auditIdentityKey, identity)))
+
+			entry.Actor = RequestActor(r)
+			if identity.Name != "" {
+				entry.Actor = identity.Name
+			}
+			entry.Status = recorder.status
+			if reported.Detail != "" || len(reported.Errors) > 0 {
+				entry.Detail = reported.Detail
+				entry.Errors = reported.Errors
+			} else if recorder.body.Len() > 0 {
+				var problem models.Problem
+				if json.Unmarshal(recorder.body.Bytes(), &problem) == nil {
+					entry.Detail = problem.Detail
+					entry.Errors = problem.Errors
+				}
+			}
+			if err := sink.Record(entry); err != nil {
+				log.Printf("Audit error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"backend/internal/auth"
+)
+
+// APIKeyHeader carries the API key of a caller; it can also be sent as "Authorization: ApiKey <key>".
+const APIKeyHeader = "X-API-Key"
+
+// credentials returns the API key or the bearer token sent with a request; both are empty without credentials.
+func credentials(r *http.Request) (key, token string) {
+	if key := strings.TrimSpace(r.Header.Get(APIKeyHeader)); key != "" {
+		return key, ""
+	}
+	scheme, value, found := strings.Cut(strings.TrimSpace(r.Header.Get("Authorization")), " ")
+	if !found {
+		return "", ""
+	}
+	switch {
+	case strings.EqualFold(scheme, "ApiKey"):
+		return strings.TrimSpace(value), ""
+	case strings.EqualFold(scheme, "Bearer"):
+		return "", strings.TrimSpace(value)
+	}
+	return "", ""
+}
+
+// isRead reports whether a request only reads data.
+func isRead(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// writeUnauthorized rejects a request lacking valid credentials, naming the accepted schemes.
+func writeUnauthorized(w http.ResponseWriter, tokens *auth.TokenVerifier, message string) {
+	w.Header().Add("WWW-Authenticate", `ApiKey realm="swift-codes"`)
+	if tokens != nil {
+		w.Header().Add("WWW-Authenticate", `Bearer realm="swift-codes"`)
+	}
+	writeJSONError(w, http.StatusUnauthorized, message)
+}
+
+// AuthMiddleware authenticates callers by API key or, when tokens is set, by JWT bearer token. Write requests
+// need credentials, and reads as well unless anonymousReads is set; credentials sent with an anonymous read
+// are still checked. Every request let through carries an identity whose role is checked by the handlers:
+// anonymous reads get the reader role, as do callers granted no role while anonymous reads are allowed. The
+// name of an authenticated caller is the actor of the request.
+func AuthMiddleware(keys auth.KeyStore, tokens *auth.TokenVerifier, anonymousReads bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var identity auth.Identity
+			switch key, token := credentials(r); {
+			case key != "":
+				record, err := keys.Authenticate(key)
+				if errors.Is(err, auth.ErrInvalidKey) {
+					writeUnauthorized(w, tokens, "Invalid API key")
+					return
+				}
+				if err != nil {
+					log.Printf("Auth error: %v", err)
+					writeJSONError(w, http.StatusInternalServerError, "Internal server error")
+					return
+				}
+				role, _ := auth.ParseRole(record.Role)
+				identity = auth.Identity{Name: record.Name, Role: role}
+			case token != "":
+				if tokens == nil {
+					writeUnauthorized(w, tokens, "Bearer tokens are not accepted")
+					return
+				}
+				var err error
+				if identity, err = tokens.Verify(token); err != nil {
+					log.Printf("Rejected bearer token: %v", err)
+					writeUnauthorized(w, tokens, "Invalid bearer token")
+					return
+				}
+			case anonymousReads && isRead(r):
+				next.ServeHTTP(w, WithIdentity(r, auth.Identity{Role: auth.RoleReader}))
+				return
+			default:
+				writeUnauthorized(w, tokens, "Authentication required")
+				return
+			}
+
+			// credentials never allow less than no credentials
+			if anonymousReads && identity.Role == auth.RoleNone {
+				identity.Role = auth.RoleReader
+			}
+			shareIdentity(r, identity)
+			next.ServeHTTP(w, WithIdentity(r, identity))
+		})
+	}
+}
+
+// NoAuthMiddleware gives every request an unnamed identity with the admin role without authenticating it,
+// for servers running with authentication turned off. The actor of every request is AnonymousActor.
+func NoAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, WithIdentity(r, auth.Identity{Role: auth.RoleAdmin}))
+	})
+}
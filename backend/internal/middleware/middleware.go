@@ -6,6 +6,8 @@ import (
 	"sync"
 	"time"
 
+	"backend/internal/models"
+
 	"golang.org/x/time/rate"
 )
 
@@ -50,9 +52,9 @@ func RateLimitMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// writeJSONError returns an error message in JSON format.
+// writeJSONError returns an error message as an RFC 7807 problem of type about:blank.
 func writeJSONError(w http.ResponseWriter, status int, message string) {
-	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Type", models.ProblemContentType)
 	w.WriteHeader(status)
-	json.NewEncoder(w).Encode(map[string]string{"error": message})
+	json.NewEncoder(w).Encode(models.NewProblem(status, message))
 }
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"backend/internal/auth"
+)
+
+// RequestIDHeader carries the ID of a request in both directions.
+const RequestIDHeader = "X-Request-ID"
+
+// AnonymousActor is the actor of requests whose caller does not identify itself.
+const AnonymousActor = "anonymous"
+
+// maxActorLength is the length of the actor columns of the history and the audit log.
+const maxActorLength = 255
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota
+	identityKey
+	auditProblemKey
+	auditIdentityKey
+)
+
+var requestIDRegex = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestIDMiddleware assigns an ID to every request, reusing a well-formed X-Request-ID header
+// of the caller, and returns it in the X-Request-ID response header.
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !requestIDRegex.MatchString(id) {
+			var b [16]byte
+			rand.Read(b[:])
+			id = hex.EncodeToString(b[:])
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey, id)))
+	})
+}
+
+// RequestID returns the ID assigned by RequestIDMiddleware, or the X-Request-ID header when it did not run.
+func RequestID(r *http.Request) string {
+	if id, ok := r.Context().Value(requestIDKey).(string); ok {
+		return id
+	}
+	return r.Header.Get(RequestIDHeader)
+}
+
+// WithIdentity returns the request carrying the identity of its caller, as set by AuthMiddleware.
+func WithIdentity(r *http.Request, identity auth.Identity) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), identityKey, identity))
+}
+
+// RequestIdentity returns the identity of the caller set by AuthMiddleware, if any.
+func RequestIdentity(r *http.Request) (auth.Identity, bool) {
+	identity, ok := r.Context().Value(identityKey).(auth.Identity)
+	return identity, ok
+}
+
+// RequestActor returns the caller recorded for a request: the name of its API key or the subject of its
+// bearer token, otherwise AnonymousActor.
+func RequestActor(r *http.Request) string {
+	if identity, ok := RequestIdentity(r); ok && identity.Name != "" {
+		return identity.Name
+	}
+	return AnonymousActor
+}
+
+// ClaimedActor returns the X-Actor header of a request cut to maxActorLength characters. The caller
+// names itself with it without proof, so it is only recorded next to the actor in the audit log.
+func ClaimedActor(r *http.Request) string {
+	actor := []rune(strings.TrimSpace(r.Header.Get("X-Actor")))
+	if len(actor) > maxActorLength {
+		actor = actor[:maxActorLength]
+	}
+	return string(actor)
+}
+
+// clientIP returns the IP address of the caller without the port.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
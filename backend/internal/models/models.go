@@ -1,34 +1,213 @@
 package models
 
+import "time"
+
 type SwiftCodeDetails struct {
-	Address       string `json:"address"`
-	BankName      string `json:"bankName"`
-	CountryISO2   string `json:"countryISO2"`
-	IsHeadquarter bool   `json:"isHeadquarter"`
-	SwiftCode     string `json:"swiftCode"`
+	Address       string     `json:"address"`
+	AddressASCII  string     `json:"addressAscii,omitempty"`
+	TownName      string     `json:"townName,omitempty"`
+	PostalCode    string     `json:"postalCode,omitempty"`
+	Street        string     `json:"street,omitempty"`
+	TimeZone      string     `json:"timeZone,omitempty"`
+	BankName      string     `json:"bankName"`
+	BankNameASCII string     `json:"bankNameAscii,omitempty"`
+	CountryISO2   string     `json:"countryISO2"`
+	IsHeadquarter bool       `json:"isHeadquarter"`
+	SwiftCode     string     `json:"swiftCode"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
 }
 
 type SwiftCodeHeadquarter struct {
 	Address       string             `json:"address"`
+	AddressASCII  string             `json:"addressAscii,omitempty"`
+	TownName      string             `json:"townName,omitempty"`
+	PostalCode    string             `json:"postalCode,omitempty"`
+	Street        string             `json:"street,omitempty"`
+	TimeZone      string             `json:"timeZone,omitempty"`
 	BankName      string             `json:"bankName"`
+	BankNameASCII string             `json:"bankNameAscii,omitempty"`
 	CountryISO2   string             `json:"countryISO2"`
 	CountryName   string             `json:"countryName"`
 	IsHeadquarter bool               `json:"isHeadquarter"`
 	SwiftCode     string             `json:"swiftCode"`
+	DeletedAt     *time.Time         `json:"deletedAt,omitempty"`
 	Branches      []SwiftCodeDetails `json:"branches"`
 }
 
 type SwiftCodeBranch struct {
-	Address       string `json:"address"`
-	BankName      string `json:"bankName"`
-	CountryISO2   string `json:"countryISO2"`
-	CountryName   string `json:"countryName"`
-	IsHeadquarter *bool  `json:"isHeadquarter"`
-	SwiftCode     string `json:"swiftCode"`
+	Address       string     `json:"address"`
+	AddressASCII  string     `json:"addressAscii,omitempty"`
+	TownName      string     `json:"townName,omitempty"`
+	PostalCode    string     `json:"postalCode,omitempty"`
+	Street        string     `json:"street,omitempty"`
+	TimeZone      string     `json:"timeZone,omitempty"`
+	BankName      string     `json:"bankName"`
+	BankNameASCII string     `json:"bankNameAscii,omitempty"`
+	CountryISO2   string     `json:"countryISO2"`
+	CountryName   string     `json:"countryName"`
+	IsHeadquarter *bool      `json:"isHeadquarter"`
+	SwiftCode     string     `json:"swiftCode"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+}
+
+type SwiftCodePatch struct {
+	Address     *string `json:"address"`
+	TownName    *string `json:"townName"`
+	PostalCode  *string `json:"postalCode"`
+	Street      *string `json:"street"`
+	TimeZone    *string `json:"timeZone"`
+	BankName    *string `json:"bankName"`
+	CountryName *string `json:"countryName"`
 }
 
 type SwiftCodeByCountryISO2 struct {
-	CountryISO2 string             `json:"countryISO2"`
-	CountryName string             `json:"countryName"`
-	SwiftCodes  []SwiftCodeDetails `json:"swiftCodes"`
+	CountryISO2    string             `json:"countryISO2"`
+	CountryISO3    string             `json:"countryISO3"`
+	CountryNumeric string             `json:"countryNumeric,omitempty"`
+	CountryName    string             `json:"countryName"`
+	SwiftCodes     []SwiftCodeDetails `json:"swiftCodes"`
+	Total          *int               `json:"total,omitempty"`
+	Next           *string            `json:"next,omitempty"`
+}
+
+type SwiftCodeChange struct {
+	ID        int64            `json:"id"`
+	SwiftCode string           `json:"swiftCode"`
+	Operation string           `json:"operation"`
+	Before    *SwiftCodeBranch `json:"before"`
+	After     *SwiftCodeBranch `json:"after"`
+	Actor     string           `json:"actor"`
+	ChangedAt time.Time        `json:"changedAt"`
+}
+
+type SwiftCodeHistory struct {
+	SwiftCode string            `json:"swiftCode"`
+	Changes   []SwiftCodeChange `json:"changes"`
+}
+
+type AuditEntry struct {
+	ID           int64        `json:"id"`
+	Time         time.Time    `json:"time"`
+	Actor        string       `json:"actor"`
+	ClaimedActor string       `json:"claimedActor,omitempty"`
+	IP           string       `json:"ip"`
+	RequestID    string       `json:"requestId"`
+	Method       string       `json:"method"`
+	Path         string       `json:"path"`
+	Status       int          `json:"status"`
+	Detail       string       `json:"detail,omitempty"`
+	Errors       []FieldError `json:"errors,omitempty"`
+}
+
+type AuditLog struct {
+	Entries []AuditEntry `json:"entries"`
+	Next    *string      `json:"next"`
+}
+
+type APIKey struct {
+	ID        int64      `json:"id"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Role      string     `json:"role"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+type SwiftCodeBatchResult struct {
+	Index           int          `json:"index"`
+	SwiftCode       string       `json:"swiftCode"`
+	ParticipantType string       `json:"participantType,omitempty"`
+	Status          string       `json:"status"`
+	Error           string       `json:"error,omitempty"`
+	Errors          []FieldError `json:"errors,omitempty"`
+}
+
+type SwiftCodeBatchResponse struct {
+	Mode    string                 `json:"mode"`
+	Created int                    `json:"created"`
+	Failed  int                    `json:"failed"`
+	Results []SwiftCodeBatchResult `json:"results"`
+}
+
+type SwiftCodeList struct {
+	SwiftCodes []SwiftCodeDetails `json:"swiftCodes"`
+	Next       *string            `json:"next"`
+}
+
+type BankSearchResult struct {
+	BankID               string  `json:"bankId"`
+	BankName             string  `json:"bankName"`
+	CountryISO2          string  `json:"countryISO2"`
+	CountryName          string  `json:"countryName"`
+	HeadquarterSwiftCode *string `json:"headquarterSwiftCode"`
+	Score                float64 `json:"score"`
+}
+
+type BankSearchResponse struct {
+	Query   string             `json:"query"`
+	Results []BankSearchResult `json:"results"`
+}
+
+type Bank struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	NameASCII    string             `json:"nameAscii"`
+	CountryISO2  string             `json:"countryISO2"`
+	CountryName  string             `json:"countryName"`
+	Headquarters []SwiftCodeDetails `json:"headquarters"`
+	Branches     []SwiftCodeDetails `json:"branches"`
+}
+
+type BankSummary struct {
+	ID                    string   `json:"id"`
+	Name                  string   `json:"name"`
+	CountryISO2           string   `json:"countryISO2"`
+	CountryName           string   `json:"countryName"`
+	HeadquarterSwiftCodes []string `json:"headquarterSwiftCodes"`
+	BranchCount           int      `json:"branchCount"`
+}
+
+type BankList struct {
+	Banks []BankSummary `json:"banks"`
+	Next  *string       `json:"next"`
+}
+
+type Country struct {
+	CountryISO2      string `json:"countryISO2"`
+	CountryISO3      string `json:"countryISO3"`
+	CountryNumeric   string `json:"countryNumeric,omitempty"`
+	CountryName      string `json:"countryName"`
+	BankCount        int    `json:"bankCount"`
+	HeadquarterCount int    `json:"headquarterCount"`
+	BranchCount      int    `json:"branchCount"`
+}
+
+type CountryList struct {
+	Countries []Country `json:"countries"`
+}
+
+type IBANDetails struct {
+	IBAN        string            `json:"iban"`
+	Printed     string            `json:"printed"`
+	CountryISO2 string            `json:"countryISO2"`
+	CheckDigits string            `json:"checkDigits"`
+	BBAN        string            `json:"bban"`
+	BankCode    string            `json:"bankCode"`
+	SwiftCode   *SwiftCodeDetails `json:"swiftCode"`
+}
+
+type ClearingCode struct {
+	Scheme string `json:"scheme"`
+	Code   string `json:"code"`
+}
+
+type SwiftCodeClearingCodes struct {
+	SwiftCode     string         `json:"swiftCode"`
+	ClearingCodes []ClearingCode `json:"clearingCodes"`
+}
+
+type ClearingCodeMatch struct {
+	Scheme    string           `json:"scheme"`
+	Code      string           `json:"code"`
+	SwiftCode SwiftCodeDetails `json:"swiftCode"`
 }
@@ -0,0 +1,50 @@
+package models
+
+import "net/http"
+
+// ProblemContentType is the media type of error responses (RFC 7807).
+const ProblemContentType = "application/problem+json"
+
+// Problem types. Errors without a more specific type use about:blank, whose title is the HTTP status text.
+const (
+	ProblemTypeBlank      = "about:blank"
+	ProblemTypeValidation = "/problems/validation-error"
+)
+
+// Problem is the body of an error response as defined by RFC 7807.
+type Problem struct {
+	Type   string       `json:"type"`
+	Title  string       `json:"title"`
+	Status int          `json:"status"`
+	Detail string       `json:"detail,omitempty"`
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes why a single input field was rejected. Field is the JSON
+// name of the field and Code a stable machine-readable reason such as "required".
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewProblem returns a problem of type about:blank with the given status and detail.
+func NewProblem(status int, detail string) Problem {
+	return Problem{
+		Type:   ProblemTypeBlank,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// NewValidationProblem returns a 400 problem listing the rejected fields.
+func NewValidationProblem(detail string, errors []FieldError) Problem {
+	return Problem{
+		Type:   ProblemTypeValidation,
+		Title:  "Validation failed",
+		Status: http.StatusBadRequest,
+		Detail: detail,
+		Errors: errors,
+	}
+}
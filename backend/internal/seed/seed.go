@@ -0,0 +1,123 @@
+package seed
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"backend/internal/importer"
+	"backend/internal/models"
+	"backend/internal/store"
+)
+
+// fixture holds the reference SWIFT codes as a gzip compressed JSON array
+// in the request format of POST /v1/swift-codes.
+//
+//go:embed swift_codes.json.gz
+var fixture []byte
+
+// fixtureName is the file name reported for the embedded fixture.
+const fixtureName = "swift_codes.json.gz"
+
+// Actor is recorded in the history for the changes made by seeding.
+const Actor = "seed"
+
+// Load parses the SWIFT codes of a seed file, or of the embedded reference fixture when path is empty.
+// Files ending in .gz are decompressed; the remaining extension selects JSON (.json), TSV (.tsv)
+// or a CSV/TSV spreadsheet export with a detected delimiter. Invalid rows are returned as rejected results.
+func Load(path string) ([]importer.Row, []importer.Result, error) {
+	var r io.Reader
+	name := fixtureName
+	if path == "" {
+		r = bytes.NewReader(fixture)
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer file.Close()
+		r = file
+		name = filepath.Base(path)
+	}
+
+	if strings.EqualFold(filepath.Ext(name), ".gz") {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decompress %s: %v", name, err)
+		}
+		defer gz.Close()
+		r = gz
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	reader := bufio.NewReader(r)
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		return importer.ParseJSON(reader)
+	case ".tsv":
+		return importer.Parse(reader, '\t')
+	default:
+		return importer.Parse(reader, importer.DetectDelimiter(reader))
+	}
+}
+
+// Apply upserts the rows through the store used by the API: missing SWIFT codes are created,
+// differing ones are replaced and identical ones are skipped, as are soft-deleted ones, which are
+// not restored, so applying the same rows again changes nothing. A store error stops seeding; the
+// results so far are returned with the error.
+func Apply(s store.SwiftCodeRepository, rows []importer.Row) ([]importer.Result, error) {
+	seen := make(map[string]int, len(rows))
+	results := make([]importer.Result, 0, len(rows))
+	for _, row := range rows {
+		result := importer.Result{Line: row.Line, SwiftCode: row.Branch.SwiftCode}
+
+		if firstLine, duplicate := seen[row.Branch.SwiftCode]; duplicate {
+			result.Status = importer.StatusSkipped
+			result.Reason = fmt.Sprintf("duplicate of line %d", firstLine)
+			results = append(results, result)
+			continue
+		}
+		seen[row.Branch.SwiftCode] = row.Line
+
+		existing, err := s.GetSwiftCode(row.Branch.SwiftCode, true)
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			err = s.CreateSwiftCode(row.Branch, Actor)
+			result.Status = importer.StatusInserted
+		case err != nil:
+		case existing.DeletedAt != nil:
+			result.Status = importer.StatusSkipped
+			result.Reason = "deleted, restore it to seed it again"
+		case upToDate(existing, row.Branch):
+			result.Status = importer.StatusSkipped
+			result.Reason = "already up to date"
+		default:
+			err = s.UpdateSwiftCode(row.Branch, Actor)
+			result.Status = importer.StatusUpdated
+		}
+		if err != nil {
+			return results, fmt.Errorf("line %d: failed to seed SWIFT code %s: %v", row.Line, row.Branch.SwiftCode, err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// upToDate reports whether the stored SWIFT code already holds the seeded values.
+func upToDate(stored, seeded models.SwiftCodeBranch) bool {
+	return stored.BankName == seeded.BankName &&
+		stored.CountryName == seeded.CountryName &&
+		*stored.IsHeadquarter == *seeded.IsHeadquarter &&
+		stored.Address == seeded.Address &&
+		stored.TownName == seeded.TownName &&
+		stored.PostalCode == seeded.PostalCode &&
+		stored.Street == seeded.Street &&
+		stored.TimeZone == seeded.TimeZone
+}
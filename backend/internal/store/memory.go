@@ -0,0 +1,516 @@
+package store
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/internal/models"
+	"backend/internal/validation"
+)
+
+// Memory is a Store kept in process memory with the semantics of the PostgreSQL schema:
+// SWIFT codes are unique, banks are unique by the search key of their name within a country,
+// deleted SWIFT codes are kept until they are purged, and purging the last SWIFT code of a bank
+// removes the bank and, with its last bank, the country. Changes of SWIFT codes are recorded in a history.
+// It is meant for local development and tests; nothing is persisted.
+type Memory struct {
+	mu            sync.RWMutex
+	countries     map[string]string // country name by ISO2 code
+	banks         map[string]*memoryBank
+	bankIDs       map[bankKey]string
+	swiftCodes    map[string]*memorySwiftCode
+	clearingCodes map[models.ClearingCode]string // SWIFT code by clearing code
+	bankCodes     map[bankCodeKey]string         // SWIFT code by national bank code
+	history       []models.SwiftCodeChange       // changes of SWIFT codes, oldest first
+}
+
+var _ Store = (*Memory)(nil)
+
+type memoryBank struct {
+	id          string
+	name        string
+	nameKey     string
+	nameASCII   string
+	countryISO2 string
+}
+
+type memorySwiftCode struct {
+	swiftCode     string
+	bankID        string
+	isHeadquarter bool
+	address       string
+	addressASCII  string
+	townName      string
+	postalCode    string
+	street        string
+	timeZone      string
+	deletedAt     *time.Time
+}
+
+// bankKey identifies a bank like the unique (name_key, country_id) constraint.
+type bankKey struct {
+	countryISO2 string
+	nameKey     string
+}
+
+// bankCodeKey identifies a national bank code like the unique (country_iso2, bank_code) constraint.
+type bankCodeKey struct {
+	countryISO2 string
+	bankCode    string
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory() *Memory {
+	return &Memory{
+		countries:     map[string]string{},
+		banks:         map[string]*memoryBank{},
+		bankIDs:       map[bankKey]string{},
+		swiftCodes:    map[string]*memorySwiftCode{},
+		clearingCodes: map[models.ClearingCode]string{},
+		bankCodes:     map[bankCodeKey]string{},
+	}
+}
+
+// newID returns a random version 4 UUID, the format of the IDs generated by the database.
+func newID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// details returns the SWIFT code with the name of its bank.
+func (m *Memory) details(sc *memorySwiftCode) models.SwiftCodeDetails {
+	bank := m.banks[sc.bankID]
+	return models.SwiftCodeDetails{
+		Address:       sc.address,
+		AddressASCII:  sc.addressASCII,
+		TownName:      sc.townName,
+		PostalCode:    sc.postalCode,
+		Street:        sc.street,
+		TimeZone:      sc.timeZone,
+		BankName:      bank.name,
+		BankNameASCII: bank.nameASCII,
+		CountryISO2:   bank.countryISO2,
+		IsHeadquarter: sc.isHeadquarter,
+		SwiftCode:     sc.swiftCode,
+		DeletedAt:     sc.deletedAt,
+	}
+}
+
+// visible returns a stored SWIFT code unless it is deleted and includeDeleted is false.
+func (m *Memory) visible(swiftCode string, includeDeleted bool) (*memorySwiftCode, bool) {
+	sc, ok := m.swiftCodes[swiftCode]
+	if !ok || sc.deletedAt != nil && !includeDeleted {
+		return nil, false
+	}
+	return sc, true
+}
+
+// sortedSwiftCodes returns the SWIFT codes accepted by match ordered by SWIFT code.
+func (m *Memory) sortedSwiftCodes(match func(sc *memorySwiftCode) bool) []*memorySwiftCode {
+	var swiftCodes []*memorySwiftCode
+	for _, sc := range m.swiftCodes {
+		if match(sc) {
+			swiftCodes = append(swiftCodes, sc)
+		}
+	}
+	sort.Slice(swiftCodes, func(i, j int) bool {
+		return swiftCodes[i].swiftCode < swiftCodes[j].swiftCode
+	})
+	return swiftCodes
+}
+
+// GetHeadquarter returns a headquarter SWIFT code with the branches sharing its 8 character prefix.
+// With includeDeleted a deleted headquarter and deleted branches are returned as well.
+func (m *Memory) GetHeadquarter(swiftCode string, includeDeleted bool) (models.SwiftCodeHeadquarter, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sc, ok := m.visible(swiftCode, includeDeleted)
+	if !ok {
+		return models.SwiftCodeHeadquarter{}, ErrNotFound
+	}
+	details := m.details(sc)
+	headquarter := models.SwiftCodeHeadquarter{
+		Address:       details.Address,
+		AddressASCII:  details.AddressASCII,
+		TownName:      details.TownName,
+		PostalCode:    details.PostalCode,
+		Street:        details.Street,
+		TimeZone:      details.TimeZone,
+		BankName:      details.BankName,
+		BankNameASCII: details.BankNameASCII,
+		CountryISO2:   details.CountryISO2,
+		CountryName:   m.countries[details.CountryISO2],
+		IsHeadquarter: details.IsHeadquarter,
+		SwiftCode:     details.SwiftCode,
+		DeletedAt:     details.DeletedAt,
+		Branches:      []models.SwiftCodeDetails{},
+	}
+
+	branches := m.sortedSwiftCodes(func(branch *memorySwiftCode) bool {
+		return branch.swiftCode[:8] == swiftCode[:8] && branch.swiftCode != swiftCode && !branch.isHeadquarter &&
+			(includeDeleted || branch.deletedAt == nil)
+	})
+	for _, branch := range branches {
+		headquarter.Branches = append(headquarter.Branches, m.details(branch))
+	}
+	return headquarter, nil
+}
+
+// GetSwiftCode returns a single SWIFT code of either kind, a deleted one only with includeDeleted.
+func (m *Memory) GetSwiftCode(swiftCode string, includeDeleted bool) (models.SwiftCodeBranch, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sc, ok := m.visible(swiftCode, includeDeleted)
+	if !ok {
+		return models.SwiftCodeBranch{}, ErrNotFound
+	}
+	branch := m.snapshot(sc)
+	branch.DeletedAt = sc.deletedAt
+	return branch, nil
+}
+
+// matches reports whether a SWIFT code passes the filter.
+func (m *Memory) matches(sc *memorySwiftCode, filter SwiftCodeFilter) bool {
+	bank := m.banks[sc.bankID]
+	switch {
+	case sc.deletedAt != nil && !filter.IncludeDeleted:
+		return false
+	case filter.CountryISO2 != "" && bank.countryISO2 != filter.CountryISO2:
+		return false
+	case filter.BankKey != "" && !strings.Contains(bank.nameKey, filter.BankKey):
+		return false
+	case filter.Town != "" && strings.ToUpper(sc.townName) != filter.Town:
+		return false
+	case filter.IsHeadquarter != nil && sc.isHeadquarter != *filter.IsHeadquarter:
+		return false
+	case filter.Prefix != "" && !strings.HasPrefix(sc.swiftCode, filter.Prefix):
+		return false
+	}
+	return true
+}
+
+// ListSwiftCodes returns SWIFT codes matching the filter ordered by SWIFT code.
+func (m *Memory) ListSwiftCodes(filter SwiftCodeFilter, page Page) ([]models.SwiftCodeDetails, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	swiftCodes := []models.SwiftCodeDetails{}
+	for _, sc := range m.sortedSwiftCodes(func(sc *memorySwiftCode) bool { return m.matches(sc, filter) }) {
+		if len(page.After) > 0 && sc.swiftCode <= page.After[0] {
+			continue
+		}
+		if len(swiftCodes) == page.Limit {
+			break
+		}
+		swiftCodes = append(swiftCodes, m.details(sc))
+	}
+	return swiftCodes, nil
+}
+
+// GetCountrySwiftCodes returns all SWIFT codes of a country.
+func (m *Memory) GetCountrySwiftCodes(countryISO2 string) (models.SwiftCodeByCountryISO2, error) {
+	return m.ListCountrySwiftCodes(countryISO2, SwiftCodeFilter{}, Page{})
+}
+
+// ListCountrySwiftCodes returns a page of the SWIFT codes of a country with the total matching the filter.
+// A zero page limit returns all SWIFT codes without a total.
+func (m *Memory) ListCountrySwiftCodes(countryISO2 string, filter SwiftCodeFilter, page Page) (models.SwiftCodeByCountryISO2, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	countryName, ok := m.countries[countryISO2]
+	if !ok {
+		return models.SwiftCodeByCountryISO2{}, ErrNotFound
+	}
+
+	filter.CountryISO2 = countryISO2
+	matching := m.sortedSwiftCodes(func(sc *memorySwiftCode) bool { return m.matches(sc, filter) })
+	if page.Sort == SortBankName {
+		sort.SliceStable(matching, func(i, j int) bool {
+			return m.banks[matching[i].bankID].nameKey < m.banks[matching[j].bankID].nameKey
+		})
+	}
+
+	countrySwiftCodes := models.SwiftCodeByCountryISO2{
+		CountryISO2: countryISO2,
+		CountryName: countryName,
+		SwiftCodes:  []models.SwiftCodeDetails{},
+	}
+	if page.Limit > 0 {
+		total := len(matching)
+		countrySwiftCodes.Total = &total
+	}
+
+	skipped := 0
+	for _, sc := range matching {
+		if len(page.After) > 0 && !m.afterKey(sc, page) {
+			continue
+		}
+		if skipped < page.Offset {
+			skipped++
+			continue
+		}
+		if page.Limit > 0 && len(countrySwiftCodes.SwiftCodes) == page.Limit {
+			break
+		}
+		countrySwiftCodes.SwiftCodes = append(countrySwiftCodes.SwiftCodes, m.details(sc))
+	}
+	return countrySwiftCodes, nil
+}
+
+// afterKey reports whether a SWIFT code sorts after the keyset cursor of the page.
+func (m *Memory) afterKey(sc *memorySwiftCode, page Page) bool {
+	if page.Sort == SortBankName {
+		nameKey := m.banks[sc.bankID].nameKey
+		return nameKey > page.After[0] || nameKey == page.After[0] && sc.swiftCode > page.After[1]
+	}
+	return sc.swiftCode > page.After[0]
+}
+
+// upsertCountryAndBank stores the country name and returns the ID of the bank of the SWIFT code,
+// creating the bank when no bank of the country has the same search key. With rename an existing
+// bank takes over the given name. Renames are recorded for the SWIFT codes of the bank or country.
+func (m *Memory) upsertCountryAndBank(body models.SwiftCodeBranch, rename bool, actor string) string {
+	if name, ok := m.countries[body.CountryISO2]; ok && name != body.CountryName {
+		m.recordRename(func(bank *memoryBank) bool { return bank.countryISO2 == body.CountryISO2 }, func() {
+			m.countries[body.CountryISO2] = body.CountryName
+		}, actor)
+	}
+	m.countries[body.CountryISO2] = body.CountryName
+
+	key := bankKey{countryISO2: body.CountryISO2, nameKey: validation.SearchKey(body.BankName)}
+	if id, ok := m.bankIDs[key]; ok {
+		bank := m.banks[id]
+		if rename && (bank.name != body.BankName || bank.nameASCII != body.BankNameASCII) {
+			m.recordRename(func(other *memoryBank) bool { return other == bank }, func() {
+				bank.name = body.BankName
+				bank.nameASCII = body.BankNameASCII
+			}, actor)
+		}
+		return id
+	}
+
+	bank := &memoryBank{
+		id:          newID(),
+		name:        body.BankName,
+		nameKey:     key.nameKey,
+		nameASCII:   body.BankNameASCII,
+		countryISO2: body.CountryISO2,
+	}
+	m.banks[bank.id] = bank
+	m.bankIDs[key] = bank.id
+	return bank.id
+}
+
+// newMemorySwiftCode copies the stored fields of a SWIFT code.
+func newMemorySwiftCode(body models.SwiftCodeBranch, bankID string) *memorySwiftCode {
+	return &memorySwiftCode{
+		swiftCode:     body.SwiftCode,
+		bankID:        bankID,
+		isHeadquarter: *body.IsHeadquarter,
+		address:       body.Address,
+		addressASCII:  body.AddressASCII,
+		townName:      body.TownName,
+		postalCode:    body.PostalCode,
+		street:        body.Street,
+		timeZone:      body.TimeZone,
+	}
+}
+
+// CreateSwiftCode stores a new SWIFT code, returning ErrConflict when it already exists.
+// A deleted SWIFT code is replaced and restored.
+func (m *Memory) CreateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visible(swiftCode.SwiftCode, false); ok {
+		return ErrConflict
+	}
+	m.insertSwiftCode(swiftCode, actor)
+	return nil
+}
+
+// insertSwiftCode stores a SWIFT code that does not exist or is deleted and records its creation,
+// or its restore when a deleted SWIFT code with the same values is replaced.
+func (m *Memory) insertSwiftCode(body models.SwiftCodeBranch, actor string) {
+	operation := OperationCreate
+	bankID := m.upsertCountryAndBank(body, false, actor)
+	var previous *models.SwiftCodeBranch
+	if sc, ok := m.swiftCodes[body.SwiftCode]; ok {
+		snapshot := m.snapshot(sc)
+		previous = &snapshot
+	}
+
+	sc := newMemorySwiftCode(body, bankID)
+	m.swiftCodes[body.SwiftCode] = sc
+	after := m.snapshot(sc)
+	if previous != nil && sameSnapshot(*previous, after) {
+		operation = OperationRestore
+	}
+	m.record(operation, nil, &after, actor)
+}
+
+// CreateSwiftCodes stores the SWIFT codes at once, see SwiftCodeRepository. Storing in memory
+// cannot fail, so the only failures are SWIFT codes that already exist or repeat within the batch.
+func (m *Memory) CreateSwiftCodes(swiftCodes []models.SwiftCodeBranch, actor string) ([]error, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	results := make([]error, len(swiftCodes))
+	seen := make(map[string]bool, len(swiftCodes))
+	failed := false
+	for i, swiftCode := range swiftCodes {
+		if _, ok := m.visible(swiftCode.SwiftCode, false); ok || seen[swiftCode.SwiftCode] {
+			results[i] = ErrConflict
+			failed = true
+		}
+		seen[swiftCode.SwiftCode] = true
+	}
+	if failed {
+		return results, nil
+	}
+
+	for _, swiftCode := range swiftCodes {
+		m.insertSwiftCode(swiftCode, actor)
+	}
+	return results, nil
+}
+
+// UpdateSwiftCode stores a validated SWIFT code over the existing one that is not deleted. The country and bank
+// are created or renamed as needed and the previous bank is removed once it has no codes left.
+func (m *Memory) UpdateSwiftCode(body models.SwiftCodeBranch, actor string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	previous, ok := m.visible(body.SwiftCode, false)
+	if !ok {
+		return ErrNotFound
+	}
+	// renames of the bank and country are recorded on their own, so the update starts from the new names
+	bankID := m.upsertCountryAndBank(body, true, actor)
+	before := m.snapshot(previous)
+
+	sc := newMemorySwiftCode(body, bankID)
+	m.swiftCodes[body.SwiftCode] = sc
+	if !m.bankHasSwiftCodes(previous.bankID) {
+		m.deleteBank(previous.bankID)
+	}
+	if after := m.snapshot(sc); !sameSnapshot(before, after) {
+		m.record(OperationUpdate, &before, &after, actor)
+	}
+	return nil
+}
+
+// DeleteSwiftCode marks a SWIFT code as deleted like the delete_swift_code function.
+// Its bank and country are kept until the SWIFT code is purged.
+func (m *Memory) DeleteSwiftCode(swiftCode, actor string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sc, ok := m.visible(swiftCode, false)
+	if !ok {
+		return ErrNotFound
+	}
+	deletedAt := time.Now()
+	sc.deletedAt = &deletedAt
+	before := m.snapshot(sc)
+	m.record(OperationDelete, &before, nil, actor)
+	return nil
+}
+
+// RestoreSwiftCode undoes the deletion of a SWIFT code, returning ErrConflict when it is not deleted.
+func (m *Memory) RestoreSwiftCode(swiftCode, actor string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sc, ok := m.swiftCodes[swiftCode]
+	if !ok {
+		return ErrNotFound
+	}
+	if sc.deletedAt == nil {
+		return ErrConflict
+	}
+	sc.deletedAt = nil
+	after := m.snapshot(sc)
+	m.record(OperationRestore, nil, &after, actor)
+	return nil
+}
+
+// PurgeSwiftCodes removes the SWIFT codes deleted before the given time with their clearing and
+// national bank codes like the purge_swift_codes function, then the banks and countries left without SWIFT codes.
+func (m *Memory) PurgeSwiftCodes(deletedBefore time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	purged := 0
+	for swiftCode, sc := range m.swiftCodes {
+		if sc.deletedAt == nil || !sc.deletedAt.Before(deletedBefore) {
+			continue
+		}
+		before := m.snapshot(sc)
+		m.record(OperationPurge, &before, nil, PurgeActor)
+		delete(m.swiftCodes, swiftCode)
+		for clearingCode, owner := range m.clearingCodes {
+			if owner == swiftCode {
+				delete(m.clearingCodes, clearingCode)
+			}
+		}
+		for bankCode, owner := range m.bankCodes {
+			if owner == swiftCode {
+				delete(m.bankCodes, bankCode)
+			}
+		}
+		purged++
+	}
+
+	countriesWithBanks := map[string]bool{}
+	for id, bank := range m.banks {
+		if m.bankHasSwiftCodes(id) {
+			countriesWithBanks[bank.countryISO2] = true
+		} else {
+			m.deleteBank(id)
+		}
+	}
+	for countryISO2 := range m.countries {
+		if !countriesWithBanks[countryISO2] {
+			delete(m.countries, countryISO2)
+		}
+	}
+	return purged, nil
+}
+
+// bankHasSwiftCodes reports whether any SWIFT code belongs to the bank.
+func (m *Memory) bankHasSwiftCodes(bankID string) bool {
+	for _, sc := range m.swiftCodes {
+		if sc.bankID == bankID {
+			return true
+		}
+	}
+	return false
+}
+
+// bankIsLive reports whether a SWIFT code of the bank is not deleted.
+func (m *Memory) bankIsLive(bankID string) bool {
+	for _, sc := range m.swiftCodes {
+		if sc.bankID == bankID && sc.deletedAt == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteBank removes a bank without SWIFT codes.
+func (m *Memory) deleteBank(bankID string) {
+	bank := m.banks[bankID]
+	delete(m.bankIDs, bankKey{countryISO2: bank.countryISO2, nameKey: bank.nameKey})
+	delete(m.banks, bankID)
+}
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"backend/internal/models"
+)
+
+// similarityThreshold is the default pg_trgm.similarity_threshold used by the % operator.
+const similarityThreshold = 0.3
+
+// sortedBanks returns the banks of a country, or of all countries, ordered by search key and ID.
+// Banks without SWIFT codes that are not deleted are left out like in reads of the PostgreSQL store.
+func (m *Memory) sortedBanks(countryISO2 string) []*memoryBank {
+	var banks []*memoryBank
+	for _, bank := range m.banks {
+		if (countryISO2 == "" || bank.countryISO2 == countryISO2) && m.bankIsLive(bank.id) {
+			banks = append(banks, bank)
+		}
+	}
+	sort.Slice(banks, func(i, j int) bool {
+		if banks[i].nameKey != banks[j].nameKey {
+			return banks[i].nameKey < banks[j].nameKey
+		}
+		return banks[i].id < banks[j].id
+	})
+	return banks
+}
+
+// ListBanks returns banks ordered by the search key of their name in byte order and ID, optionally of a single country.
+func (m *Memory) ListBanks(countryISO2 string, page Page) ([]models.BankSummary, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	banks := []models.BankSummary{}
+	for _, bank := range m.sortedBanks(countryISO2) {
+		if len(page.After) > 0 && (bank.nameKey < page.After[0] || bank.nameKey == page.After[0] && bank.id <= page.After[1]) {
+			continue
+		}
+		if len(banks) == page.Limit {
+			break
+		}
+
+		summary := models.BankSummary{
+			ID:                    bank.id,
+			Name:                  bank.name,
+			CountryISO2:           bank.countryISO2,
+			CountryName:           m.countries[bank.countryISO2],
+			HeadquarterSwiftCodes: []string{},
+		}
+		for _, sc := range m.sortedSwiftCodes(func(sc *memorySwiftCode) bool { return sc.bankID == bank.id && sc.deletedAt == nil }) {
+			if sc.isHeadquarter {
+				summary.HeadquarterSwiftCodes = append(summary.HeadquarterSwiftCodes, sc.swiftCode)
+			} else {
+				summary.BranchCount++
+			}
+		}
+		banks = append(banks, summary)
+	}
+	return banks, nil
+}
+
+// GetBank returns a bank with its headquarter and branch SWIFT codes.
+func (m *Memory) GetBank(id string) (models.Bank, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	bank, ok := m.banks[id]
+	if !ok || !m.bankIsLive(id) {
+		return models.Bank{}, ErrNotFound
+	}
+
+	result := models.Bank{
+		ID:           bank.id,
+		Name:         bank.name,
+		NameASCII:    bank.nameASCII,
+		CountryISO2:  bank.countryISO2,
+		CountryName:  m.countries[bank.countryISO2],
+		Headquarters: []models.SwiftCodeDetails{},
+		Branches:     []models.SwiftCodeDetails{},
+	}
+	for _, sc := range m.sortedSwiftCodes(func(sc *memorySwiftCode) bool { return sc.bankID == id && sc.deletedAt == nil }) {
+		if sc.isHeadquarter {
+			result.Headquarters = append(result.Headquarters, m.details(sc))
+		} else {
+			result.Branches = append(result.Branches, m.details(sc))
+		}
+	}
+	return result, nil
+}
+
+// SearchBanks ranks exact, prefix and substring matches of the search key above trigram
+// similarity matches; with includeAddress the addresses of the bank's SWIFT codes are searched as well.
+// The scores are those of the PostgreSQL query.
+func (m *Memory) SearchBanks(key string, includeAddress bool, limit int) ([]models.BankSearchResult, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	results := []models.BankSearchResult{}
+	for _, bank := range m.banks {
+		if !m.bankIsLive(bank.id) {
+			continue
+		}
+		similar := similarity(bank.nameKey, key)
+		score := similar * 0.6
+		switch {
+		case bank.nameKey == key:
+			score = 1.0
+		case strings.HasPrefix(bank.nameKey, key):
+			score = 0.9
+		case strings.Contains(bank.nameKey, key):
+			score = 0.7
+		}
+
+		addressMatch := false
+		if includeAddress {
+			for _, sc := range m.swiftCodes {
+				if sc.bankID == bank.id && sc.deletedAt == nil && strings.Contains(strings.ToLower(sc.addressASCII), strings.ToLower(key)) {
+					addressMatch = true
+					break
+				}
+			}
+		}
+		if addressMatch && score < 0.5 {
+			score = 0.5
+		}
+
+		if !strings.Contains(bank.nameKey, key) && similar < similarityThreshold && !addressMatch {
+			continue
+		}
+
+		result := models.BankSearchResult{
+			BankID:      bank.id,
+			BankName:    bank.name,
+			CountryISO2: bank.countryISO2,
+			CountryName: m.countries[bank.countryISO2],
+			Score:       score,
+		}
+		for _, sc := range m.sortedSwiftCodes(func(sc *memorySwiftCode) bool { return sc.bankID == bank.id && sc.isHeadquarter && sc.deletedAt == nil }) {
+			headquarter := sc.swiftCode
+			result.HeadquarterSwiftCode = &headquarter
+			break
+		}
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].BankName < results[j].BankName
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+// similarity returns the trigram similarity of two strings as computed by pg_trgm:
+// the number of shared trigrams divided by the number of distinct trigrams of both.
+func similarity(a, b string) float64 {
+	trigramsA, trigramsB := trigrams(a), trigrams(b)
+	if len(trigramsA) == 0 || len(trigramsB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range trigramsA {
+		if trigramsB[trigram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(trigramsA)+len(trigramsB)-shared)
+}
+
+// trigrams splits a string into lower case words of letters and digits and returns the
+// trigrams of every word padded with two spaces in front and one behind, like pg_trgm.
+func trigrams(value string) map[string]bool {
+	set := map[string]bool{}
+	words := strings.FieldsFunc(strings.ToLower(value), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = true
+		}
+	}
+	return set
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"sort"
+
+	"backend/internal/models"
+)
+
+// SetBankCode maps a national bank code of an IBAN onto a stored SWIFT code, replacing
+// a previous mapping of the code. It returns ErrNotFound when the SWIFT code is not stored or deleted.
+func (m *Memory) SetBankCode(countryISO2, bankCode, swiftCode string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok {
+		return ErrNotFound
+	}
+	m.bankCodes[bankCodeKey{countryISO2: countryISO2, bankCode: bankCode}] = swiftCode
+	return nil
+}
+
+// GetSwiftCodeByBankCode returns the SWIFT code mapped to a national bank code of an IBAN.
+func (m *Memory) GetSwiftCodeByBankCode(countryISO2, bankCode string) (models.SwiftCodeDetails, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sc, ok := m.visible(m.bankCodes[bankCodeKey{countryISO2: countryISO2, bankCode: bankCode}], false)
+	if !ok {
+		return models.SwiftCodeDetails{}, ErrNotFound
+	}
+	return m.details(sc), nil
+}
+
+// GetSwiftCodeByClearingCode returns the SWIFT code a clearing code is assigned to.
+func (m *Memory) GetSwiftCodeByClearingCode(clearingCode models.ClearingCode) (models.SwiftCodeDetails, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sc, ok := m.visible(m.clearingCodes[clearingCode], false)
+	if !ok {
+		return models.SwiftCodeDetails{}, ErrNotFound
+	}
+	return m.details(sc), nil
+}
+
+// ListClearingCodes returns the clearing codes of a SWIFT code ordered by scheme and code.
+func (m *Memory) ListClearingCodes(swiftCode string) ([]models.ClearingCode, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok {
+		return nil, ErrNotFound
+	}
+
+	clearingCodes := []models.ClearingCode{}
+	for clearingCode, owner := range m.clearingCodes {
+		if owner == swiftCode {
+			clearingCodes = append(clearingCodes, clearingCode)
+		}
+	}
+	sort.Slice(clearingCodes, func(i, j int) bool {
+		if clearingCodes[i].Scheme != clearingCodes[j].Scheme {
+			return clearingCodes[i].Scheme < clearingCodes[j].Scheme
+		}
+		return clearingCodes[i].Code < clearingCodes[j].Code
+	})
+	return clearingCodes, nil
+}
+
+// GetClearingCode returns a single clearing code of a SWIFT code.
+func (m *Memory) GetClearingCode(swiftCode string, clearingCode models.ClearingCode) (models.ClearingCode, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok || m.clearingCodes[clearingCode] != swiftCode {
+		return clearingCode, ErrNotFound
+	}
+	return clearingCode, nil
+}
+
+// CreateClearingCode assigns a clearing code to a SWIFT code.
+func (m *Memory) CreateClearingCode(swiftCode string, clearingCode models.ClearingCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok {
+		return ErrNotFound
+	}
+	if _, ok := m.clearingCodes[clearingCode]; ok {
+		return ErrConflict
+	}
+	m.clearingCodes[clearingCode] = swiftCode
+	return nil
+}
+
+// UpdateClearingCode replaces a clearing code of a SWIFT code.
+func (m *Memory) UpdateClearingCode(swiftCode string, previous, clearingCode models.ClearingCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok || m.clearingCodes[previous] != swiftCode {
+		return ErrNotFound
+	}
+	if _, ok := m.clearingCodes[clearingCode]; ok && clearingCode != previous {
+		return ErrConflict
+	}
+	delete(m.clearingCodes, previous)
+	m.clearingCodes[clearingCode] = swiftCode
+	return nil
+}
+
+// DeleteClearingCode removes a clearing code from a SWIFT code.
+func (m *Memory) DeleteClearingCode(swiftCode string, clearingCode models.ClearingCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.visible(swiftCode, false); !ok || m.clearingCodes[clearingCode] != swiftCode {
+		return ErrNotFound
+	}
+	delete(m.clearingCodes, clearingCode)
+	return nil
+}
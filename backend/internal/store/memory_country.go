@@ -0,0 +1,63 @@
+package store
+
+import (
+	"sort"
+
+	"backend/internal/models"
+)
+
+// country returns a stored country with its statistics.
+func (m *Memory) country(countryISO2 string) models.Country {
+	country := models.Country{CountryISO2: countryISO2, CountryName: m.countries[countryISO2]}
+	for _, bank := range m.banks {
+		if bank.countryISO2 == countryISO2 && m.bankIsLive(bank.id) {
+			country.BankCount++
+		}
+	}
+	for _, sc := range m.swiftCodes {
+		if sc.deletedAt != nil || m.banks[sc.bankID].countryISO2 != countryISO2 {
+			continue
+		}
+		if sc.isHeadquarter {
+			country.HeadquarterCount++
+		} else {
+			country.BranchCount++
+		}
+	}
+	return country
+}
+
+// ListCountries returns all countries with their statistics ordered by ISO2 code.
+func (m *Memory) ListCountries() ([]models.Country, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	countries := make([]models.Country, 0, len(m.countries))
+	for countryISO2 := range m.countries {
+		countries = append(countries, m.country(countryISO2))
+	}
+	sort.Slice(countries, func(i, j int) bool {
+		return countries[i].CountryISO2 < countries[j].CountryISO2
+	})
+	return countries, nil
+}
+
+// GetCountry returns a single country with its statistics.
+func (m *Memory) GetCountry(countryISO2 string) (models.Country, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, ok := m.countries[countryISO2]; !ok {
+		return models.Country{}, ErrNotFound
+	}
+	return m.country(countryISO2), nil
+}
+
+// CountryExists reports whether the country is stored.
+func (m *Memory) CountryExists(countryISO2 string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	_, ok := m.countries[countryISO2]
+	return ok, nil
+}
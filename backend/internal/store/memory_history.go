@@ -0,0 +1,117 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+)
+
+// snapshot returns a SWIFT code with its bank and country like the swift_code_snapshot function.
+func (m *Memory) snapshot(sc *memorySwiftCode) models.SwiftCodeBranch {
+	details := m.details(sc)
+	isHeadquarter := sc.isHeadquarter
+	return models.SwiftCodeBranch{
+		Address:       details.Address,
+		AddressASCII:  details.AddressASCII,
+		TownName:      details.TownName,
+		PostalCode:    details.PostalCode,
+		Street:        details.Street,
+		TimeZone:      details.TimeZone,
+		BankName:      details.BankName,
+		BankNameASCII: details.BankNameASCII,
+		CountryISO2:   details.CountryISO2,
+		CountryName:   m.countries[details.CountryISO2],
+		IsHeadquarter: &isHeadquarter,
+		SwiftCode:     details.SwiftCode,
+	}
+}
+
+// sameSnapshot reports whether two snapshots hold the same values.
+func sameSnapshot(a, b models.SwiftCodeBranch) bool {
+	if *a.IsHeadquarter != *b.IsHeadquarter {
+		return false
+	}
+	a.IsHeadquarter, b.IsHeadquarter = nil, nil
+	return a == b
+}
+
+// record appends a change to the history like the swift_codes_history trigger.
+func (m *Memory) record(operation string, before, after *models.SwiftCodeBranch, actor string) {
+	snapshot := after
+	if before != nil {
+		snapshot = before
+	}
+	m.history = append(m.history, models.SwiftCodeChange{
+		ID:        int64(len(m.history) + 1),
+		SwiftCode: snapshot.SwiftCode,
+		Operation: operation,
+		Before:    before,
+		After:     after,
+		Actor:     actor,
+		ChangedAt: time.Now(),
+	})
+}
+
+// recordRename renames a bank or country and records an update of each SWIFT code of the banks accepted by
+// of that is not deleted, like the record_swift_code_rename trigger.
+func (m *Memory) recordRename(of func(bank *memoryBank) bool, rename func(), actor string) {
+	swiftCodes := m.sortedSwiftCodes(func(sc *memorySwiftCode) bool {
+		return sc.deletedAt == nil && of(m.banks[sc.bankID])
+	})
+	befores := make([]models.SwiftCodeBranch, len(swiftCodes))
+	for i, sc := range swiftCodes {
+		befores[i] = m.snapshot(sc)
+	}
+	rename()
+	for i, sc := range swiftCodes {
+		after := m.snapshot(sc)
+		m.record(OperationUpdate, &befores[i], &after, actor)
+	}
+}
+
+// GetSwiftCodeHistory returns the changes of a SWIFT code, oldest first, or ErrNotFound when none are recorded.
+func (m *Memory) GetSwiftCodeHistory(swiftCode string) ([]models.SwiftCodeChange, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	changes := []models.SwiftCodeChange{}
+	for _, change := range m.history {
+		if change.SwiftCode == swiftCode {
+			changes = append(changes, change)
+		}
+	}
+	if len(changes) == 0 {
+		return nil, ErrNotFound
+	}
+	return changes, nil
+}
+
+// ListSwiftCodesAsOf returns the SWIFT codes existing at the given time from the latest change of
+// every SWIFT code recorded until then, ordered by SWIFT code. Empty countryISO2 and prefix are not applied.
+func (m *Memory) ListSwiftCodesAsOf(asOf time.Time, countryISO2, prefix string) ([]models.SwiftCodeBranch, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	latest := map[string]*models.SwiftCodeBranch{}
+	for _, change := range m.history {
+		if change.ChangedAt.After(asOf) {
+			break
+		}
+		if strings.HasPrefix(change.SwiftCode, prefix) {
+			latest[change.SwiftCode] = change.After
+		}
+	}
+
+	swiftCodes := []models.SwiftCodeBranch{}
+	for _, snapshot := range latest {
+		if snapshot != nil && (countryISO2 == "" || snapshot.CountryISO2 == countryISO2) {
+			swiftCodes = append(swiftCodes, *snapshot)
+		}
+	}
+	sort.Slice(swiftCodes, func(i, j int) bool {
+		return swiftCodes[i].SwiftCode < swiftCodes[j].SwiftCode
+	})
+	return swiftCodes, nil
+}
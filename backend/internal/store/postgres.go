@@ -0,0 +1,539 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"backend/internal/models"
+	"backend/internal/validation"
+)
+
+// Postgres is the Store backed by the PostgreSQL schema in internal/db/migrations.
+type Postgres struct {
+	DB *sql.DB
+}
+
+var _ Store = (*Postgres)(nil)
+
+// NewPostgres creates a store using the given database connection.
+func NewPostgres(db *sql.DB) *Postgres {
+	return &Postgres{DB: db}
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// notFound translates sql.ErrNoRows to ErrNotFound.
+func notFound(err error) error {
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	return err
+}
+
+// escapeLike escapes the LIKE wildcards in a user supplied search term.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// GetHeadquarter returns a headquarter SWIFT code with the branches sharing its 8 character prefix.
+// With includeDeleted a deleted headquarter and deleted branches are returned as well.
+func (p *Postgres) GetHeadquarter(swiftCode string, includeDeleted bool) (models.SwiftCodeHeadquarter, error) {
+	var headquarter models.SwiftCodeHeadquarter
+	var branches sql.NullString
+
+	err := p.DB.QueryRow(`
+		SELECT 
+			sc.address, sc.address_ascii, sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+			b.name AS bank_name, b.name_ascii AS bank_name_ascii, c.iso2_code AS country_iso2,
+			c.name AS country_name, sc.is_headquarter, sc.swift_code, sc.deleted_at,
+			(
+				SELECT COALESCE(json_agg(json_build_object(
+					'bankName', b2.name,
+					'bankNameAscii', b2.name_ascii,
+					'address', sw.address,
+					'addressAscii', sw.address_ascii,
+					'townName', sw.town_name,
+					'postalCode', sw.postal_code,
+					'street', sw.street,
+					'timeZone', sw.time_zone,
+					'countryISO2', c2.iso2_code,
+					'isHeadquarter', sw.is_headquarter,
+					'swiftCode', sw.swift_code,
+					'deletedAt', sw.deleted_at
+				)), '[]'::json)
+				FROM swift_codes sw
+				JOIN banks b2 ON sw.bank_id = b2.id
+				JOIN countries c2 ON b2.country_id = c2.id
+				WHERE LEFT(sw.swift_code, 8) = LEFT($1, 8)
+				AND sw.swift_code != $1
+				AND sw.is_headquarter = false
+				AND ($2 OR sw.deleted_at IS NULL)
+			) AS branches
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE sc.swift_code = $1 AND ($2 OR sc.deleted_at IS NULL);
+	`, swiftCode, includeDeleted).Scan(
+		&headquarter.Address, &headquarter.AddressASCII, &headquarter.TownName, &headquarter.PostalCode, &headquarter.Street, &headquarter.TimeZone,
+		&headquarter.BankName, &headquarter.BankNameASCII, &headquarter.CountryISO2,
+		&headquarter.CountryName, &headquarter.IsHeadquarter, &headquarter.SwiftCode, &headquarter.DeletedAt, &branches,
+	)
+	if err != nil {
+		return headquarter, notFound(err)
+	}
+
+	if branches.Valid {
+		json.Unmarshal([]byte(branches.String), &headquarter.Branches)
+	}
+	return headquarter, nil
+}
+
+// GetSwiftCode returns a single SWIFT code of either kind, a deleted one only with includeDeleted.
+func (p *Postgres) GetSwiftCode(swiftCode string, includeDeleted bool) (models.SwiftCodeBranch, error) {
+	var branch models.SwiftCodeBranch
+	var isHeadquarter bool
+
+	err := p.DB.QueryRow(`
+		SELECT 
+			sc.swift_code, b.name AS bank_name, b.name_ascii AS bank_name_ascii, sc.address, sc.address_ascii,
+			sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+			c.iso2_code AS country_iso2, c.name AS country_name, sc.is_headquarter, sc.deleted_at
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE sc.swift_code = $1 AND ($2 OR sc.deleted_at IS NULL);
+	`, swiftCode, includeDeleted).Scan(
+		&branch.SwiftCode, &branch.BankName, &branch.BankNameASCII, &branch.Address, &branch.AddressASCII,
+		&branch.TownName, &branch.PostalCode, &branch.Street, &branch.TimeZone,
+		&branch.CountryISO2, &branch.CountryName, &isHeadquarter, &branch.DeletedAt,
+	)
+	if err != nil {
+		return branch, notFound(err)
+	}
+	branch.IsHeadquarter = &isHeadquarter
+	return branch, nil
+}
+
+// swiftCodeDetailsColumns are the columns read by scanSwiftCodeDetails.
+const swiftCodeDetailsColumns = `sc.address, sc.address_ascii, sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+			b.name AS bank_name, b.name_ascii AS bank_name_ascii, c.iso2_code AS country_iso2, sc.is_headquarter, sc.swift_code, sc.deleted_at`
+
+// ListSwiftCodes returns SWIFT codes matching the filter ordered by SWIFT code.
+func (p *Postgres) ListSwiftCodes(filter SwiftCodeFilter, page Page) ([]models.SwiftCodeDetails, error) {
+	var conditions []string
+	var args []any
+	addCondition := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, "sc.deleted_at IS NULL")
+	}
+
+	if filter.CountryISO2 != "" {
+		addCondition("c.iso2_code = $%d", filter.CountryISO2)
+	}
+	if filter.BankKey != "" {
+		addCondition("b.name_key LIKE '%%' || $%d || '%%'", escapeLike(filter.BankKey))
+	}
+	if filter.Town != "" {
+		addCondition("upper(sc.town_name) = $%d", filter.Town)
+	}
+	if filter.IsHeadquarter != nil {
+		addCondition("sc.is_headquarter = $%d", *filter.IsHeadquarter)
+	}
+	if filter.Prefix != "" {
+		addCondition("sc.swift_code LIKE $%d || '%%'", filter.Prefix)
+	}
+	if len(page.After) > 0 {
+		addCondition("sc.swift_code > $%d", page.After[0])
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, page.Limit)
+
+	rows, err := p.DB.Query(fmt.Sprintf(`
+		SELECT %s
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		%s
+		ORDER BY sc.swift_code
+		LIMIT $%d;
+	`, swiftCodeDetailsColumns, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanSwiftCodeDetails(rows)
+}
+
+// GetCountrySwiftCodes returns all SWIFT codes of a country.
+func (p *Postgres) GetCountrySwiftCodes(countryISO2 string) (models.SwiftCodeByCountryISO2, error) {
+	countrySwiftCodes := models.SwiftCodeByCountryISO2{CountryISO2: countryISO2}
+	var swiftCodes sql.NullString
+	var countryName sql.NullString
+
+	err := p.DB.QueryRow(`
+		SELECT 
+			c.name AS country_name,
+			COALESCE(json_agg(json_build_object(
+				'bankName', b.name,
+				'bankNameAscii', b.name_ascii,
+				'address', sc.address,
+				'addressAscii', sc.address_ascii,
+				'townName', sc.town_name,
+				'postalCode', sc.postal_code,
+				'street', sc.street,
+				'timeZone', sc.time_zone,
+				'countryISO2', c.iso2_code,
+				'isHeadquarter', sc.is_headquarter,
+				'swiftCode', sc.swift_code
+			)) FILTER (WHERE sc.id IS NOT NULL), '[]'::json) AS swift_codes
+		FROM countries c
+		LEFT JOIN banks b ON b.country_id = c.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
+		WHERE c.iso2_code = $1
+		GROUP BY c.name;
+	`, countryISO2).Scan(&countryName, &swiftCodes)
+	if err != nil {
+		return countrySwiftCodes, notFound(err)
+	}
+
+	countrySwiftCodes.CountryName = countryName.String
+	if swiftCodes.Valid {
+		json.Unmarshal([]byte(swiftCodes.String), &countrySwiftCodes.SwiftCodes)
+	} else {
+		countrySwiftCodes.SwiftCodes = []models.SwiftCodeDetails{}
+	}
+	return countrySwiftCodes, nil
+}
+
+// ListCountrySwiftCodes returns a page of the SWIFT codes of a country with the total matching the filter.
+func (p *Postgres) ListCountrySwiftCodes(countryISO2 string, filter SwiftCodeFilter, page Page) (models.SwiftCodeByCountryISO2, error) {
+	args := []any{countryISO2}
+	filters := ""
+	if !filter.IncludeDeleted {
+		filters += " AND sc.deleted_at IS NULL"
+	}
+	if filter.IsHeadquarter != nil {
+		args = append(args, *filter.IsHeadquarter)
+		filters += fmt.Sprintf(" AND sc.is_headquarter = $%d", len(args))
+	}
+	if filter.Town != "" {
+		args = append(args, filter.Town)
+		filters += fmt.Sprintf(" AND upper(sc.town_name) = $%d", len(args))
+	}
+
+	countrySwiftCodes := models.SwiftCodeByCountryISO2{CountryISO2: countryISO2}
+	var total int
+
+	err := p.DB.QueryRow(`
+		SELECT c.name AS country_name, COUNT(sc.id) AS total
+		FROM countries c
+		LEFT JOIN banks b ON b.country_id = c.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id`+filters+`
+		WHERE c.iso2_code = $1
+		GROUP BY c.name;
+	`, args...).Scan(&countrySwiftCodes.CountryName, &total)
+	if err != nil {
+		return countrySwiftCodes, notFound(err)
+	}
+	countrySwiftCodes.Total = &total
+
+	orderBy := "sc.swift_code"
+	keyset := ""
+	if page.Sort == SortBankName {
+		orderBy = `b.name_key COLLATE "C", sc.swift_code`
+		if len(page.After) > 0 {
+			args = append(args, page.After[0], page.After[1])
+			keyset = fmt.Sprintf(` AND (b.name_key COLLATE "C" > $%d OR b.name_key = $%[1]d AND sc.swift_code > $%d)`, len(args)-1, len(args))
+		}
+	} else if len(page.After) > 0 {
+		args = append(args, page.After[0])
+		keyset = fmt.Sprintf(" AND sc.swift_code > $%d", len(args))
+	}
+	args = append(args, page.Limit, page.Offset)
+
+	rows, err := p.DB.Query(fmt.Sprintf(`
+		SELECT %s
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE c.iso2_code = $1%s%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d;
+	`, swiftCodeDetailsColumns, filters, keyset, orderBy, len(args)-1, len(args)), args...)
+	if err != nil {
+		return countrySwiftCodes, err
+	}
+
+	countrySwiftCodes.SwiftCodes, err = scanSwiftCodeDetails(rows)
+	return countrySwiftCodes, err
+}
+
+// scanSwiftCodeDetails reads rows of swiftCodeDetailsColumns, closing the rows when done.
+func scanSwiftCodeDetails(rows *sql.Rows) ([]models.SwiftCodeDetails, error) {
+	defer rows.Close()
+
+	swiftCodes := []models.SwiftCodeDetails{}
+	for rows.Next() {
+		var details models.SwiftCodeDetails
+		err := rows.Scan(
+			&details.Address, &details.AddressASCII, &details.TownName, &details.PostalCode, &details.Street, &details.TimeZone,
+			&details.BankName, &details.BankNameASCII, &details.CountryISO2, &details.IsHeadquarter, &details.SwiftCode, &details.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		swiftCodes = append(swiftCodes, details)
+	}
+	return swiftCodes, rows.Err()
+}
+
+const insertSwiftCodeQuery = `
+WITH country_ins AS (
+    INSERT INTO countries (iso2_code, name)
+    VALUES ($1, $2)
+    ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+    WHERE countries.name IS DISTINCT FROM EXCLUDED.name
+    RETURNING id
+), country_sel AS (
+    SELECT id FROM countries WHERE iso2_code = $1
+    UNION ALL
+    SELECT id FROM country_ins LIMIT 1
+), bank_ins AS (
+    INSERT INTO banks (name, name_key, name_ascii, country_id)
+    SELECT $3, $4, $5, id FROM country_sel
+    ON CONFLICT (name_key, country_id) DO NOTHING
+    RETURNING id
+), bank_sel AS (
+    SELECT id FROM banks WHERE name_key = $4 AND country_id = (SELECT id FROM country_sel)
+    UNION ALL
+    SELECT id FROM bank_ins LIMIT 1
+), swift_ins AS (
+    INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii, town_name, postal_code, street, time_zone)
+    SELECT $6, (SELECT id FROM bank_sel), $7, $8, $9, $10, $11, $12, $13
+    ON CONFLICT (swift_code) DO UPDATE
+    SET bank_id = EXCLUDED.bank_id, is_headquarter = EXCLUDED.is_headquarter, address = EXCLUDED.address,
+        address_ascii = EXCLUDED.address_ascii, town_name = EXCLUDED.town_name, postal_code = EXCLUDED.postal_code,
+        street = EXCLUDED.street, time_zone = EXCLUDED.time_zone, deleted_at = NULL
+    WHERE swift_codes.deleted_at IS NOT NULL
+    RETURNING swift_code
+)
+SELECT COUNT(*) FROM swift_ins;
+	`
+
+// insertSwiftCode inserts the country and bank when missing and then the SWIFT code.
+// An existing bank is matched by the search key of its name, keeping its stored casing.
+// A deleted SWIFT code is replaced and restored; ErrConflict is returned when the code already exists.
+func insertSwiftCode(q queryRower, body models.SwiftCodeBranch) error {
+	var insertedCount int
+	err := q.QueryRow(insertSwiftCodeQuery,
+		body.CountryISO2,
+		body.CountryName,
+		body.BankName,
+		validation.SearchKey(body.BankName),
+		body.BankNameASCII,
+		body.SwiftCode,
+		*body.IsHeadquarter,
+		body.Address,
+		body.AddressASCII,
+		body.TownName,
+		body.PostalCode,
+		body.Street,
+		body.TimeZone,
+	).Scan(&insertedCount)
+	if err == nil && insertedCount == 0 {
+		return ErrConflict
+	}
+	return err
+}
+
+// beginAs starts a transaction whose changes are recorded in the history as made by actor.
+func (p *Postgres) beginAs(actor string) (*sql.Tx, error) {
+	tx, err := p.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(`SELECT set_config('swift.actor', $1, true)`, actor); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return tx, nil
+}
+
+// CreateSwiftCode stores a new SWIFT code, returning ErrConflict when it already exists.
+// A deleted SWIFT code is replaced and restored.
+func (p *Postgres) CreateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error {
+	tx, err := p.beginAs(actor)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertSwiftCode(tx, swiftCode); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreateSwiftCodes stores the SWIFT codes in a single transaction, see SwiftCodeRepository.
+func (p *Postgres) CreateSwiftCodes(swiftCodes []models.SwiftCodeBranch, actor string) ([]error, error) {
+	tx, err := p.beginAs(actor)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	results := make([]error, 0, len(swiftCodes))
+	failed := false
+	for _, swiftCode := range swiftCodes {
+		err := insertSwiftCode(tx, swiftCode)
+		results = append(results, err)
+		if err != nil {
+			failed = true
+			if err != ErrConflict {
+				break
+			}
+		}
+	}
+
+	if failed {
+		return results, nil
+	}
+	return results, tx.Commit()
+}
+
+// UpdateSwiftCode stores a validated SWIFT code over the existing one that is not deleted. The country and bank
+// are created or renamed as needed and the previous bank is removed once it has no codes left.
+func (p *Postgres) UpdateSwiftCode(body models.SwiftCodeBranch, actor string) error {
+	tx, err := p.beginAs(actor)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var previousBankID string
+	err = tx.QueryRow(`SELECT bank_id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL FOR UPDATE`, body.SwiftCode).Scan(&previousBankID)
+	if err != nil {
+		return notFound(err)
+	}
+
+	_, err = tx.Exec(`
+WITH country_ups AS (
+    INSERT INTO countries (iso2_code, name)
+    VALUES ($1, $2)
+    ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+    RETURNING id
+), bank_ups AS (
+    INSERT INTO banks (name, name_key, name_ascii, country_id)
+    SELECT $3, $4, $5, id FROM country_ups
+    ON CONFLICT (name_key, country_id) DO UPDATE SET name = EXCLUDED.name, name_ascii = EXCLUDED.name_ascii
+    RETURNING id
+)
+UPDATE swift_codes
+SET bank_id = (SELECT id FROM bank_ups), is_headquarter = $7, address = $8, address_ascii = $9,
+    town_name = $10, postal_code = $11, street = $12, time_zone = $13
+WHERE swift_code = $6;
+	`,
+		body.CountryISO2,
+		body.CountryName,
+		body.BankName,
+		validation.SearchKey(body.BankName),
+		body.BankNameASCII,
+		body.SwiftCode,
+		*body.IsHeadquarter,
+		body.Address,
+		body.AddressASCII,
+		body.TownName,
+		body.PostalCode,
+		body.Street,
+		body.TimeZone,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM banks
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM swift_codes WHERE bank_id = $1);
+	`, previousBankID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteSwiftCode marks a SWIFT code as deleted with the delete_swift_code function.
+// Its bank and country are kept until the SWIFT code is purged.
+func (p *Postgres) DeleteSwiftCode(swiftCode, actor string) error {
+	tx, err := p.beginAs(actor)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var swiftDeleted bool
+	if err := tx.QueryRow(`SELECT delete_swift_code($1)`, swiftCode).Scan(&swiftDeleted); err != nil {
+		return err
+	}
+	if !swiftDeleted {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// RestoreSwiftCode undoes the deletion of a SWIFT code, returning ErrConflict when it is not deleted.
+func (p *Postgres) RestoreSwiftCode(swiftCode, actor string) error {
+	tx, err := p.beginAs(actor)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists, restored bool
+	err = tx.QueryRow(`
+		WITH swift_upd AS (
+			UPDATE swift_codes SET deleted_at = NULL
+			WHERE swift_code = $1 AND deleted_at IS NOT NULL
+			RETURNING id
+		)
+		SELECT EXISTS (SELECT 1 FROM swift_codes WHERE swift_code = $1), EXISTS (SELECT 1 FROM swift_upd);
+	`, swiftCode).Scan(&exists, &restored)
+	switch {
+	case err != nil:
+		return err
+	case !exists:
+		return ErrNotFound
+	case !restored:
+		return ErrConflict
+	}
+	return tx.Commit()
+}
+
+// PurgeSwiftCodes removes the SWIFT codes deleted before the given time with the purge_swift_codes
+// function, which also removes the banks and countries left without SWIFT codes.
+func (p *Postgres) PurgeSwiftCodes(deletedBefore time.Time) (int, error) {
+	tx, err := p.beginAs(PurgeActor)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var purged int
+	if err := tx.QueryRow(`SELECT purge_swift_codes($1)`, deletedBefore).Scan(&purged); err != nil {
+		return 0, err
+	}
+	return purged, tx.Commit()
+}
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"backend/internal/models"
+
+	"github.com/lib/pq"
+)
+
+// liveBankCondition matches the banks with a SWIFT code that is not deleted. Reads hide the other banks,
+// which are kept until the purge job removes them.
+const liveBankCondition = `EXISTS (SELECT 1 FROM swift_codes live WHERE live.bank_id = b.id AND live.deleted_at IS NULL)`
+
+// ListBanks returns banks ordered by the search key of their name in byte order and ID, optionally of a single country.
+func (p *Postgres) ListBanks(countryISO2 string, page Page) ([]models.BankSummary, error) {
+	conditions := []string{liveBankCondition}
+	var args []any
+
+	if countryISO2 != "" {
+		args = append(args, countryISO2)
+		conditions = append(conditions, fmt.Sprintf("c.iso2_code = $%d", len(args)))
+	}
+	if len(page.After) > 0 {
+		args = append(args, page.After[0], page.After[1])
+		conditions = append(conditions, fmt.Sprintf(`(b.name_key COLLATE "C" > $%d OR b.name_key = $%[1]d AND b.id > $%d)`, len(args)-1, len(args)))
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+	args = append(args, page.Limit)
+
+	rows, err := p.DB.Query(fmt.Sprintf(`
+		SELECT b.id, b.name, c.iso2_code AS country_iso2, c.name AS country_name,
+			COALESCE(array_agg(sc.swift_code ORDER BY sc.swift_code) FILTER (WHERE sc.is_headquarter), '{}') AS headquarter_swift_codes,
+			COUNT(sc.id) FILTER (WHERE NOT sc.is_headquarter) AS branch_count
+		FROM banks b
+		JOIN countries c ON b.country_id = c.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
+		%s
+		GROUP BY b.id, c.iso2_code, c.name
+		ORDER BY b.name_key COLLATE "C", b.id
+		LIMIT $%d;
+	`, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	banks := []models.BankSummary{}
+	for rows.Next() {
+		var bank models.BankSummary
+		if err := rows.Scan(&bank.ID, &bank.Name, &bank.CountryISO2, &bank.CountryName,
+			pq.Array(&bank.HeadquarterSwiftCodes), &bank.BranchCount); err != nil {
+			return nil, err
+		}
+		if bank.HeadquarterSwiftCodes == nil {
+			bank.HeadquarterSwiftCodes = []string{}
+		}
+		banks = append(banks, bank)
+	}
+	return banks, rows.Err()
+}
+
+// GetBank returns a bank with its headquarter and branch SWIFT codes.
+func (p *Postgres) GetBank(id string) (models.Bank, error) {
+	var bank models.Bank
+
+	err := p.DB.QueryRow(`
+		SELECT b.id, b.name, b.name_ascii, c.iso2_code AS country_iso2, c.name AS country_name
+		FROM banks b
+		JOIN countries c ON b.country_id = c.id
+		WHERE b.id = $1 AND `+liveBankCondition+`;
+	`, id).Scan(&bank.ID, &bank.Name, &bank.NameASCII, &bank.CountryISO2, &bank.CountryName)
+	if err != nil {
+		return bank, notFound(err)
+	}
+
+	rows, err := p.DB.Query(`
+		SELECT `+swiftCodeDetailsColumns+`
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE sc.bank_id = $1 AND sc.deleted_at IS NULL
+		ORDER BY sc.swift_code;
+	`, bank.ID)
+	if err != nil {
+		return bank, err
+	}
+
+	swiftCodes, err := scanSwiftCodeDetails(rows)
+	if err != nil {
+		return bank, err
+	}
+
+	bank.Headquarters = []models.SwiftCodeDetails{}
+	bank.Branches = []models.SwiftCodeDetails{}
+	for _, swiftCode := range swiftCodes {
+		if swiftCode.IsHeadquarter {
+			bank.Headquarters = append(bank.Headquarters, swiftCode)
+		} else {
+			bank.Branches = append(bank.Branches, swiftCode)
+		}
+	}
+	return bank, nil
+}
+
+// SearchBanks ranks exact, prefix and substring matches of the search key above trigram
+// similarity matches; with includeAddress the addresses of the bank's SWIFT codes are searched as well.
+func (p *Postgres) SearchBanks(key string, includeAddress bool, limit int) ([]models.BankSearchResult, error) {
+	addressScore := ""
+	addressMatch := ""
+	if includeAddress {
+		addressScore = `,
+			CASE WHEN EXISTS (
+				SELECT 1 FROM swift_codes sc
+				WHERE sc.bank_id = b.id AND sc.deleted_at IS NULL AND sc.address_ascii ILIKE '%' || $2 || '%'
+			) THEN 0.5 ELSE 0 END`
+		addressMatch = `
+			OR EXISTS (
+				SELECT 1 FROM swift_codes sc
+				WHERE sc.bank_id = b.id AND sc.deleted_at IS NULL AND sc.address_ascii ILIKE '%' || $2 || '%'
+			)`
+	}
+
+	rows, err := p.DB.Query(`
+		WITH matches AS (
+			SELECT b.id, b.name, b.country_id,
+				GREATEST(
+					CASE
+						WHEN b.name_key = $1 THEN 1.0
+						WHEN b.name_key LIKE $2 || '%' THEN 0.9
+						WHEN b.name_key LIKE '%' || $2 || '%' THEN 0.7
+						ELSE 0
+					END,
+					similarity(b.name_key, $1) * 0.6`+addressScore+`
+				) AS score
+			FROM banks b
+			WHERE (b.name_key LIKE '%' || $2 || '%'
+			OR b.name_key % $1`+addressMatch+`)
+			AND `+liveBankCondition+`
+		)
+		SELECT m.id AS bank_id, m.name AS bank_name, c.iso2_code AS country_iso2, c.name AS country_name,
+			hq.swift_code AS headquarter_swift_code, m.score
+		FROM matches m
+		JOIN countries c ON c.id = m.country_id
+		LEFT JOIN LATERAL (
+			SELECT sc.swift_code FROM swift_codes sc
+			WHERE sc.bank_id = m.id AND sc.is_headquarter = true AND sc.deleted_at IS NULL
+			ORDER BY sc.swift_code
+			LIMIT 1
+		) hq ON true
+		ORDER BY m.score DESC, m.name
+		LIMIT $3;
+	`, key, escapeLike(key), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []models.BankSearchResult{}
+	for rows.Next() {
+		var result models.BankSearchResult
+		if err := rows.Scan(&result.BankID, &result.BankName, &result.CountryISO2, &result.CountryName, &result.HeadquarterSwiftCode, &result.Score); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, rows.Err()
+}
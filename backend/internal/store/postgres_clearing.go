@@ -0,0 +1,151 @@
+package store
+
+import (
+	"backend/internal/models"
+)
+
+// GetSwiftCodeByBankCode returns the SWIFT code mapped to a national bank code of an IBAN.
+func (p *Postgres) GetSwiftCodeByBankCode(countryISO2, bankCode string) (models.SwiftCodeDetails, error) {
+	var swiftCode models.SwiftCodeDetails
+	err := p.DB.QueryRow(`
+		SELECT sc.address, sc.address_ascii, b.name AS bank_name, b.name_ascii AS bank_name_ascii,
+			c.iso2_code AS country_iso2, sc.is_headquarter, sc.swift_code
+		FROM national_bank_codes nbc
+		JOIN swift_codes sc ON sc.swift_code = nbc.swift_code
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE nbc.country_iso2 = $1 AND nbc.bank_code = $2 AND sc.deleted_at IS NULL;
+	`, countryISO2, bankCode).Scan(
+		&swiftCode.Address, &swiftCode.AddressASCII, &swiftCode.BankName, &swiftCode.BankNameASCII,
+		&swiftCode.CountryISO2, &swiftCode.IsHeadquarter, &swiftCode.SwiftCode,
+	)
+	return swiftCode, notFound(err)
+}
+
+// GetSwiftCodeByClearingCode returns the SWIFT code a clearing code is assigned to.
+func (p *Postgres) GetSwiftCodeByClearingCode(clearingCode models.ClearingCode) (models.SwiftCodeDetails, error) {
+	var swiftCode models.SwiftCodeDetails
+	err := p.DB.QueryRow(`
+		SELECT sc.address, sc.address_ascii, b.name AS bank_name, b.name_ascii AS bank_name_ascii,
+			c.iso2_code AS country_iso2, sc.is_headquarter, sc.swift_code
+		FROM clearing_codes cc
+		JOIN swift_codes sc ON cc.swift_code_id = sc.id
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE cc.scheme = $1 AND cc.code = $2 AND sc.deleted_at IS NULL;
+	`, clearingCode.Scheme, clearingCode.Code).Scan(
+		&swiftCode.Address, &swiftCode.AddressASCII, &swiftCode.BankName, &swiftCode.BankNameASCII,
+		&swiftCode.CountryISO2, &swiftCode.IsHeadquarter, &swiftCode.SwiftCode,
+	)
+	return swiftCode, notFound(err)
+}
+
+// ListClearingCodes returns the clearing codes of a SWIFT code ordered by scheme and code.
+func (p *Postgres) ListClearingCodes(swiftCode string) ([]models.ClearingCode, error) {
+	var swiftCodeID string
+	err := p.DB.QueryRow(`SELECT id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL`, swiftCode).Scan(&swiftCodeID)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	rows, err := p.DB.Query(`
+		SELECT scheme, code FROM clearing_codes
+		WHERE swift_code_id = $1
+		ORDER BY scheme, code;
+	`, swiftCodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	clearingCodes := []models.ClearingCode{}
+	for rows.Next() {
+		var clearingCode models.ClearingCode
+		if err := rows.Scan(&clearingCode.Scheme, &clearingCode.Code); err != nil {
+			return nil, err
+		}
+		clearingCodes = append(clearingCodes, clearingCode)
+	}
+	return clearingCodes, rows.Err()
+}
+
+// GetClearingCode returns a single clearing code of a SWIFT code.
+func (p *Postgres) GetClearingCode(swiftCode string, clearingCode models.ClearingCode) (models.ClearingCode, error) {
+	err := p.DB.QueryRow(`
+		SELECT cc.scheme, cc.code
+		FROM clearing_codes cc
+		JOIN swift_codes sc ON cc.swift_code_id = sc.id
+		WHERE sc.swift_code = $1 AND sc.deleted_at IS NULL AND cc.scheme = $2 AND cc.code = $3;
+	`, swiftCode, clearingCode.Scheme, clearingCode.Code).Scan(&clearingCode.Scheme, &clearingCode.Code)
+	return clearingCode, notFound(err)
+}
+
+// CreateClearingCode assigns a clearing code to a SWIFT code.
+func (p *Postgres) CreateClearingCode(swiftCode string, clearingCode models.ClearingCode) error {
+	var exists, inserted bool
+	err := p.DB.QueryRow(`
+		WITH swift_sel AS (
+			SELECT id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL
+		), clearing_ins AS (
+			INSERT INTO clearing_codes (swift_code_id, scheme, code)
+			SELECT id, $2, $3 FROM swift_sel
+			ON CONFLICT (scheme, code) DO NOTHING
+			RETURNING id
+		)
+		SELECT EXISTS (SELECT 1 FROM swift_sel), EXISTS (SELECT 1 FROM clearing_ins);
+	`, swiftCode, clearingCode.Scheme, clearingCode.Code).Scan(&exists, &inserted)
+	switch {
+	case err != nil:
+		return err
+	case !exists:
+		return ErrNotFound
+	case !inserted:
+		return ErrConflict
+	}
+	return nil
+}
+
+// UpdateClearingCode replaces a clearing code of a SWIFT code.
+func (p *Postgres) UpdateClearingCode(swiftCode string, previous, clearingCode models.ClearingCode) error {
+	var exists, conflict bool
+	err := p.DB.QueryRow(`
+		WITH target AS (
+			SELECT cc.id FROM clearing_codes cc
+			JOIN swift_codes sc ON cc.swift_code_id = sc.id
+			WHERE sc.swift_code = $1 AND sc.deleted_at IS NULL AND cc.scheme = $2 AND cc.code = $3
+		), conflict AS (
+			SELECT 1 FROM clearing_codes
+			WHERE scheme = $4 AND code = $5 AND id NOT IN (SELECT id FROM target)
+		), clearing_upd AS (
+			UPDATE clearing_codes SET scheme = $4, code = $5
+			WHERE id IN (SELECT id FROM target) AND NOT EXISTS (SELECT 1 FROM conflict)
+			RETURNING id
+		)
+		SELECT EXISTS (SELECT 1 FROM target), EXISTS (SELECT 1 FROM conflict);
+	`, swiftCode, previous.Scheme, previous.Code, clearingCode.Scheme, clearingCode.Code).Scan(&exists, &conflict)
+	switch {
+	case err != nil:
+		return err
+	case !exists:
+		return ErrNotFound
+	case conflict:
+		return ErrConflict
+	}
+	return nil
+}
+
+// DeleteClearingCode removes a clearing code from a SWIFT code.
+func (p *Postgres) DeleteClearingCode(swiftCode string, clearingCode models.ClearingCode) error {
+	result, err := p.DB.Exec(`
+		DELETE FROM clearing_codes cc
+		USING swift_codes sc
+		WHERE cc.swift_code_id = sc.id AND sc.swift_code = $1 AND sc.deleted_at IS NULL AND cc.scheme = $2 AND cc.code = $3;
+	`, swiftCode, clearingCode.Scheme, clearingCode.Code)
+	if err != nil {
+		return err
+	}
+	if deleted, _ := result.RowsAffected(); deleted == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
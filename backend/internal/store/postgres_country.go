@@ -0,0 +1,59 @@
+package store
+
+import (
+	"fmt"
+
+	"backend/internal/models"
+)
+
+// countryStatisticsQuery counts the banks, headquarters and branches of every country. Only SWIFT codes that are
+// not deleted are counted, and only the banks having one of them.
+const countryStatisticsQuery = `
+	SELECT c.iso2_code AS country_iso2, c.name AS country_name,
+		COUNT(DISTINCT sc.bank_id) AS bank_count,
+		COUNT(sc.id) FILTER (WHERE sc.is_headquarter) AS headquarter_count,
+		COUNT(sc.id) FILTER (WHERE NOT sc.is_headquarter) AS branch_count
+	FROM countries c
+	LEFT JOIN banks b ON b.country_id = c.id
+	LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
+	%s
+	GROUP BY c.id, c.iso2_code, c.name
+	ORDER BY c.iso2_code;
+`
+
+// ListCountries returns all countries with their statistics ordered by ISO2 code.
+func (p *Postgres) ListCountries() ([]models.Country, error) {
+	rows, err := p.DB.Query(fmt.Sprintf(countryStatisticsQuery, ""))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	countries := []models.Country{}
+	for rows.Next() {
+		var country models.Country
+		if err := rows.Scan(&country.CountryISO2, &country.CountryName, &country.BankCount,
+			&country.HeadquarterCount, &country.BranchCount); err != nil {
+			return nil, err
+		}
+		countries = append(countries, country)
+	}
+	return countries, rows.Err()
+}
+
+// GetCountry returns a single country with its statistics.
+func (p *Postgres) GetCountry(countryISO2 string) (models.Country, error) {
+	var country models.Country
+	err := p.DB.QueryRow(fmt.Sprintf(countryStatisticsQuery, "WHERE c.iso2_code = $1"), countryISO2).Scan(
+		&country.CountryISO2, &country.CountryName, &country.BankCount,
+		&country.HeadquarterCount, &country.BranchCount,
+	)
+	return country, notFound(err)
+}
+
+// CountryExists reports whether the country is stored.
+func (p *Postgres) CountryExists(countryISO2 string) (bool, error) {
+	var exists bool
+	err := p.DB.QueryRow(`SELECT EXISTS(SELECT 1 FROM countries WHERE iso2_code = $1)`, countryISO2).Scan(&exists)
+	return exists, err
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"encoding/json"
+	"time"
+
+	"backend/internal/models"
+)
+
+// unmarshalSnapshot decodes a SWIFT code recorded by the swift_code_snapshot function, nil for NULL.
+func unmarshalSnapshot(data []byte) (*models.SwiftCodeBranch, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var snapshot models.SwiftCodeBranch
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// GetSwiftCodeHistory returns the changes of a SWIFT code recorded by the swift_codes_history
+// trigger, oldest first, or ErrNotFound when none are recorded.
+func (p *Postgres) GetSwiftCodeHistory(swiftCode string) ([]models.SwiftCodeChange, error) {
+	rows, err := p.DB.Query(`
+		SELECT id, swift_code, operation, before, after, actor, changed_at
+		FROM swift_code_history
+		WHERE swift_code = $1
+		ORDER BY changed_at, id;
+	`, swiftCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []models.SwiftCodeChange{}
+	for rows.Next() {
+		var change models.SwiftCodeChange
+		var before, after []byte
+		if err := rows.Scan(&change.ID, &change.SwiftCode, &change.Operation, &before, &after,
+			&change.Actor, &change.ChangedAt); err != nil {
+			return nil, err
+		}
+		if change.Before, err = unmarshalSnapshot(before); err != nil {
+			return nil, err
+		}
+		if change.After, err = unmarshalSnapshot(after); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, ErrNotFound
+	}
+	return changes, nil
+}
+
+// ListSwiftCodesAsOf returns the SWIFT codes existing at the given time from the latest change of
+// every SWIFT code recorded until then, ordered by SWIFT code. Empty countryISO2 and prefix are not applied.
+func (p *Postgres) ListSwiftCodesAsOf(asOf time.Time, countryISO2, prefix string) ([]models.SwiftCodeBranch, error) {
+	rows, err := p.DB.Query(`
+		SELECT latest.after
+		FROM (
+			SELECT DISTINCT ON (swift_code) swift_code, after
+			FROM swift_code_history
+			WHERE changed_at <= $1 AND swift_code LIKE $2 || '%'
+			ORDER BY swift_code, changed_at DESC, id DESC
+		) latest
+		WHERE latest.after IS NOT NULL AND ($3 = '' OR latest.after->>'countryISO2' = $3)
+		ORDER BY latest.swift_code;
+	`, asOf, escapeLike(prefix), countryISO2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	swiftCodes := []models.SwiftCodeBranch{}
+	for rows.Next() {
+		var after []byte
+		if err := rows.Scan(&after); err != nil {
+			return nil, err
+		}
+		snapshot, err := unmarshalSnapshot(after)
+		if err != nil {
+			return nil, err
+		}
+		swiftCodes = append(swiftCodes, *snapshot)
+	}
+	return swiftCodes, rows.Err()
+}
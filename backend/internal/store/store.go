@@ -0,0 +1,140 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"backend/internal/models"
+)
+
+// Errors returned by the repositories. Implementations wrap their own errors, such as
+// sql.ErrNoRows, so that handlers do not depend on the storage backend.
+var (
+	ErrNotFound = errors.New("resource not found")
+	ErrConflict = errors.New("resource already exists")
+)
+
+// Store is the data layer used by the HTTP handlers.
+type Store interface {
+	SwiftCodeRepository
+	BankRepository
+	CountryRepository
+	ClearingCodeRepository
+	HistoryRepository
+}
+
+// SwiftCodeFilter narrows a listing of SWIFT codes. Zero values are not applied.
+type SwiftCodeFilter struct {
+	CountryISO2    string // upper case ISO2 code
+	BankKey        string // search key of a part of the bank name, see validation.SearchKey
+	Town           string // upper case town name
+	IsHeadquarter  *bool
+	Prefix         string // upper case prefix of the SWIFT code
+	IncludeDeleted bool   // list deleted SWIFT codes as well
+}
+
+// Operations recorded in the history of a SWIFT code.
+const (
+	OperationCreate  = "create"
+	OperationUpdate  = "update"
+	OperationDelete  = "delete"
+	OperationRestore = "restore"
+	OperationPurge   = "purge"
+)
+
+// PurgeActor is the actor recorded for SWIFT codes removed by PurgeSwiftCodes.
+const PurgeActor = "purge"
+
+// Sort orders of SWIFT code pages. SortBankName orders by the search key of the bank name in byte order,
+// which both stores share, and then by SWIFT code.
+const (
+	SortSwiftCode = "swiftCode"
+	SortBankName  = "bankName"
+)
+
+// Page selects a slice of an ordered listing. After holds the sort key of the last row
+// of the previous page (keyset pagination) and is exclusive with Offset.
+type Page struct {
+	Limit  int
+	Offset int
+	After  []string
+	Sort   string
+}
+
+// SwiftCodeRepository stores SWIFT codes together with their banks and countries.
+// Banks are matched by the search key of their name within a country and countries by ISO2 code;
+// both are created on demand and removed when deleted SWIFT codes are purged and none are left.
+// Deleted SWIFT codes are kept, hidden from reads unless asked for, until they are purged.
+// Every change is recorded in the history together with the actor making it.
+type SwiftCodeRepository interface {
+	// GetHeadquarter returns a headquarter SWIFT code with the branches sharing its 8 character prefix.
+	// With includeDeleted a deleted headquarter and deleted branches are returned as well.
+	GetHeadquarter(swiftCode string, includeDeleted bool) (models.SwiftCodeHeadquarter, error)
+	// GetSwiftCode returns a single SWIFT code of either kind, a deleted one only with includeDeleted.
+	GetSwiftCode(swiftCode string, includeDeleted bool) (models.SwiftCodeBranch, error)
+	// ListSwiftCodes returns SWIFT codes matching the filter ordered by SWIFT code.
+	ListSwiftCodes(filter SwiftCodeFilter, page Page) ([]models.SwiftCodeDetails, error)
+	// GetCountrySwiftCodes returns all SWIFT codes of a country.
+	GetCountrySwiftCodes(countryISO2 string) (models.SwiftCodeByCountryISO2, error)
+	// ListCountrySwiftCodes returns a page of the SWIFT codes of a country with the total matching the filter.
+	ListCountrySwiftCodes(countryISO2 string, filter SwiftCodeFilter, page Page) (models.SwiftCodeByCountryISO2, error)
+	// CreateSwiftCode stores a new SWIFT code, returning ErrConflict when it already exists.
+	// A deleted SWIFT code is replaced and restored.
+	CreateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error
+	// CreateSwiftCodes stores the SWIFT codes in a single transaction. It returns the outcome of each
+	// item attempted, stopping after the first failure other than ErrConflict; nothing is stored
+	// unless every item succeeded.
+	CreateSwiftCodes(swiftCodes []models.SwiftCodeBranch, actor string) ([]error, error)
+	// UpdateSwiftCode replaces an existing SWIFT code that is not deleted.
+	UpdateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error
+	// DeleteSwiftCode marks a SWIFT code as deleted, keeping its bank and country.
+	DeleteSwiftCode(swiftCode, actor string) error
+	// RestoreSwiftCode undoes the deletion of a SWIFT code, returning ErrConflict when it is not deleted.
+	RestoreSwiftCode(swiftCode, actor string) error
+	// PurgeSwiftCodes removes the SWIFT codes deleted before the given time with their clearing and
+	// national bank codes, then the banks and countries left without SWIFT codes. It returns the
+	// number of SWIFT codes removed. The removals are recorded as made by PurgeActor.
+	PurgeSwiftCodes(deletedBefore time.Time) (int, error)
+}
+
+// HistoryRepository reads the recorded changes of SWIFT codes.
+type HistoryRepository interface {
+	// GetSwiftCodeHistory returns the changes of a SWIFT code, oldest first, or ErrNotFound when none are recorded.
+	GetSwiftCodeHistory(swiftCode string) ([]models.SwiftCodeChange, error)
+	// ListSwiftCodesAsOf returns the SWIFT codes existing at the given time, as they were then, ordered by
+	// SWIFT code. Empty countryISO2 and prefix are not applied.
+	ListSwiftCodesAsOf(asOf time.Time, countryISO2, prefix string) ([]models.SwiftCodeBranch, error)
+}
+
+// BankRepository reads banks and searches them by name.
+type BankRepository interface {
+	// ListBanks returns banks ordered by the search key of their name in byte order and ID, optionally of a single country.
+	ListBanks(countryISO2 string, page Page) ([]models.BankSummary, error)
+	// GetBank returns a bank with its headquarter and branch SWIFT codes.
+	GetBank(id string) (models.Bank, error)
+	// SearchBanks returns the banks best matching a search key, see validation.SearchKey.
+	SearchBanks(key string, includeAddress bool, limit int) ([]models.BankSearchResult, error)
+}
+
+// CountryRepository reads countries with their statistics.
+type CountryRepository interface {
+	ListCountries() ([]models.Country, error)
+	GetCountry(countryISO2 string) (models.Country, error)
+	CountryExists(countryISO2 string) (bool, error)
+}
+
+// ClearingCodeRepository stores national clearing codes of SWIFT codes and resolves
+// national identifiers to SWIFT codes.
+type ClearingCodeRepository interface {
+	// GetSwiftCodeByBankCode returns the SWIFT code mapped to a national bank code of an IBAN.
+	GetSwiftCodeByBankCode(countryISO2, bankCode string) (models.SwiftCodeDetails, error)
+	// GetSwiftCodeByClearingCode returns the SWIFT code a clearing code is assigned to.
+	GetSwiftCodeByClearingCode(clearingCode models.ClearingCode) (models.SwiftCodeDetails, error)
+	ListClearingCodes(swiftCode string) ([]models.ClearingCode, error)
+	GetClearingCode(swiftCode string, clearingCode models.ClearingCode) (models.ClearingCode, error)
+	// CreateClearingCode assigns a clearing code, returning ErrConflict when it is already assigned.
+	CreateClearingCode(swiftCode string, clearingCode models.ClearingCode) error
+	// UpdateClearingCode replaces a clearing code, returning ErrConflict when the new one is already assigned.
+	UpdateClearingCode(swiftCode string, previous, clearingCode models.ClearingCode) error
+	DeleteClearingCode(swiftCode string, clearingCode models.ClearingCode) error
+}
@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	_ "time/tzdata" // IANA time zone database for hosts without one
+	"unicode/utf8"
+
+	"backend/internal/models"
+)
+
+// Regular expressions of the structured address fields. Letters of any script are accepted.
+var (
+	TownNameRegex   = regexp.MustCompile(`^[\p{L}\p{M}\p{N}\s.'()-]+$`) // letters, numbers, spaces and . ' ( ) -
+	PostalCodeRegex = regexp.MustCompile(`^[A-Za-z0-9 -]{1,20}$`)       // up to 20 letters, digits, spaces and dashes
+)
+
+// ValidateTimeZone reports whether name is an IANA time zone such as Europe/Warsaw.
+func ValidateTimeZone(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
+// DeriveAddress builds the free-text address from the structured address fields,
+// as "street, postal code town". It returns an empty string when none are set.
+func DeriveAddress(input models.SwiftCodeBranch) string {
+	var parts []string
+	if input.Street != "" {
+		parts = append(parts, input.Street)
+	}
+	if town := strings.TrimSpace(input.PostalCode + " " + input.TownName); town != "" {
+		parts = append(parts, town)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ValidateDerivedAddress checks that the address derived from the structured address fields is a valid
+// address, reporting the errors on the structured fields the client sent rather than on the address.
+func ValidateDerivedAddress(input models.SwiftCodeBranch) []models.FieldError {
+	var errors []models.FieldError
+
+	fields := []struct{ name, value string }{
+		{"street", input.Street},
+		{"postalCode", input.PostalCode},
+		{"townName", input.TownName},
+	}
+	if !fitsTextColumn(DeriveAddress(input)) {
+		for _, field := range fields {
+			if field.value != "" {
+				errors = append(errors, fieldError(field.name, CodeInvalid, "Street, postal code and town name together must fit the 255 characters of the address they are combined into when address is omitted"))
+			}
+		}
+		return errors
+	}
+	for _, field := range fields {
+		if field.value != "" && !IsValidText(field.value, AddressPunctuation) {
+			errors = append(errors, fieldError(field.name, CodeInvalid, fmt.Sprintf("Fields combined into the address when address is omitted must contain only letters, numbers, spaces and %s", AddressPunctuation)))
+		}
+	}
+	return errors
+}
+
+// validateAddressFields checks the optional structured address fields.
+func validateAddressFields(input models.SwiftCodeBranch) []models.FieldError {
+	var errors []models.FieldError
+
+	if input.TownName != "" && (utf8.RuneCountInString(input.TownName) > 100 || !TownNameRegex.MatchString(input.TownName)) {
+		errors = append(errors, fieldError("townName", CodeInvalid, "Town name must be at most 100 characters and contain only letters, numbers, spaces and . ' ( ) -"))
+	}
+	if input.PostalCode != "" && !PostalCodeRegex.MatchString(input.PostalCode) {
+		errors = append(errors, fieldError("postalCode", CodeInvalid, "Postal code must be at most 20 letters, digits, spaces and dashes"))
+	}
+	if input.Street != "" && (utf8.RuneCountInString(input.Street) > 255 || !IsValidText(input.Street, AddressPunctuation)) {
+		errors = append(errors, fieldError("street", CodeInvalid, fmt.Sprintf("Street must be at most 255 characters and contain only letters, numbers, spaces and %s", AddressPunctuation)))
+	}
+	if input.TimeZone != "" && !ValidateTimeZone(input.TimeZone) {
+		errors = append(errors, fieldError("timeZone", CodeInvalid, "Time zone must be an IANA time zone such as Europe/Warsaw"))
+	}
+
+	return errors
+}
@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	institutionCodeRegex = regexp.MustCompile(`^[A-Z]{4}$`)
+	locationCodeRegex    = regexp.MustCompile(`^[A-Z2-9][A-NP-Z0-9]$`) // no leading 0 or 1, no letter O in second place
+	branchCodeRegex      = regexp.MustCompile(`^(XXX|[A-WYZ0-9][A-Z0-9]{2})$`)
+)
+
+// BIC is a business identifier code (ISO 9362) split into its components.
+type BIC struct {
+	Institution string // characters 1-4
+	Country     string // characters 5-6
+	Location    string // characters 7-8
+	Branch      string // characters 9-11, XXX for the primary office
+}
+
+// IsTest reports whether the BIC is a test and training code.
+func (b BIC) IsTest() bool {
+	return b.Location[1] == '0'
+}
+
+// IsPassive reports whether the BIC belongs to a passive participant.
+func (b BIC) IsPassive() bool {
+	return b.Location[1] == '1'
+}
+
+// IsReverseBilling reports whether messages to the BIC are billed to the receiver.
+func (b BIC) IsReverseBilling() bool {
+	return b.Location[1] == '2'
+}
+
+// Participant types reported for accepted BICs whose location code marks a special participant.
+const (
+	ParticipantPassive        = "passive"
+	ParticipantReverseBilling = "reverseBilling"
+)
+
+// ParticipantType returns the participant type marked by the location code of a valid BIC, or ""
+// for an ordinary participant. Passive and reverse billing BICs are genuine codes and are accepted,
+// so the type is only reported to tell their owners that messages reach them differently.
+func ParticipantType(code string) string {
+	bic, errors := ParseBIC(code)
+	switch {
+	case len(errors) > 0:
+		return ""
+	case bic.IsPassive():
+		return ParticipantPassive
+	case bic.IsReverseBilling():
+		return ParticipantReverseBilling
+	default:
+		return ""
+	}
+}
+
+// ParseBIC splits an 8 or 11 character BIC into its components and checks each of them
+// against ISO 9362. The returned errors name the component that failed.
+func ParseBIC(code string) (BIC, []string) {
+	code = strings.ToUpper(code)
+	if !SwiftLookupRegex.MatchString(code) {
+		return BIC{}, []string{"SWIFT code must be 8 or 11 letters or digits"}
+	}
+
+	bic := BIC{
+		Institution: code[0:4],
+		Country:     code[4:6],
+		Location:    code[6:8],
+		Branch:      primaryOfficeBranchCode,
+	}
+	if len(code) == 11 {
+		bic.Branch = code[8:11]
+	}
+
+	var errors []string
+	if !institutionCodeRegex.MatchString(bic.Institution) {
+		errors = append(errors, "Institution code (characters 1-4) must be 4 letters")
+	}
+	if !IsCountryCode(bic.Country) {
+		errors = append(errors, "Country code (characters 5-6) must be an ISO 3166 country code")
+	}
+	if !locationCodeRegex.MatchString(bic.Location) {
+		errors = append(errors, "Location code (characters 7-8) must not start with 0 or 1 and must not contain the letter O in second place")
+	}
+	if !branchCodeRegex.MatchString(bic.Branch) {
+		errors = append(errors, "Branch code (characters 9-11) must not start with X unless it is XXX")
+	}
+
+	return bic, errors
+}
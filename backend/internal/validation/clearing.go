@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"backend/internal/models"
+)
+
+// clearingScheme describes the member identifier format of a national clearing system.
+// Schemes are named after the ISO 20022 external clearing system identification codes.
+type clearingScheme struct {
+	name     string
+	format   *regexp.Regexp
+	expected string
+	checksum func(code string) bool
+}
+
+var clearingSchemes = map[string]clearingScheme{
+	"ATBLZ": {"Austrian Bankleitzahl", regexp.MustCompile(`^[0-9]{5}$`), "5 digits", nil},
+	"AUBSB": {"Australian Bank State Branch code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+	"CACPA": {"Canadian Payments Association routing number", regexp.MustCompile(`^0[0-9]{8}$`), "9 digits starting with 0", nil},
+	"CHBCC": {"Swiss bank clearing code", regexp.MustCompile(`^[0-9]{3,5}$`), "3 to 5 digits", nil},
+	"CHSIC": {"Swiss SIC code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+	"CNAPS": {"Chinese CNAPS identifier", regexp.MustCompile(`^[0-9]{12}$`), "12 digits", nil},
+	"DEBLZ": {"German Bankleitzahl", regexp.MustCompile(`^[1-9][0-9]{7}$`), "8 digits not starting with 0", nil},
+	"ESNCC": {"Spanish domestic interbanking code", regexp.MustCompile(`^[0-9]{8,9}$`), "8 or 9 digits", nil},
+	"GBDSC": {"UK domestic sort code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+	"GRBIC": {"Hellenic Bank Identification Code", regexp.MustCompile(`^[0-9]{7}$`), "7 digits", nil},
+	"HKNCC": {"Hong Kong bank code", regexp.MustCompile(`^[0-9]{3}$`), "3 digits", nil},
+	"IENCC": {"Irish national clearing code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+	"INFSC": {"Indian Financial System Code", regexp.MustCompile(`^[A-Z]{4}0[A-Z0-9]{6}$`), "4 letters, 0 and 6 letters or digits", nil},
+	"ITNCC": {"Italian domestic identification code", regexp.MustCompile(`^[0-9]{10}$`), "10 digits", nil},
+	"JPZGN": {"Japan Zengin clearing code", regexp.MustCompile(`^[0-9]{7}$`), "7 digits", nil},
+	"NZNCC": {"New Zealand national clearing code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+	"PLKNR": {"Polish national clearing code", regexp.MustCompile(`^[0-9]{8}$`), "8 digits", nil},
+	"PTNCC": {"Portuguese national clearing code", regexp.MustCompile(`^[0-9]{8}$`), "8 digits", nil},
+	"RUCBC": {"Russian Central Bank identification code", regexp.MustCompile(`^[0-9]{9}$`), "9 digits", nil},
+	"SESBA": {"Swedish bank clearing number", regexp.MustCompile(`^[0-9]{4,5}$`), "4 or 5 digits", nil},
+	"SGIBG": {"Singapore interbank GIRO code", regexp.MustCompile(`^[0-9]{7}$`), "7 digits", nil},
+	"USABA": {"US ABA routing number", regexp.MustCompile(`^[0-9]{9}$`), "9 digits", abaChecksum},
+	"ZANCC": {"South African national clearing code", regexp.MustCompile(`^[0-9]{6}$`), "6 digits", nil},
+}
+
+// NormalizeClearingCode validates a clearing code against the format of its scheme and returns
+// the scheme and code in canonical form. Spaces and dashes, as in printed sort codes, are dropped.
+// Errors refer to the "scheme" and "code" fields.
+func NormalizeClearingCode(scheme, code string) (string, string, []models.FieldError) {
+	scheme = strings.ToUpper(strings.TrimSpace(scheme))
+	definition, ok := clearingSchemes[scheme]
+	if !ok {
+		return "", "", []models.FieldError{fieldError("scheme", CodeUnknown, fmt.Sprintf("Clearing scheme %q is not supported", scheme))}
+	}
+
+	code = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(code))
+	if !definition.format.MatchString(code) {
+		return "", "", []models.FieldError{fieldError("code", CodeInvalid, fmt.Sprintf("%s must be %s", definition.name, definition.expected))}
+	}
+	if definition.checksum != nil && !definition.checksum(code) {
+		return "", "", []models.FieldError{fieldError("code", CodeChecksum, fmt.Sprintf("%s has an invalid check digit", definition.name))}
+	}
+
+	return scheme, code, nil
+}
+
+// abaChecksum verifies the check digit of a 9 digit ABA routing number (weights 3, 7, 1).
+func abaChecksum(code string) bool {
+	weights := [9]int{3, 7, 1, 3, 7, 1, 3, 7, 1}
+	sum := 0
+	for i, r := range code {
+		sum += int(r-'0') * weights[i]
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"strings"
+	"unicode"
+)
+
+// Country is an entry of the ISO 3166-1 country registry.
+type Country struct {
+	Alpha2  string
+	Alpha3  string
+	Numeric string // empty for codes without an ISO numeric code, such as XK
+	Name    string // canonical name stored in the database
+	Aliases []string
+}
+
+// iso3166CSV lists the officially assigned ISO 3166-1 codes together with XK (Kosovo),
+// which is not assigned by ISO but is used in BICs.
+//
+//go:embed iso3166.csv
+var iso3166CSV string
+
+var countries = func() map[string]Country {
+	records, err := csv.NewReader(strings.NewReader(iso3166CSV)).ReadAll()
+	if err != nil {
+		panic("validation: invalid ISO 3166 registry: " + err.Error())
+	}
+
+	registry := make(map[string]Country, len(records)-1)
+	for _, record := range records[1:] {
+		country := Country{Alpha2: record[0], Alpha3: record[1], Numeric: record[2], Name: record[3]}
+		if record[4] != "" {
+			country.Aliases = strings.Split(record[4], "|")
+		}
+		registry[country.Alpha2] = country
+	}
+	return registry
+}()
+
+// LookupCountry returns the registry entry of an ISO 3166-1 alpha-2 code.
+func LookupCountry(alpha2 string) (Country, bool) {
+	country, ok := countries[strings.ToUpper(alpha2)]
+	return country, ok
+}
+
+// CanonicalCountryName returns the registered name of an ISO 3166-1 alpha-2 code,
+// or an empty string when the code is unknown.
+func CanonicalCountryName(alpha2 string) string {
+	country, _ := LookupCountry(alpha2)
+	return country.Name
+}
+
+// IsCountryCode reports whether code is a known ISO 3166-1 alpha-2 country code.
+func IsCountryCode(code string) bool {
+	_, ok := LookupCountry(code)
+	return ok
+}
+
+// MatchesName reports whether name refers to the country. Case, punctuation and
+// repeated spaces are ignored and the registered aliases are accepted as well.
+func (c Country) MatchesName(name string) bool {
+	name = countryNameKey(name)
+	if name == countryNameKey(c.Name) {
+		return true
+	}
+	for _, alias := range c.Aliases {
+		if name == countryNameKey(alias) {
+			return true
+		}
+	}
+	return false
+}
+
+// countryNameKey reduces a country name to upper case words separated by single spaces.
+func countryNameKey(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
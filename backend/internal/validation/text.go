@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Punctuation allowed besides letters, marks, numbers and spaces. The sets can be
+// overridden at startup with the NAME_PUNCTUATION and ADDRESS_PUNCTUATION variables.
+var (
+	NamePunctuation    = "&'’-.,()/"
+	AddressPunctuation = "&'’-.,()/#:"
+)
+
+// ConfigurePunctuation replaces the allowed punctuation sets with the non-empty values given.
+func ConfigurePunctuation(name, address string) {
+	if name != "" {
+		NamePunctuation = name
+	}
+	if address != "" {
+		AddressPunctuation = address
+	}
+}
+
+// NormalizeText composes the text to Unicode NFC, trims it and collapses runs of whitespace.
+func NormalizeText(value string) string {
+	return strings.Join(strings.Fields(norm.NFC.String(value)), " ")
+}
+
+// IsValidText reports whether the text has at least one letter or number and otherwise
+// only letters, combining marks, numbers, spaces and the given punctuation.
+func IsValidText(value, punctuation string) bool {
+	hasAlnum := false
+	for _, r := range value {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			hasAlnum = true
+		case unicode.IsMark(r) || r == ' ' || strings.ContainsRune(punctuation, r):
+		default:
+			return false
+		}
+	}
+	return hasAlnum
+}
+
+// SearchKey returns the case and accent insensitive form of a name used for matching,
+// so that "Banco de Crédito" and "BANCO DE CREDITO" share the key "BANCO DE CREDITO".
+func SearchKey(value string) string {
+	return strings.ToUpper(Transliterate(NormalizeText(value)))
+}
+
+// swiftCharacters is the SWIFT X character set allowed in FIN message fields besides letters and digits.
+const swiftCharacters = "/-?:().,'+ "
+
+// removeMarks decomposes characters and drops their accents, e.g. "É" becomes "E".
+var removeMarks = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// transliterations maps letters without a decomposition and Bulgarian Cyrillic to Latin letters,
+// and typographic punctuation to the SWIFT X character set. Lower case letters are mapped through
+// their upper case form.
+var transliterations = map[rune]string{
+	'ß': "ss", 'ı': "i", 'Æ': "AE", 'Œ': "OE", 'Ø': "O", 'Ł': "L", 'Đ': "D", 'Ð': "D", 'Þ': "TH", 'Ħ': "H",
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ж': "ZH", 'З': "Z", 'И': "I", 'Й': "Y",
+	'К': "K", 'Л': "L", 'М': "M", 'Н': "N", 'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U",
+	'Ф': "F", 'Х': "H", 'Ц': "TS", 'Ч': "CH", 'Ш': "SH", 'Щ': "SHT", 'Ъ': "A", 'Ь': "Y", 'Ю': "YU", 'Я': "YA",
+	'&': "+", '#': "", '"': "'", '’': "'", '‘': "'", '“': "'", '”': "'", '–': "-", '—': "-", '_': "-",
+}
+
+// Transliterate converts text to the SWIFT X character set used in FIN messages. Accents are
+// removed, other letters are transliterated and anything left unsupported becomes a period.
+// The casing of the text is kept, so "Шумен" becomes "Shumen".
+func Transliterate(value string) string {
+	value, _, _ = transform.String(removeMarks, value)
+	chars := []rune(value)
+
+	var b strings.Builder
+	for i, r := range chars {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(swiftCharacters, r)) {
+			b.WriteRune(r)
+			continue
+		}
+
+		latin, ok := transliterations[r]
+		if !ok {
+			latin, ok = transliterations[unicode.ToUpper(r)]
+		}
+		switch {
+		case !ok && unicode.IsSpace(r):
+			b.WriteRune(' ')
+		case !ok:
+			b.WriteRune('.')
+		case unicode.IsLower(r):
+			b.WriteString(strings.ToLower(latin))
+		case len(latin) > 1 && i+1 < len(chars) && unicode.IsLower(chars[i+1]):
+			b.WriteString(latin[:1] + strings.ToLower(latin[1:]))
+		default:
+			b.WriteString(latin)
+		}
+	}
+	return b.String()
+}
@@ -1,57 +1,132 @@
 package validation
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
+	"unicode/utf8"
 
 	"backend/internal/models"
 )
 
 // Regular expression definitions for input validation.
 var (
-	AlnumSpaceRegex = regexp.MustCompile(`^[A-Za-z0-9\s]+$`)     // letters, numbers and spaces
-	CountryIsoRegex = regexp.MustCompile(`^[A-Za-z]{2}$`)        // exactly 2 letters
-	SwiftCodeRegex  = regexp.MustCompile(`^[A-Za-z0-9]{11}$`)    // exactly 11 letters/digits
-	AddressRegex    = regexp.MustCompile(`^[A-Za-z0-9\s,.-/]+$`) // allows commas, periods, dashes and slashes
+	AlnumSpaceRegex  = regexp.MustCompile(`^[A-Za-z0-9\s]+$`)    // letters, numbers and spaces
+	CountryIsoRegex  = regexp.MustCompile(`^[A-Za-z]{2}$`)       // exactly 2 letters
+	SwiftCodeRegex   = regexp.MustCompile(`^[A-Za-z0-9]{11}$`)   // exactly 11 letters/digits
+	SwiftPrefixRegex = regexp.MustCompile(`^[A-Za-z0-9]{1,11}$`) // 1 to 11 letters/digits
+
+	SwiftLookupRegex = regexp.MustCompile(`^[A-Za-z0-9]{8}([A-Za-z0-9]{3})?$`)                    // 8 or 11 letters/digits
+	UUIDRegex        = regexp.MustCompile(`^[0-9A-Fa-f]{8}(-[0-9A-Fa-f]{4}){3}-[0-9A-Fa-f]{12}$`) // canonical UUID
+)
+
+// primaryOfficeBranchCode is the branch code of a bank's primary office.
+const primaryOfficeBranchCode = "XXX"
+
+// NormalizeSwiftCode validates a SWIFT code used for a lookup and returns it in its 11 character
+// upper case form. An 8 character BIC refers to the primary office and is completed with XXX.
+func NormalizeSwiftCode(swiftCode string) (string, bool) {
+	if !SwiftLookupRegex.MatchString(swiftCode) {
+		return "", false
+	}
+	swiftCode = strings.ToUpper(swiftCode)
+	if len(swiftCode) == 8 {
+		swiftCode += primaryOfficeBranchCode
+	}
+	return swiftCode, true
+}
+
+// Codes of field validation errors.
+const (
+	CodeRequired = "required" // the field is missing or empty
+	CodeInvalid  = "invalid"  // the value has the wrong format or length
+	CodeMismatch = "mismatch" // the value contradicts another field
+	CodeUnknown  = "unknown"  // the value is well-formed but not a known code
+	CodeChecksum = "checksum" // the check digits of the value are wrong
+
+	CodeType        = "type"        // the JSON value has the wrong type
+	CodeUnsupported = "unsupported" // the field is not accepted by the endpoint
 )
 
-func ValidateSwiftCodeBranch(input models.SwiftCodeBranch) []string {
-	var errors []string
+// maxTextLength is the length of the varchar(255) columns of names and addresses, which also
+// hold their transliterated forms and the search keys of bank names.
+const maxTextLength = 255
+
+// fitsTextColumn reports whether the text and its transliteration, which can be longer because
+// letters such as "Щ" become "SHT", both fit maxTextLength.
+func fitsTextColumn(value string) bool {
+	return utf8.RuneCountInString(value) <= maxTextLength && len(Transliterate(value)) <= maxTextLength
+}
+
+// fieldError returns the error of a single field.
+func fieldError(field, code, message string) models.FieldError {
+	return models.FieldError{Field: field, Code: code, Message: message}
+}
+
+// FieldNames returns the comma separated field names of the errors.
+func FieldNames(errors []models.FieldError) string {
+	names := make([]string, len(errors))
+	for i, e := range errors {
+		names[i] = e.Field
+	}
+	return strings.Join(names, ", ")
+}
+
+// ValidateSwiftCodeBranch checks the format of each field and returns the errors
+// keyed by the JSON field names, in the order of the fields.
+func ValidateSwiftCodeBranch(input models.SwiftCodeBranch) []models.FieldError {
+	var errors []models.FieldError
 
 	if !SwiftCodeRegex.MatchString(input.SwiftCode) {
-		errors = append(errors, "SWIFT code must be exactly 11 alphanumeric characters")
+		errors = append(errors, fieldError("swiftCode", CodeInvalid, "SWIFT code must be exactly 11 alphanumeric characters"))
+	} else if bic, bicErrors := ParseBIC(input.SwiftCode); len(bicErrors) > 0 {
+		for _, message := range bicErrors {
+			errors = append(errors, fieldError("swiftCode", CodeInvalid, message))
+		}
+	} else if bic.IsTest() {
+		errors = append(errors, fieldError("swiftCode", CodeInvalid, fmt.Sprintf("Location code %s (characters 7-8) marks a test and training BIC, which is not accepted", bic.Location)))
+	} else if !strings.EqualFold(bic.Country, input.CountryISO2) {
+		errors = append(errors, fieldError("swiftCode", CodeMismatch, "Country code (characters 5-6) of the SWIFT code must match the country ISO2 code"))
 	}
-	if len(input.BankName) > 255 || !AlnumSpaceRegex.MatchString(input.BankName) {
-		errors = append(errors, "Bank name must be at most 255 characters and contain only letters, numbers, and spaces")
+	if !fitsTextColumn(input.BankName) || !IsValidText(input.BankName, NamePunctuation) {
+		errors = append(errors, fieldError("bankName", CodeInvalid, fmt.Sprintf("Bank name must be at most 255 characters, also once transliterated to Latin letters, and contain only letters, numbers, spaces and %s", NamePunctuation)))
 	}
 	if !CountryIsoRegex.MatchString(input.CountryISO2) {
-		errors = append(errors, "Country ISO2 must be exactly 2 letters")
+		errors = append(errors, fieldError("countryISO2", CodeInvalid, "Country ISO2 must be exactly 2 letters"))
+	} else if country, ok := LookupCountry(input.CountryISO2); !ok {
+		errors = append(errors, fieldError("countryISO2", CodeUnknown, "Country ISO2 must be an ISO 3166 country code"))
+	} else if input.CountryName != "" && !country.MatchesName(input.CountryName) {
+		errors = append(errors, fieldError("countryName", CodeMismatch, fmt.Sprintf("Country name does not match country ISO2 %s (%s)", country.Alpha2, country.Name)))
 	}
-	if len(input.CountryName) > 100 || !AlnumSpaceRegex.MatchString(input.CountryName) {
-		errors = append(errors, "Country name must be at most 100 characters and contain only letters, numbers, and spaces")
+	if input.CountryName != "" && (utf8.RuneCountInString(input.CountryName) > 100 || !IsValidText(input.CountryName, NamePunctuation)) {
+		errors = append(errors, fieldError("countryName", CodeInvalid, fmt.Sprintf("Country name must be at most 100 characters and contain only letters, numbers, spaces and %s", NamePunctuation)))
 	}
-	if len(input.Address) > 255 || !AddressRegex.MatchString(input.Address) {
-		errors = append(errors, "Address must be at most 255 characters and contain only letters, numbers, spaces, commas, periods, dashes, and slashes")
+	if !fitsTextColumn(input.Address) || !IsValidText(input.Address, AddressPunctuation) {
+		errors = append(errors, fieldError("address", CodeInvalid, fmt.Sprintf("Address must be at most 255 characters, also once transliterated to Latin letters, and contain only letters, numbers, spaces and %s", AddressPunctuation)))
 	}
+	errors = append(errors, validateAddressFields(input)...)
 
 	return errors
 }
 
-func ValidateSwiftCodeFields(body models.SwiftCodeBranch) []string {
-	missingFields := []string{}
-	fields := map[string]*string{
-		"swift_code":   &body.SwiftCode,
-		"bank_name":    &body.BankName,
-		"country_iso2": &body.CountryISO2,
-		"country_name": &body.CountryName,
-		"address":      &body.Address,
-	}
-	for key, value := range fields {
-		if value == nil || *value == "" {
-			missingFields = append(missingFields, key)
+// ValidateSwiftCodeFields returns an error for each required field that is missing, in a fixed order.
+func ValidateSwiftCodeFields(body models.SwiftCodeBranch) []models.FieldError {
+	missingFields := []models.FieldError{}
+	fields := []struct {
+		name, label, value string
+	}{
+		{"swiftCode", "SWIFT code", body.SwiftCode},
+		{"bankName", "Bank name", body.BankName},
+		{"countryISO2", "Country ISO2", body.CountryISO2},
+		{"address", "Address", body.Address},
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			missingFields = append(missingFields, fieldError(field.name, CodeRequired, field.label+" is required"))
 		}
 	}
 	if body.IsHeadquarter == nil {
-		missingFields = append(missingFields, "is_headquarter")
+		missingFields = append(missingFields, fieldError("isHeadquarter", CodeRequired, "Headquarter status is required"))
 	}
 	return missingFields
 }
@@ -0,0 +1,68 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test retrieving a bank with its headquarters and branches
+func TestGetBank_Hierarchy(t *testing.T) {
+	t.Log("Testing retrieval of a bank with its SWIFT code hierarchy")
+
+	var bankID string
+	err := db.QueryRow(`
+		SELECT b.id FROM banks b JOIN swift_codes sc ON sc.bank_id = b.id
+		WHERE sc.swift_code = 'AAISALTRXXX'
+	`).Scan(&bankID)
+	assert.NoError(t, err)
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/banks/"+bankID, nil)
+	rec := httptest.NewRecorder()
+
+	handler.BanksHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.Bank
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, bankID, response.ID)
+	assert.Equal(t, "UNITED BANK OF ALBANIA SH.A", response.Name)
+	assert.Equal(t, "AL", response.CountryISO2)
+	assert.NotEmpty(t, response.Headquarters)
+	for _, branch := range response.Branches {
+		assert.False(t, branch.IsHeadquarter)
+	}
+}
+
+// test listing the banks of a country
+func TestGetBanksByCountry_Success(t *testing.T) {
+	t.Log("Testing listing of the banks of a valid country (AL)")
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/countries/AL/banks?limit=500", nil)
+	rec := httptest.NewRecorder()
+
+	handler.CountriesHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.BankList
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, response.Banks)
+	for _, bank := range response.Banks {
+		assert.Equal(t, "AL", bank.CountryISO2)
+	}
+}
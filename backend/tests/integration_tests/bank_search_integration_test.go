@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test searching banks by a lowercase name prefix
+func TestSearchBanks_Prefix(t *testing.T) {
+	t.Log("Testing bank search by a case-insensitive name prefix")
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=united%20bank%20of%20alb", nil)
+	rec := httptest.NewRecorder()
+
+	handler.SearchBanksHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.BankSearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.NotEmpty(t, response.Results)
+	assert.Equal(t, "UNITED BANK OF ALBANIA SH.A", response.Results[0].BankName)
+	assert.Equal(t, "AL", response.Results[0].CountryISO2)
+	if assert.NotNil(t, response.Results[0].HeadquarterSwiftCode) {
+		assert.Equal(t, "AAISALTRXXX", *response.Results[0].HeadquarterSwiftCode)
+	}
+}
+
+// test searching banks with a misspelled name
+func TestSearchBanks_Fuzzy(t *testing.T) {
+	t.Log("Testing bank search tolerates misspelled names")
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=unitd%20bnak%20of%20albania", nil)
+	rec := httptest.NewRecorder()
+
+	handler.SearchBanksHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.BankSearchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	found := false
+	for _, result := range response.Results {
+		if result.BankName == "UNITED BANK OF ALBANIA SH.A" {
+			found = true
+		}
+	}
+	assert.True(t, found, "fuzzy search should find the bank despite typos")
+}
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test assigning a clearing code to a SWIFT code and resolving it back
+func TestClearingCodes_CreateAndLookup(t *testing.T) {
+	t.Log("Testing clearing code assignment, reverse lookup and removal")
+
+	handler := handlers.NewHandler(db)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/AAISALTRXXX/clearing-codes", strings.NewReader(`{"scheme":"PLKNR","code":"99999999"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.SwiftHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/clearing-codes/PLKNR/99999999", nil)
+	rec = httptest.NewRecorder()
+	handler.GetClearingCodeHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var match models.ClearingCodeMatch
+	err := json.Unmarshal(rec.Body.Bytes(), &match)
+	assert.NoError(t, err)
+	assert.Equal(t, "AAISALTRXXX", match.SwiftCode.SwiftCode)
+
+	req = httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/AAISALTRXXX/clearing-codes/PLKNR/99999999", nil)
+	rec = httptest.NewRecorder()
+	handler.SwiftHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/clearing-codes/PLKNR/99999999", nil)
+	rec = httptest.NewRecorder()
+	handler.GetClearingCodeHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
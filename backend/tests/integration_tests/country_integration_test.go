@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test listing all countries with their statistics
+func TestListCountries_Success(t *testing.T) {
+	t.Log("Testing listing of loaded countries with statistics")
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/countries", nil)
+	rec := httptest.NewRecorder()
+
+	handler.CountriesHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.CountryList
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	found := false
+	for _, country := range response.Countries {
+		if country.CountryISO2 == "AL" {
+			found = true
+			assert.Equal(t, "ALBANIA", country.CountryName)
+			assert.Greater(t, country.BankCount, 0)
+			assert.Greater(t, country.HeadquarterCount, 0)
+		}
+	}
+	assert.True(t, found, "AL should be listed")
+}
+
+// test retrieving statistics of a single country
+func TestGetCountry_Statistics(t *testing.T) {
+	t.Log("Testing statistics of a valid country (AL) match the stored SWIFT codes")
+
+	var expected int
+	err := db.QueryRow(`
+		SELECT COUNT(*) FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE c.iso2_code = 'AL'
+	`).Scan(&expected)
+	assert.NoError(t, err)
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/countries/AL", nil)
+	rec := httptest.NewRecorder()
+
+	handler.CountriesHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.Country
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, expected, response.HeadquarterCount+response.BranchCount)
+}
@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/importer"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test resolving an IBAN through a bank code mapping loaded by the importer
+func TestGetIBAN_Resolved(t *testing.T) {
+	t.Log("Testing IBAN lookup resolves the SWIFT code mapped to its bank code")
+
+	rows, rejected, err := importer.ParseBankCodes(strings.NewReader("COUNTRY ISO2 CODE,NATIONAL BANK CODE,SWIFT CODE\nAL,21211009,AAISALTR\n"), ',')
+	assert.NoError(t, err)
+	assert.Empty(t, rejected)
+
+	results, err := importer.ImportBankCodes(db, rows, 10)
+	assert.NoError(t, err)
+	assert.NotEqual(t, importer.StatusRejected, results[0].Status)
+	defer db.Exec(`DELETE FROM national_bank_codes WHERE country_iso2 = 'AL' AND bank_code = '21211009'`)
+
+	handler := handlers.NewHandler(db)
+	req := httptest.NewRequest(http.MethodGet, "/v1/iban/AL47212110090000000235698741", nil)
+	rec := httptest.NewRecorder()
+
+	handler.GetIBANHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.IBANDetails
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "21211009", response.BankCode)
+	if assert.NotNil(t, response.SwiftCode) {
+		assert.Equal(t, "AAISALTRXXX", response.SwiftCode.SwiftCode)
+	}
+}
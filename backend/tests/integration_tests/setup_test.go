@@ -3,10 +3,14 @@ package tests
 import (
 	"database/sql"
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"backend/internal/auth"
+	"backend/internal/middleware"
+
 	"github.com/joho/godotenv"
 	_ "github.com/lib/pq"
 )
@@ -41,3 +45,8 @@ func TestMain(m *testing.M) {
 	db.Close()
 	os.Exit(code)
 }
+
+// asAdmin returns the request carrying an admin identity, for handlers called without the authentication.
+func asAdmin(r *http.Request) *http.Request {
+	return middleware.WithIdentity(r, auth.Identity{Role: auth.RoleAdmin})
+}
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test inserting several swift codes in one atomic batch
+func TestPostSwiftCodesBatch_Atomic(t *testing.T) {
+	t.Log("Testing atomic batch insertion of SWIFT codes")
+
+	defer db.Exec(`DELETE FROM swift_codes WHERE swift_code IN ('BTCHPLPWXXX', 'BTCHPLPW001')`)
+
+	handler := handlers.NewHandler(db)
+
+	body := `[
+		{"swiftCode": "BTCHPLPWXXX", "bankName": "Batch Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Batch Address", "isHeadquarter": true},
+		{"swiftCode": "BTCHPLPW001", "bankName": "Batch Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Batch Branch", "isHeadquarter": false}
+	]`
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var response models.SwiftCodeBatchResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, response.Created)
+
+	var count int
+	err = db.QueryRow(`SELECT COUNT(*) FROM swift_codes WHERE swift_code IN ('BTCHPLPWXXX', 'BTCHPLPW001')`).Scan(&count)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+// test that a duplicate swift code rolls back the whole atomic batch
+func TestPostSwiftCodesBatch_AtomicRollback(t *testing.T) {
+	t.Log("Testing atomic batch with a duplicate SWIFT code is rolled back")
+
+	handler := handlers.NewHandler(db)
+
+	body := `[
+		{"swiftCode": "ROLLPLPWXXX", "bankName": "Rollback Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Rollback Address", "isHeadquarter": true},
+		{"swiftCode": "ROLLPLPWXXX", "bankName": "Rollback Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Rollback Address", "isHeadquarter": true}
+	]`
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var exists bool
+	err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM swift_codes WHERE swift_code = 'ROLLPLPWXXX')`).Scan(&exists)
+	assert.NoError(t, err)
+	assert.False(t, exists, "rolled back batch should not leave any SWIFT code behind")
+}
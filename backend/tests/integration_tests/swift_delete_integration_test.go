@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"backend/internal/handlers"
+	"backend/internal/models"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -20,10 +21,11 @@ func TestDeleteSwiftCodeHandler_Success(t *testing.T) {
 	_, err := db.Exec(`
 		INSERT INTO countries (iso2_code, name) VALUES ('PL', 'Poland')
 		ON CONFLICT (iso2_code) DO NOTHING;
-		INSERT INTO banks (name, country_id) VALUES ('Test Bank', (SELECT id FROM countries WHERE iso2_code = 'PL'))
-		ON CONFLICT (name, country_id) DO NOTHING;
-		INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address)
-		VALUES ('ABCDEFGHXXX', (SELECT id FROM banks WHERE name = 'Test Bank' AND country_id = (SELECT id FROM countries WHERE iso2_code = 'PL')), true, 'Test Address')
+		INSERT INTO banks (name, name_key, name_ascii, country_id)
+		VALUES ('Test Bank', 'TEST BANK', 'Test Bank', (SELECT id FROM countries WHERE iso2_code = 'PL'))
+		ON CONFLICT (name_key, country_id) DO NOTHING;
+		INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii)
+		VALUES ('ABCDEFGHXXX', (SELECT id FROM banks WHERE name_key = 'TEST BANK' AND country_id = (SELECT id FROM countries WHERE iso2_code = 'PL')), true, 'Test Address', 'Test Address')
 		ON CONFLICT (swift_code) DO NOTHING;
 	`)
 	assert.NoError(t, err)
@@ -41,11 +43,26 @@ func TestDeleteSwiftCodeHandler_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "SWIFT code deleted successfully", response["message"])
 
-	// Verify that SWIFT code is deleted
-	var exists bool
-	err = db.QueryRow(`SELECT EXISTS(SELECT 1 FROM swift_codes WHERE swift_code = 'ABCDEFGHXXX')`).Scan(&exists)
+	// Verify that SWIFT code is marked as deleted and its bank is kept
+	var deleted, bankExists bool
+	err = db.QueryRow(`
+		SELECT sc.deleted_at IS NOT NULL, EXISTS(SELECT 1 FROM banks WHERE id = sc.bank_id)
+		FROM swift_codes sc WHERE sc.swift_code = 'ABCDEFGHXXX'
+	`).Scan(&deleted, &bankExists)
 	assert.NoError(t, err)
-	assert.False(t, exists, "SWIFT code should be deleted from database")
+	assert.True(t, deleted, "SWIFT code should be marked as deleted")
+	assert.True(t, bankExists, "bank should be kept until the SWIFT code is purged")
+
+	// Verify that the deleted SWIFT code is hidden and can be restored
+	req = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDEFGHXXX", nil)
+	rec = httptest.NewRecorder()
+	handler.SwiftHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/v1/swift-codes/ABCDEFGHXXX/restore", nil)
+	rec = httptest.NewRecorder()
+	handler.SwiftHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusOK, rec.Code)
 }
 
 // TestDeleteSwiftCodeHandler_NotFound verifies that trying to delete a non-existent SWIFT code returns 404.
@@ -60,10 +77,10 @@ func TestDeleteSwiftCodeHandler_NotFound(t *testing.T) {
 
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 
-	var response map[string]string
-	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	var problem models.Problem
+	err := json.Unmarshal(rec.Body.Bytes(), &problem)
 	assert.NoError(t, err)
-	assert.Equal(t, "SWIFT code not found, nothing to delete", response["message"])
+	assert.Equal(t, "SWIFT code not found, nothing to delete", problem.Detail)
 }
 
 // TestDeleteSwiftCodeHandler_InvalidFormat verifies that an invalid SWIFT code format results in a 400 error.
@@ -77,7 +94,7 @@ func TestDeleteSwiftCodeHandler_InvalidFormat(t *testing.T) {
 	handler.DeleteSwiftCodeHandler(rec, req)
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
-	assert.Contains(t, rec.Body.String(), "Invalid SWIFT code format – it must be exactly 11 letters or digits.")
+	assert.Contains(t, rec.Body.String(), "Invalid SWIFT code format – it must be 8 or 11 letters or digits.")
 }
 
 // TestDeleteSwiftCodeHandler_DatabaseError verifies that a database error results in a 500 response.
@@ -21,7 +21,7 @@ func TestGetSwiftCodesByCountry_Success(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/AL", nil)
 	rec := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(rec, req)
+	handler.GetSwiftCodesByCountryHandler(rec, asAdmin(req))
 
 	assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -31,6 +31,8 @@ func TestGetSwiftCodesByCountry_Success(t *testing.T) {
 
 	assert.Equal(t, "AL", response.CountryISO2)
 	assert.Equal(t, "ALBANIA", response.CountryName)
+	assert.Equal(t, "ALB", response.CountryISO3)
+	assert.Equal(t, "008", response.CountryNumeric)
 	assert.Greater(t, len(response.SwiftCodes), 0) // should return at least 1 swift code
 }
 
@@ -42,7 +44,7 @@ func TestGetSwiftCodesByCountry_NotFound(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/XX", nil)
 	rec := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(rec, req)
+	handler.GetSwiftCodesByCountryHandler(rec, asAdmin(req))
 
 	assert.Equal(t, http.StatusNotFound, rec.Code)
 }
@@ -55,7 +57,41 @@ func TestGetSwiftCodesByCountry_InvalidFormat(t *testing.T) {
 	req := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/INVALID!@#", nil)
 	rec := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(rec, req)
+	handler.GetSwiftCodesByCountryHandler(rec, asAdmin(req))
 
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 }
+
+// test retrieving swift codes by country page by page sorted by bank name
+func TestGetSwiftCodesByCountry_Paginated(t *testing.T) {
+	t.Log("Testing paginated retrieval of SWIFT codes for a valid country (AL)")
+
+	handler := handlers.NewHandler(db)
+
+	total := -1
+	fetched := 0
+	target := "/v1/swift-codes/country/AL?sort=bankName&limit=3"
+	for {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetSwiftCodesByCountryHandler(rec, asAdmin(req))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.SwiftCodeByCountryISO2
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.NotNil(t, response.Total)
+
+		total = *response.Total
+		fetched += len(response.SwiftCodes)
+
+		if response.Next == nil {
+			break
+		}
+		target = "/v1/swift-codes/country/AL?sort=bankName&limit=3&cursor=" + *response.Next
+	}
+
+	assert.Equal(t, total, fetched)
+}
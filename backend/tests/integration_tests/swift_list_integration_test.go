@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// test walking through all swift codes of a country page by page
+func TestListSwiftCodes_Pagination(t *testing.T) {
+	t.Log("Testing keyset pagination over the SWIFT codes of a country")
+
+	handler := handlers.NewHandler(db)
+
+	var expected int
+	err := db.QueryRow(`
+		SELECT COUNT(*) FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE c.iso2_code = 'AL'
+	`).Scan(&expected)
+	assert.NoError(t, err)
+
+	seen := map[string]bool{}
+	previous := ""
+	target := "/v1/swift-codes?country=AL&limit=5"
+	for {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		rec := httptest.NewRecorder()
+
+		handler.SwiftHandler(rec, asAdmin(req))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response models.SwiftCodeList
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		for _, code := range response.SwiftCodes {
+			assert.Equal(t, "AL", code.CountryISO2)
+			assert.Greater(t, code.SwiftCode, previous, "codes should be sorted by SWIFT code")
+			assert.False(t, seen[code.SwiftCode], "codes should not repeat across pages")
+			seen[code.SwiftCode] = true
+			previous = code.SwiftCode
+		}
+
+		if response.Next == nil {
+			break
+		}
+		target = "/v1/swift-codes?country=AL&limit=5&cursor=" + *response.Next
+	}
+
+	assert.Equal(t, expected, len(seen))
+}
+
+// test filtering swift codes by the town of a structured address
+func TestListSwiftCodes_TownFilter(t *testing.T) {
+	t.Log("Testing listing of SWIFT codes in a single town")
+
+	handler := handlers.NewHandler(db)
+	defer db.Exec(`DELETE FROM swift_codes WHERE swift_code = 'TOWNPLPWXXX'`)
+
+	body := `{
+		"swiftCode": "TOWNPLPWXXX",
+		"bankName": "Town Bank",
+		"countryISO2": "PL",
+		"street": "ul. Piotrkowska 1",
+		"postalCode": "90-001",
+		"townName": "Łódź",
+		"timeZone": "Europe/Warsaw",
+		"isHeadquarter": true
+	}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.PostSwiftCodeHandler(rec, req)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/swift-codes?town="+url.QueryEscape("łódź"), nil)
+	rec = httptest.NewRecorder()
+	handler.SwiftHandler(rec, asAdmin(req))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response models.SwiftCodeList
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	assert.NoError(t, err)
+
+	assert.Len(t, response.SwiftCodes, 1)
+	assert.Equal(t, "TOWNPLPWXXX", response.SwiftCodes[0].SwiftCode)
+	assert.Equal(t, "ul. Piotrkowska 1, 90-001 Łódź", response.SwiftCodes[0].Address)
+	assert.Equal(t, "ul. Piotrkowska 1, 90-001 Lodz", response.SwiftCodes[0].AddressASCII)
+	assert.Equal(t, "Europe/Warsaw", response.SwiftCodes[0].TimeZone)
+}
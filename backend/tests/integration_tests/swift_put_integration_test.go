@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// insert a swift code used by the update tests
+func seedUpdateSwiftCode(t *testing.T) {
+	_, err := db.Exec(`
+		INSERT INTO countries (iso2_code, name) VALUES ('PL', 'POLAND')
+		ON CONFLICT (iso2_code) DO NOTHING;
+		INSERT INTO banks (name, name_key, name_ascii, country_id)
+		VALUES ('UPDATE BANK', 'UPDATE BANK', 'UPDATE BANK', (SELECT id FROM countries WHERE iso2_code = 'PL'))
+		ON CONFLICT (name_key, country_id) DO NOTHING;
+		INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii)
+		VALUES ('UPDTPLPWXXX', (SELECT id FROM banks WHERE name_key = 'UPDATE BANK' AND country_id = (SELECT id FROM countries WHERE iso2_code = 'PL')), true, 'OLD ADDRESS', 'OLD ADDRESS')
+		ON CONFLICT (swift_code) DO NOTHING;
+	`)
+	assert.NoError(t, err)
+}
+
+// test replacing an existing swift code
+func TestPutSwiftCode_Success(t *testing.T) {
+	t.Log("Testing successful SWIFT code replacement")
+
+	seedUpdateSwiftCode(t)
+	defer db.Exec(`SELECT delete_swift_code('UPDTPLPWXXX')`)
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "UPDTPLPWXXX",
+		"bankName": "Renamed Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"address": "New Address",
+		"isHeadquarter": true
+	}`
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/UPDTPLPWXXX", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.SwiftHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var bankName, address string
+	err := db.QueryRow(`
+		SELECT b.name, sc.address FROM swift_codes sc JOIN banks b ON sc.bank_id = b.id
+		WHERE sc.swift_code = 'UPDTPLPWXXX'
+	`).Scan(&bankName, &address)
+	assert.NoError(t, err)
+	assert.Equal(t, "Renamed Bank", bankName)
+	assert.Equal(t, "New Address", address)
+
+	var oldBankExists bool
+	err = db.QueryRow(`SELECT EXISTS(SELECT 1 FROM banks WHERE name = 'UPDATE BANK')`).Scan(&oldBankExists)
+	assert.NoError(t, err)
+	assert.False(t, oldBankExists, "bank without SWIFT codes should be removed")
+}
+
+// test partially updating an existing swift code
+func TestPatchSwiftCode_Success(t *testing.T) {
+	t.Log("Testing successful partial SWIFT code update")
+
+	seedUpdateSwiftCode(t)
+	defer db.Exec(`SELECT delete_swift_code('UPDTPLPWXXX')`)
+
+	handler := handlers.NewHandler(db)
+
+	req := httptest.NewRequest(http.MethodPatch, "/v1/swift-codes/UPDTPLPWXXX", strings.NewReader(`{"address": "Patched Address"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.SwiftHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var address string
+	err := db.QueryRow(`SELECT address FROM swift_codes WHERE swift_code = 'UPDTPLPWXXX'`).Scan(&address)
+	assert.NoError(t, err)
+	assert.Equal(t, "Patched Address", address)
+}
+
+// test updating a non-existent swift code
+func TestPutSwiftCode_NotFound(t *testing.T) {
+	t.Log("Testing replacement of a non-existent SWIFT code should return 404 Not Found")
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"address": "Test Address",
+		"isHeadquarter": true
+	}`
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/NOPEPLPWXXX", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	handler.SwiftHandler(rec, asAdmin(req))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
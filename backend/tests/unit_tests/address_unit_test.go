@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"testing"
+
+	"backend/internal/models"
+	"backend/internal/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateTimeZone verifies that only IANA time zone names are accepted.
+func TestValidateTimeZone(t *testing.T) {
+	t.Log("Testing validation of IANA time zones")
+
+	assert.True(t, validation.ValidateTimeZone("Europe/Warsaw"))
+	assert.True(t, validation.ValidateTimeZone("America/Montevideo"))
+	assert.True(t, validation.ValidateTimeZone("UTC"))
+
+	assert.False(t, validation.ValidateTimeZone(""))
+	assert.False(t, validation.ValidateTimeZone("Local"))
+	assert.False(t, validation.ValidateTimeZone("Europe/Atlantis"))
+	assert.False(t, validation.ValidateTimeZone("CET+1"))
+}
+
+// TestDeriveAddress verifies that the free-text address is built from the structured address fields.
+func TestDeriveAddress(t *testing.T) {
+	t.Log("Testing derivation of the address from street, postal code and town name")
+
+	assert.Equal(t, "UL. MARSZAŁKOWSKA 1, 00-950 WARSZAWA", validation.DeriveAddress(models.SwiftCodeBranch{
+		Street: "UL. MARSZAŁKOWSKA 1", PostalCode: "00-950", TownName: "WARSZAWA",
+	}))
+	assert.Equal(t, "TIRANA", validation.DeriveAddress(models.SwiftCodeBranch{TownName: "TIRANA"}))
+	assert.Equal(t, "", validation.DeriveAddress(models.SwiftCodeBranch{TimeZone: "Europe/Tirane"}))
+}
+
+// TestValidateSwiftCodeBranch_StructuredAddress verifies that non-ASCII addresses are accepted
+// and that malformed structured address fields are reported.
+func TestValidateSwiftCodeBranch_StructuredAddress(t *testing.T) {
+	t.Log("Testing validation of the structured address fields")
+
+	isHeadquarter := true
+	branch := models.SwiftCodeBranch{
+		SwiftCode:     "ABCDPLPWXXX",
+		BankName:      "Test Bank",
+		CountryISO2:   "PL",
+		Address:       "ul. Świętokrzyska 11/21, 00-919 Łódź",
+		TownName:      "Łódź",
+		PostalCode:    "00-919",
+		Street:        "ul. Świętokrzyska 11/21",
+		TimeZone:      "Europe/Warsaw",
+		IsHeadquarter: &isHeadquarter,
+	}
+	assert.Empty(t, validation.ValidateSwiftCodeBranch(branch))
+
+	branch.TownName = "Łódź; DROP"
+	branch.PostalCode = "00_919"
+	branch.TimeZone = "Mars/Olympus_Mons"
+	errors := validation.ValidateSwiftCodeBranch(branch)
+	assert.Equal(t, "townName, postalCode, timeZone", validation.FieldNames(errors))
+	assert.Contains(t, errors, models.FieldError{
+		Field: "timeZone", Code: validation.CodeInvalid, Message: "Time zone must be an IANA time zone such as Europe/Warsaw",
+	})
+}
@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/audit"
+	"backend/internal/handlers"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// newAuditedHandler returns the SWIFT code handler of an in-memory store wrapped like the server does,
+// recording write requests in a file sink.
+func newAuditedHandler(t *testing.T) (*handlers.Handler, http.Handler) {
+	sink, err := audit.OpenFile(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	t.Cleanup(func() { sink.Close() })
+
+	handler := handlers.NewStoreHandler(store.NewMemory())
+	handler.Audit = sink
+	return handler, middleware.RequestIDMiddleware(middleware.AuditMiddleware(sink)(middleware.NoAuthMiddleware(http.HandlerFunc(handler.SwiftHandler))))
+}
+
+// TestAuditMiddleware_RecordsWrites verifies that write requests are recorded with their caller and
+// validation failures, while reads are not recorded.
+func TestAuditMiddleware_RecordsWrites(t *testing.T) {
+	t.Log("Testing that writes and validation failures are recorded in the audit log")
+	handler, audited := newAuditedHandler(t)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(`{
+		"address": "Test Address",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"isHeadquarter": false,
+		"swiftCode": "ABCDPLPW001"
+	}`))
+	r.Header.Set("X-Actor", "alice")
+	r.Header.Set(middleware.RequestIDHeader, "req-1")
+	r.RemoteAddr = "192.0.2.10:52000"
+	w := httptest.NewRecorder()
+	audited.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "req-1", w.Header().Get(middleware.RequestIDHeader))
+
+	r = httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(`{"swiftCode": "ABC"}`))
+	w = httptest.NewRecorder()
+	audited.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPW001", nil)
+	w = httptest.NewRecorder()
+	audited.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	entries, err := handler.Audit.List(audit.Filter{})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		rejected, created := entries[0], entries[1]
+
+		assert.Equal(t, int64(1), created.ID)
+		assert.Equal(t, middleware.AnonymousActor, created.Actor)
+		assert.Equal(t, "alice", created.ClaimedActor)
+		assert.Equal(t, "192.0.2.10", created.IP)
+		assert.Equal(t, "req-1", created.RequestID)
+		assert.Equal(t, http.MethodPost, created.Method)
+		assert.Equal(t, "/v1/swift-codes", created.Path)
+		assert.Equal(t, http.StatusCreated, created.Status)
+		assert.Empty(t, created.Errors)
+
+		assert.Equal(t, int64(2), rejected.ID)
+		assert.Equal(t, "anonymous", rejected.Actor)
+		assert.NotEmpty(t, rejected.RequestID)
+		assert.Equal(t, http.StatusBadRequest, rejected.Status)
+		assert.NotEmpty(t, rejected.Detail)
+		assert.NotEmpty(t, rejected.Errors)
+	}
+}
+
+// TestAuditMiddleware_RecordsErrorsOfOtherResponses verifies that the field errors of a rejected atomic batch,
+// whose response is a result list, and the detail of a DELETE of an unknown SWIFT code are recorded.
+func TestAuditMiddleware_RecordsErrorsOfOtherResponses(t *testing.T) {
+	t.Log("Testing that batch and DELETE failures are recorded with their errors")
+	handler, audited := newAuditedHandler(t)
+	batch := middleware.AuditMiddleware(handler.Audit)(middleware.NoAuthMiddleware(http.HandlerFunc(handler.PostSwiftCodesBatchHandler)))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(`[
+		{"address": "Test Address", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "isHeadquarter": false, "swiftCode": "ABCDPLPW001"},
+		{"address": "Test Address", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "isHeadquarter": false, "swiftCode": "ABC"}
+	]`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	batch.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	r = httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/WXYZPLPW001", nil)
+	w = httptest.NewRecorder()
+	audited.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	entries, err := handler.Audit.List(audit.Filter{})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		deleted, batched := entries[0], entries[1]
+
+		assert.Equal(t, http.StatusBadRequest, batched.Status)
+		assert.Contains(t, batched.Detail, "1")
+		if assert.NotEmpty(t, batched.Errors) {
+			assert.Equal(t, "[1].swiftCode", batched.Errors[0].Field)
+		}
+
+		assert.Equal(t, http.StatusNotFound, deleted.Status)
+		assert.Equal(t, "SWIFT code not found, nothing to delete", deleted.Detail)
+	}
+}
+
+// TestAuditHandler_Filters verifies that the audit log is filtered by actor and time range and paged with a cursor.
+func TestAuditHandler_Filters(t *testing.T) {
+	t.Log("Testing filtering and paging of the audit log")
+	handler, _ := newAuditedHandler(t)
+
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, actor := range []string{"alice", "bob", "alice", "alice"} {
+		assert.NoError(t, handler.Audit.Record(models.AuditEntry{
+			Time:   base.Add(time.Duration(i) * 24 * time.Hour),
+			Actor:  actor,
+			Method: http.MethodPut,
+			Path:   "/v1/swift-codes/ABCDPLPW001",
+			Status: http.StatusOK,
+		}))
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/audit?actor=alice&from=2026-03-02&limit=1", nil)
+	w := httptest.NewRecorder()
+	handler.AuditHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var page models.AuditLog
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+	if assert.Len(t, page.Entries, 1) && assert.NotNil(t, page.Next) {
+		assert.Equal(t, int64(4), page.Entries[0].ID)
+
+		r = httptest.NewRequest(http.MethodGet, "/v1/audit?actor=alice&from=2026-03-02&limit=1&cursor="+*page.Next, nil)
+		w = httptest.NewRecorder()
+		handler.AuditHandler(w, asAdmin(r))
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		page = models.AuditLog{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		if assert.Len(t, page.Entries, 1) {
+			assert.Equal(t, int64(3), page.Entries[0].ID)
+		}
+		assert.Nil(t, page.Next)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/audit?from=2026-03-01&to=2026-03-03", nil)
+	w = httptest.NewRecorder()
+	handler.AuditHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	page = models.AuditLog{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+	assert.Len(t, page.Entries, 2)
+}
+
+// TestAuditHandler_InvalidRange verifies that a time range ending before it starts is rejected.
+func TestAuditHandler_InvalidRange(t *testing.T) {
+	t.Log("Testing that an inverted time range returns 400 Bad Request")
+	handler, _ := newAuditedHandler(t)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/audit?from=2026-03-05&to=2026-03-01", nil)
+	w := httptest.NewRecorder()
+	handler.AuditHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "from must be before to")
+}
+
+// TestAuditHandler_Disabled verifies that the audit endpoint returns 404 without a sink.
+func TestAuditHandler_Disabled(t *testing.T) {
+	t.Log("Testing that the audit endpoint returns 404 Not Found when the audit log is disabled")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/audit", nil)
+	w := httptest.NewRecorder()
+	handler.AuditHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestAuditFile_Reopen verifies that a reopened audit file keeps its entries and continues their IDs.
+func TestAuditFile_Reopen(t *testing.T) {
+	t.Log("Testing that IDs continue after reopening an audit file")
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	sink, err := audit.OpenFile(path)
+	assert.NoError(t, err)
+	assert.NoError(t, sink.Record(models.AuditEntry{Time: time.Now(), Actor: "alice", Method: http.MethodDelete}))
+	assert.NoError(t, sink.Close())
+
+	sink, err = audit.OpenFile(path)
+	assert.NoError(t, err)
+	defer sink.Close()
+	assert.NoError(t, sink.Record(models.AuditEntry{Time: time.Now(), Actor: "bob", Method: http.MethodPost}))
+
+	entries, err := sink.List(audit.Filter{})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		assert.Equal(t, int64(2), entries[0].ID)
+		assert.Equal(t, "bob", entries[0].Actor)
+		assert.Equal(t, int64(1), entries[1].ID)
+	}
+}
+
+// TestAuditPostgres_List verifies the query of the database sink and the decoding of field errors.
+func TestAuditPostgres_List(t *testing.T) {
+	t.Log("Testing listing of the audit_log table")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	loggedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT id, logged_at, actor, claimed_actor, ip, request_id, method, path, status, detail, errors FROM audit_log WHERE actor = \$1 AND id < \$2 ORDER BY id DESC LIMIT \$3`).
+		WithArgs("alice", int64(10), 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "logged_at", "actor", "claimed_actor", "ip", "request_id", "method", "path", "status", "detail", "errors"}).
+			AddRow(9, loggedAt, "alice", "", "192.0.2.10", "req-1", "POST", "/v1/swift-codes", 400, "Validation failed",
+				[]byte(`[{"field":"swiftCode","message":"must be 8 or 11 characters"}]`)))
+
+	entries, err := audit.NewPostgres(db).List(audit.Filter{Actor: "alice", Before: 10, Limit: 5})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, int64(9), entries[0].ID)
+		assert.Equal(t, http.StatusBadRequest, entries[0].Status)
+		if assert.Len(t, entries[0].Errors, 1) {
+			assert.Equal(t, "swiftCode", entries[0].Errors[0].Field)
+		}
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/audit"
+	"backend/internal/auth"
+	"backend/internal/handlers"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// asAdmin returns the request carrying an admin identity, for handlers called without the authentication.
+func asAdmin(r *http.Request) *http.Request {
+	return middleware.WithIdentity(r, auth.Identity{Role: auth.RoleAdmin})
+}
+
+// newAuthenticatedHandler returns the SWIFT code handler of an in-memory store behind the API key authentication,
+// together with its store and an admin key named "alice".
+func newAuthenticatedHandler(t *testing.T, anonymousReads bool) (http.Handler, *store.Memory, *auth.Memory, string) {
+	keys := auth.NewMemory()
+	key, _, err := keys.CreateKey("alice", auth.RoleAdmin)
+	assert.NoError(t, err)
+
+	memory := store.NewMemory()
+	handler := handlers.NewStoreHandler(memory)
+	return middleware.AuthMiddleware(keys, nil, anonymousReads)(http.HandlerFunc(handler.SwiftHandler)), memory, keys, key
+}
+
+// TestAuthMiddleware_WritesRequireKey verifies that writes without a valid API key are rejected while reads stay anonymous.
+func TestAuthMiddleware_WritesRequireKey(t *testing.T) {
+	t.Log("Testing that writes need an API key and reads do not")
+	handler, memory, _, _ := newAuthenticatedHandler(t, true)
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+	assert.Contains(t, w.Body.String(), "Authentication required")
+
+	r = httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	r.Header.Set(middleware.APIKeyHeader, auth.KeyPrefix+"unknown")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid API key")
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPW001", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestAuthMiddleware_KeyNamesActor verifies that a write with a valid key succeeds and is attributed to the key.
+func TestAuthMiddleware_KeyNamesActor(t *testing.T) {
+	t.Log("Testing that the name of the API key is recorded as the actor")
+	handler, memory, _, key := newAuthenticatedHandler(t, true)
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	r.Header.Set("Authorization", "ApiKey "+key)
+	r.Header.Set("X-Actor", "mallory")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	history, err := memory.GetSwiftCodeHistory("ABCDPLPW001")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, store.OperationDelete, history[1].Operation)
+		assert.Equal(t, "alice", history[1].Actor)
+	}
+}
+
+// TestAuthMiddleware_RevokedKey verifies that a revoked key is rejected.
+func TestAuthMiddleware_RevokedKey(t *testing.T) {
+	t.Log("Testing that a revoked API key returns 401 Unauthorized")
+	handler, _, keys, key := newAuthenticatedHandler(t, true)
+
+	list, err := keys.ListKeys()
+	assert.NoError(t, err)
+	if assert.Len(t, list, 1) {
+		assert.Equal(t, key[:len(list[0].Prefix)], list[0].Prefix)
+		assert.NoError(t, keys.RevokeKey(list[0].ID))
+	}
+	assert.ErrorIs(t, keys.RevokeKey(42), auth.ErrNotFound)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(`{}`))
+	r.Header.Set(middleware.APIKeyHeader, key)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestAuthMiddleware_PrivateReads verifies that reads need a key when anonymous reads are off.
+func TestAuthMiddleware_PrivateReads(t *testing.T) {
+	t.Log("Testing that reads need an API key when anonymous reads are disabled")
+	handler, _, _, key := newAuthenticatedHandler(t, false)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, http.StatusUnauthorized, problem.Status)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes", nil)
+	r.Header.Set(middleware.APIKeyHeader, key)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestAuthMiddleware_AuditsRejections verifies that requests rejected by the authentication are recorded in the
+// audit log, which runs outside it, and that accepted ones are recorded with the authenticated caller.
+func TestAuthMiddleware_AuditsRejections(t *testing.T) {
+	t.Log("Testing that rejected credentials are recorded in the audit log")
+	sink, err := audit.OpenFile(filepath.Join(t.TempDir(), "audit.jsonl"))
+	assert.NoError(t, err)
+	defer sink.Close()
+
+	authenticated, memory, _, key := newAuthenticatedHandler(t, true)
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+	handler := middleware.AuditMiddleware(sink)(authenticated)
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	r.Header.Set(middleware.APIKeyHeader, auth.KeyPrefix+"unknown")
+	r.Header.Set("X-Actor", "mallory")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r = httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	r.Header.Set(middleware.APIKeyHeader, key)
+	r.Header.Set("X-Actor", "mallory")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	entries, err := sink.List(audit.Filter{})
+	assert.NoError(t, err)
+	if assert.Len(t, entries, 2) {
+		accepted, rejected := entries[0], entries[1]
+		assert.Equal(t, http.StatusUnauthorized, rejected.Status)
+		assert.Equal(t, middleware.AnonymousActor, rejected.Actor)
+		assert.Equal(t, "mallory", rejected.ClaimedActor)
+		assert.Equal(t, "Invalid API key", rejected.Detail)
+		assert.Equal(t, http.StatusOK, accepted.Status)
+		assert.Equal(t, "alice", accepted.Actor)
+		assert.Equal(t, "mallory", accepted.ClaimedActor)
+	}
+}
+
+// TestAuthPostgres_Authenticate verifies that keys are looked up by their hash and that unknown keys are invalid.
+func TestAuthPostgres_Authenticate(t *testing.T) {
+	t.Log("Testing API key lookup in the api_keys table")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	createdAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta(`SELECT id, name, prefix, role, created_at, revoked_at FROM api_keys`)
+	mock.ExpectQuery(query).
+		WithArgs(auth.HashKey("swk_valid")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "prefix", "role", "created_at", "revoked_at"}).
+			AddRow(1, "alice", "swk_valid", "editor", createdAt, nil))
+	mock.ExpectQuery(query).
+		WithArgs(auth.HashKey("swk_unknown")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "prefix", "role", "created_at", "revoked_at"}))
+
+	keys := auth.NewPostgres(db)
+	record, err := keys.Authenticate("swk_valid")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", record.Name)
+	assert.Equal(t, "editor", record.Role)
+
+	_, err = keys.Authenticate("swk_unknown")
+	assert.ErrorIs(t, err, auth.ErrInvalidKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestAuthPostgres_CreateKey verifies that only the hash and prefix of a new key are stored.
+func TestAuthPostgres_CreateKey(t *testing.T) {
+	t.Log("Testing creation of an API key")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`INSERT INTO api_keys`).
+		WithArgs("ci", sqlmock.AnyArg(), sqlmock.AnyArg(), "editor").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, time.Now()))
+
+	key, record, err := auth.NewPostgres(db).CreateKey(" ci ", auth.RoleEditor)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, auth.KeyPrefix))
+	assert.Equal(t, int64(7), record.ID)
+	assert.Equal(t, "ci", record.Name)
+	assert.True(t, strings.HasPrefix(key, record.Prefix))
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	_, _, err = auth.NewPostgres(db).CreateKey("  ", auth.RoleEditor)
+	assert.ErrorIs(t, err, auth.ErrInvalidName)
+}
@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSearchBanksHandler_Success verifies that ranked matches are returned with their headquarter code and country
+// and that the query is matched by its case and accent insensitive search key.
+func TestSearchBanksHandler_Success(t *testing.T) {
+	t.Log("Testing bank name search returns ranked matches")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`WITH matches AS`).
+		WithArgs("UNITED BANK", "UNITED BANK", 20).
+		WillReturnRows(sqlmock.NewRows([]string{"bank_id", "bank_name", "country_iso2", "country_name", "headquarter_swift_code", "score"}).
+			AddRow("7d3bbd63-3c1e-4a0e-9d0b-6a3c2f1e5b10", "UNITED BANK OF ALBANIA SH.A", "AL", "ALBANIA", "AAISALTRXXX", 0.9).
+			AddRow("0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9", "UNITED BANKING LTD", "MT", "MALTA", nil, 0.4))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=united%20%20b%C3%A1nk", nil)
+	w := httptest.NewRecorder()
+
+	handler.SearchBanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"query": "united bánk",
+		"results": [
+			{"bankId":"7d3bbd63-3c1e-4a0e-9d0b-6a3c2f1e5b10","bankName":"UNITED BANK OF ALBANIA SH.A","countryISO2":"AL","countryName":"ALBANIA","headquarterSwiftCode":"AAISALTRXXX","score":0.9},
+			{"bankId":"0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9","bankName":"UNITED BANKING LTD","countryISO2":"MT","countryName":"MALTA","headquarterSwiftCode":null,"score":0.4}
+		]
+	}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestSearchBanksHandler_IncludeAddress verifies that address matching is added to the search when requested.
+func TestSearchBanksHandler_IncludeAddress(t *testing.T) {
+	t.Log("Testing bank search including SWIFT code addresses")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`sc.address_ascii ILIKE`).
+		WithArgs("TIRANA", "TIRANA", 5).
+		WillReturnRows(sqlmock.NewRows([]string{"bank_id", "bank_name", "country_iso2", "country_name", "headquarter_swift_code", "score"}))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=tirana&includeAddress=true&limit=5", nil)
+	w := httptest.NewRecorder()
+
+	handler.SearchBanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"query":"tirana","results":[]}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestSearchBanksHandler_QueryTooShort verifies that a missing or one-character query returns a bad request error.
+func TestSearchBanksHandler_QueryTooShort(t *testing.T) {
+	t.Log("Testing too short search query returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=a", nil)
+	w := httptest.NewRecorder()
+
+	handler.SearchBanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Search query must be between 2 and 255 characters")
+}
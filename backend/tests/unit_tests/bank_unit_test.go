@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBankID = "2f8dfa08-8b09-47a1-b16d-bd1cba4a2e9a"
+
+// TestBanksHandler_List verifies that banks are listed with their headquarter codes and branch counts.
+func TestBanksHandler_List(t *testing.T) {
+	t.Log("Testing listing of banks")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		GROUP BY b.id, c.iso2_code, c.name
+		ORDER BY b.name_key COLLATE "C", b.id
+		LIMIT $2;
+	`)).WithArgs("PL", 2).WillReturnRows(sqlmock.NewRows([]string{
+		"id", "name", "country_iso2", "country_name", "headquarter_swift_codes", "branch_count",
+	}).AddRow(testBankID, "TEST BANK", "PL", "POLAND", "{ABCDEFGHXXX}", 2))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks?country=pl&limit=1", nil)
+	w := httptest.NewRecorder()
+
+	handler.BanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"banks": [{"id":"`+testBankID+`","name":"TEST BANK","countryISO2":"PL","countryName":"POLAND","headquarterSwiftCodes":["ABCDEFGHXXX"],"branchCount":2}],
+		"next": null
+	}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestBanksHandler_Detail verifies that a bank is returned with its headquarters and branches separated.
+func TestBanksHandler_Detail(t *testing.T) {
+	t.Log("Testing retrieval of a single bank")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`FROM banks b`).
+		WithArgs(testBankID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "name_ascii", "country_iso2", "country_name"}).
+			AddRow(testBankID, "TEST BANK", "TEST BANK", "PL", "POLAND"))
+	mock.ExpectQuery(`WHERE sc.bank_id = \$1`).
+		WithArgs(testBankID).
+		WillReturnRows(sqlmock.NewRows([]string{"address", "address_ascii", "town_name", "postal_code", "street", "time_zone", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code", "deleted_at"}).
+			AddRow("Branch Address", "Branch Address", "", "", "", "", "TEST BANK", "TEST BANK", "PL", false, "ABCDEFGH001", nil).
+			AddRow("Test Address", "Test Address", "", "", "", "", "TEST BANK", "TEST BANK", "PL", true, "ABCDEFGHXXX", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/"+testBankID, nil)
+	w := httptest.NewRecorder()
+
+	handler.BanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var bank models.Bank
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &bank))
+	assert.Equal(t, "TEST BANK", bank.Name)
+	assert.Len(t, bank.Headquarters, 1)
+	assert.Equal(t, "ABCDEFGHXXX", bank.Headquarters[0].SwiftCode)
+	assert.Len(t, bank.Branches, 1)
+	assert.Equal(t, "ABCDEFGH001", bank.Branches[0].SwiftCode)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestBanksHandler_NotFound verifies that requesting a non-existent bank returns a 404 error.
+func TestBanksHandler_NotFound(t *testing.T) {
+	t.Log("Testing retrieval of a non-existent bank returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`FROM banks b`).WithArgs(testBankID).WillReturnError(sql.ErrNoRows)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/"+testBankID, nil)
+	w := httptest.NewRecorder()
+
+	handler.BanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestBanksHandler_InvalidID verifies that a bank ID that is not a UUID returns a bad request error.
+func TestBanksHandler_InvalidID(t *testing.T) {
+	t.Log("Testing invalid bank ID returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/banks/not-a-uuid", nil)
+	w := httptest.NewRecorder()
+
+	handler.BanksHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid bank ID format")
+}
+
+// TestCountriesHandler_BanksNotFound verifies that listing banks of an unknown country returns a 404 error.
+func TestCountriesHandler_BanksNotFound(t *testing.T) {
+	t.Log("Testing listing banks of a non-existent country returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM countries WHERE iso2_code = $1)`)).
+		WithArgs("XX").
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/countries/xx/banks", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountriesHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
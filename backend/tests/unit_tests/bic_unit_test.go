@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"testing"
+
+	"backend/internal/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseBIC_Components verifies that a valid BIC is split into its components and flags.
+func TestParseBIC_Components(t *testing.T) {
+	t.Log("Testing parsing of valid BICs")
+
+	bic, errors := validation.ParseBIC("xwarplp1")
+	assert.Empty(t, errors)
+	assert.Equal(t, validation.BIC{Institution: "XWAR", Country: "PL", Location: "P1", Branch: "XXX"}, bic)
+	assert.True(t, bic.IsPassive())
+	assert.False(t, bic.IsTest())
+
+	bic, errors = validation.ParseBIC("BCECCLR0001")
+	assert.Empty(t, errors)
+	assert.Equal(t, "001", bic.Branch)
+	assert.True(t, bic.IsTest())
+
+	bic, errors = validation.ParseBIC("ABCDPLW2XXX")
+	assert.Empty(t, errors)
+	assert.True(t, bic.IsReverseBilling())
+}
+
+// TestParseBIC_Errors verifies that every failing component of a BIC is reported.
+func TestParseBIC_Errors(t *testing.T) {
+	t.Log("Testing parsing of invalid BICs")
+
+	tests := map[string]string{
+		"ABCD":        "SWIFT code must be 8 or 11 letters or digits",
+		"AB1DPLPWXXX": "Institution code (characters 1-4) must be 4 letters",
+		"ABCDQQPWXXX": "Country code (characters 5-6) must be an ISO 3166 country code",
+		"ABCDPL1WXXX": "Location code (characters 7-8) must not start with 0 or 1 and must not contain the letter O in second place",
+		"ABCDPLPOXXX": "Location code (characters 7-8) must not start with 0 or 1 and must not contain the letter O in second place",
+		"ABCDPLPWXAB": "Branch code (characters 9-11) must not start with X unless it is XXX",
+	}
+	for code, expected := range tests {
+		_, errors := validation.ParseBIC(code)
+		assert.Equal(t, []string{expected}, errors, code)
+	}
+}
@@ -0,0 +1,183 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/validation"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalizeClearingCode verifies the per-scheme format checks of national clearing codes.
+func TestNormalizeClearingCode(t *testing.T) {
+	t.Log("Testing clearing code validation per scheme")
+
+	scheme, code, errors := validation.NormalizeClearingCode("gbdsc", "40-47-84")
+	assert.Empty(t, errors)
+	assert.Equal(t, "GBDSC", scheme)
+	assert.Equal(t, "404784", code)
+
+	_, code, errors = validation.NormalizeClearingCode("USABA", "021000021")
+	assert.Empty(t, errors)
+	assert.Equal(t, "021000021", code)
+
+	_, _, errors = validation.NormalizeClearingCode("USABA", "021000022")
+	assert.Equal(t, []models.FieldError{{Field: "code", Code: "checksum", Message: "US ABA routing number has an invalid check digit"}}, errors)
+
+	_, _, errors = validation.NormalizeClearingCode("DEBLZ", "0370400")
+	assert.Equal(t, []models.FieldError{{Field: "code", Code: "invalid", Message: "German Bankleitzahl must be 8 digits not starting with 0"}}, errors)
+
+	_, _, errors = validation.NormalizeClearingCode("XXXXX", "1234")
+	assert.Equal(t, []models.FieldError{{Field: "scheme", Code: "unknown", Message: `Clearing scheme "XXXXX" is not supported`}}, errors)
+}
+
+// TestClearingCodesHandler_Create verifies that a clearing code is linked to an existing SWIFT code.
+func TestClearingCodesHandler_Create(t *testing.T) {
+	t.Log("Testing creation of a clearing code")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO clearing_codes (swift_code_id, scheme, code)`)).
+		WithArgs("ABCDPLPWXXX", "PLKNR", "10901014").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "inserted"}).AddRow(true, true))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/ABCDPLPW/clearing-codes", strings.NewReader(`{"scheme":"plknr","code":"1090 1014"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `{"scheme":"PLKNR","code":"10901014"}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestClearingCodesHandler_CreateConflict verifies that a clearing code assigned to another SWIFT code is refused.
+func TestClearingCodesHandler_CreateConflict(t *testing.T) {
+	t.Log("Testing creation of an already assigned clearing code returns 409 Conflict")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO clearing_codes (swift_code_id, scheme, code)`)).
+		WithArgs("ABCDPLPWXXX", "PLKNR", "10901014").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "inserted"}).AddRow(true, false))
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/ABCDPLPWXXX/clearing-codes", strings.NewReader(`{"scheme":"PLKNR","code":"10901014"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestClearingCodesHandler_List verifies that the clearing codes of a SWIFT code are listed.
+func TestClearingCodesHandler_List(t *testing.T) {
+	t.Log("Testing listing of clearing codes")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id FROM swift_codes WHERE swift_code = $1`)).
+		WithArgs("ABCDPLPWXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testBankID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT scheme, code FROM clearing_codes`)).
+		WithArgs(testBankID).
+		WillReturnRows(sqlmock.NewRows([]string{"scheme", "code"}).AddRow("PLKNR", "10901014"))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPWXXX/clearing-codes", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"swiftCode":"ABCDPLPWXXX","clearingCodes":[{"scheme":"PLKNR","code":"10901014"}]}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestClearingCodesHandler_DeleteNotFound verifies that deleting a clearing code that is not assigned returns 404.
+func TestClearingCodesHandler_DeleteNotFound(t *testing.T) {
+	t.Log("Testing deletion of a non-existent clearing code returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM clearing_codes cc`)).
+		WithArgs("ABCDPLPWXXX", "GBDSC", "404784").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPWXXX/clearing-codes/GBDSC/40-47-84", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetClearingCodeHandler_Success verifies that a clearing code resolves to its SWIFT code record.
+func TestGetClearingCodeHandler_Success(t *testing.T) {
+	t.Log("Testing reverse lookup of a clearing code")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE cc.scheme = $1 AND cc.code = $2 AND sc.deleted_at IS NULL;`)).
+		WithArgs("USABA", "021000021").
+		WillReturnRows(sqlmock.NewRows([]string{"address", "address_ascii", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code"}).
+			AddRow("Test Address", "Test Address", "TEST BANK", "TEST BANK", "US", true, "ABCDUS33XXX"))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/clearing-codes/usaba/021000021", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetClearingCodeHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"scheme": "USABA",
+		"code": "021000021",
+		"swiftCode": {
+			"address":"Test Address","addressAscii":"Test Address","bankName":"TEST BANK","bankNameAscii":"TEST BANK",
+			"countryISO2":"US","isHeadquarter":true,"swiftCode":"ABCDUS33XXX"
+		}
+	}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetClearingCodeHandler_InvalidCode verifies that a malformed clearing code returns a bad request error.
+func TestGetClearingCodeHandler_InvalidCode(t *testing.T) {
+	t.Log("Testing reverse lookup of a malformed clearing code returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/clearing-codes/GBDSC/4047", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetClearingCodeHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "UK domestic sort code must be 6 digits")
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/validation"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var countryStatisticsColumns = []string{"country_iso2", "country_name", "bank_count", "headquarter_count", "branch_count"}
+
+// TestCountriesHandler_List verifies that all countries are listed with their statistics.
+func TestCountriesHandler_List(t *testing.T) {
+	t.Log("Testing listing of countries with statistics")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		FROM countries c
+		LEFT JOIN banks b ON b.country_id = c.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
+		GROUP BY c.id, c.iso2_code, c.name
+		ORDER BY c.iso2_code;
+	`)).WillReturnRows(sqlmock.NewRows(countryStatisticsColumns).
+		AddRow("AL", "ALBANIA", 10, 12, 30).
+		AddRow("PL", "POLAND", 20, 25, 40))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/countries", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountriesHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"countries":[
+		{"countryISO2":"AL","countryISO3":"ALB","countryNumeric":"008","countryName":"ALBANIA","bankCount":10,"headquarterCount":12,"branchCount":30},
+		{"countryISO2":"PL","countryISO3":"POL","countryNumeric":"616","countryName":"POLAND","bankCount":20,"headquarterCount":25,"branchCount":40}
+	]}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestCountriesHandler_Single verifies that a single country is returned with its statistics.
+func TestCountriesHandler_Single(t *testing.T) {
+	t.Log("Testing retrieval of a single country with statistics")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE c.iso2_code = $1`)).
+		WithArgs("PL").
+		WillReturnRows(sqlmock.NewRows(countryStatisticsColumns).AddRow("PL", "POLAND", 20, 25, 40))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/countries/pl", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountriesHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"countryISO2":"PL","countryISO3":"POL","countryNumeric":"616","countryName":"POLAND","bankCount":20,"headquarterCount":25,"branchCount":40}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestCountriesHandler_NotFound verifies that requesting a country that is not loaded returns a 404 error.
+func TestCountriesHandler_NotFound(t *testing.T) {
+	t.Log("Testing retrieval of a non-existent country returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE c.iso2_code = $1`)).WithArgs("XX").WillReturnError(sql.ErrNoRows)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/countries/XX", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountriesHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestCountriesHandler_InvalidFormat verifies that an invalid country ISO2 code format returns a bad request error.
+func TestCountriesHandler_InvalidFormat(t *testing.T) {
+	t.Log("Testing invalid country ISO2 code format returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/countries/POL", nil)
+	w := httptest.NewRecorder()
+
+	handler.CountriesHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestLookupCountry verifies the ISO 3166 registry lookups and name matching.
+func TestLookupCountry(t *testing.T) {
+	t.Log("Testing ISO 3166 registry lookups")
+
+	country, ok := validation.LookupCountry("ci")
+	assert.True(t, ok)
+	assert.Equal(t, "CIV", country.Alpha3)
+	assert.Equal(t, "384", country.Numeric)
+	assert.True(t, country.MatchesName("Côte d'Ivoire"))
+	assert.True(t, country.MatchesName("cote  d ivoire"))
+	assert.False(t, country.MatchesName("Ghana"))
+
+	kosovo, ok := validation.LookupCountry("XK")
+	assert.True(t, ok)
+	assert.Equal(t, "KOSOVO", kosovo.Name)
+	assert.Empty(t, kosovo.Numeric)
+
+	_, ok = validation.LookupCountry("QQ")
+	assert.False(t, ok)
+}
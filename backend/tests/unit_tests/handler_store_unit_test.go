@@ -0,0 +1,152 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStore records the calls made by the handlers. Methods that are not overridden
+// panic through the nil embedded interface, failing tests that reach them.
+type fakeStore struct {
+	store.Store
+	swiftCodes map[string]models.SwiftCodeBranch
+	created    []models.SwiftCodeBranch
+	updated    []models.SwiftCodeBranch
+	err        error
+}
+
+func (f *fakeStore) GetSwiftCode(swiftCode string, includeDeleted bool) (models.SwiftCodeBranch, error) {
+	if f.err != nil {
+		return models.SwiftCodeBranch{}, f.err
+	}
+	branch, ok := f.swiftCodes[swiftCode]
+	if !ok {
+		return branch, store.ErrNotFound
+	}
+	return branch, nil
+}
+
+func (f *fakeStore) CreateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if _, ok := f.swiftCodes[swiftCode.SwiftCode]; ok {
+		return store.ErrConflict
+	}
+	f.created = append(f.created, swiftCode)
+	return nil
+}
+
+func (f *fakeStore) UpdateSwiftCode(swiftCode models.SwiftCodeBranch, actor string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.updated = append(f.updated, swiftCode)
+	return nil
+}
+
+func (f *fakeStore) DeleteSwiftCode(swiftCode, actor string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if _, ok := f.swiftCodes[swiftCode]; !ok {
+		return store.ErrNotFound
+	}
+	delete(f.swiftCodes, swiftCode)
+	return nil
+}
+
+// newFakeStore returns a fake holding a single branch SWIFT code.
+func newFakeStore() *fakeStore {
+	isHeadquarter := false
+	return &fakeStore{swiftCodes: map[string]models.SwiftCodeBranch{
+		"ABCDPLPW001": {
+			Address:       "Old Address",
+			BankName:      "Test Bank",
+			CountryISO2:   "PL",
+			CountryName:   "POLAND",
+			IsHeadquarter: &isHeadquarter,
+			SwiftCode:     "ABCDPLPW001",
+		},
+	}}
+}
+
+// TestStoreHandler_GetBranch verifies that a branch SWIFT code is read through the store.
+func TestStoreHandler_GetBranch(t *testing.T) {
+	t.Log("Testing retrieval of a branch SWIFT code from a fake store")
+	handler := handlers.NewStoreHandler(newFakeStore())
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPW001", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"swiftCode":"ABCDPLPW001"`)
+}
+
+// TestStoreHandler_PostConflict verifies that store.ErrConflict is reported as 409.
+func TestStoreHandler_PostConflict(t *testing.T) {
+	t.Log("Testing creation of an existing SWIFT code in a fake store returns 409 Conflict")
+	fake := newFakeStore()
+	handler := handlers.NewStoreHandler(fake)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(`{
+		"address": "Test Address",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"isHeadquarter": false,
+		"swiftCode": "ABCDPLPW001"
+	}`))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Empty(t, fake.created)
+}
+
+// TestStoreHandler_Patch verifies that a patch is merged with the stored SWIFT code before the update.
+func TestStoreHandler_Patch(t *testing.T) {
+	t.Log("Testing partial update of a SWIFT code in a fake store")
+	fake := newFakeStore()
+	handler := handlers.NewStoreHandler(fake)
+
+	r := httptest.NewRequest(http.MethodPatch, "/v1/swift-codes/ABCDPLPW001", strings.NewReader(`{"address": "New Address"}`))
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.Len(t, fake.updated, 1) {
+		assert.Equal(t, "New Address", fake.updated[0].Address)
+		assert.Equal(t, "Test Bank", fake.updated[0].BankName)
+		assert.Equal(t, "POLAND", fake.updated[0].CountryName)
+	}
+}
+
+// TestStoreHandler_DeleteError verifies that an unexpected store error is reported as 500.
+func TestStoreHandler_DeleteError(t *testing.T) {
+	t.Log("Testing store failure during deletion returns 500 Internal Server Error")
+	fake := newFakeStore()
+	fake.err = errors.New("connection lost")
+	handler := handlers.NewStoreHandler(fake)
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	w := httptest.NewRecorder()
+
+	handler.DeleteSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.JSONEq(t, `{"type":"about:blank","title":"Internal Server Error","status":500,"detail":"Database query failed"}`, w.Body.String())
+}
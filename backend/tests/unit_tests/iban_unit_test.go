@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/iban"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseIBAN_Valid verifies that valid IBANs are accepted and their bank code is extracted.
+func TestParseIBAN_Valid(t *testing.T) {
+	t.Log("Testing parsing of valid IBANs")
+
+	tests := map[string]string{
+		"DE89370400440532013000":          "37040044",
+		"gb82 west 1234 5698 7654 32":     "WEST",
+		"PL61109010140000071219812874":    "10901014",
+		"IT60X0542811101000000123456":     "05428",
+		"MT84MALT011000012345MTLCAST001S": "MALT",
+		"AL47212110090000000235698741":    "21211009",
+	}
+	for value, bankCode := range tests {
+		parsed, err := iban.Parse(value)
+		assert.NoError(t, err, value)
+		assert.Equal(t, bankCode, parsed.BankCode, value)
+	}
+
+	parsed, _ := iban.Parse("GB82WEST12345698765432")
+	assert.Equal(t, "GB82 WEST 1234 5698 7654 32", parsed.Printed())
+}
+
+// TestParseIBAN_Invalid verifies that malformed IBANs are rejected with the failing check.
+func TestParseIBAN_Invalid(t *testing.T) {
+	t.Log("Testing parsing of invalid IBANs")
+
+	_, err := iban.Parse("DE89-3704-0044")
+	assert.Equal(t, iban.ErrInvalidCharacters, err)
+
+	_, err = iban.Parse("US12345678901234")
+	assert.Equal(t, iban.ErrUnsupportedCountry, err)
+
+	_, err = iban.Parse("DE8937040044053201300")
+	assert.EqualError(t, err, "IBAN of DE must be 22 characters long")
+
+	_, err = iban.Parse("DE89370400440532O13000")
+	assert.Equal(t, iban.ErrInvalidStructure, err)
+
+	_, err = iban.Parse("DE88370400440532013000")
+	assert.Equal(t, iban.ErrInvalidChecksum, err)
+}
+
+// TestGetIBANHandler_Resolved verifies that a valid IBAN is returned with the SWIFT code mapped to its bank code.
+func TestGetIBANHandler_Resolved(t *testing.T) {
+	t.Log("Testing IBAN lookup with a mapped bank code")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`FROM national_bank_codes nbc`).
+		WithArgs("PL", "10901014").
+		WillReturnRows(sqlmock.NewRows([]string{"address", "address_ascii", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code"}).
+			AddRow("ul. Świętokrzyska 11", "ul. Swietokrzyska 11", "TEST BANK", "TEST BANK", "PL", true, "ABCDPLPWXXX"))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/iban/PL61109010140000071219812874", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetIBANHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{
+		"iban": "PL61109010140000071219812874",
+		"printed": "PL61 1090 1014 0000 0712 1981 2874",
+		"countryISO2": "PL",
+		"checkDigits": "61",
+		"bban": "109010140000071219812874",
+		"bankCode": "10901014",
+		"swiftCode": {
+			"address":"ul. Świętokrzyska 11","addressAscii":"ul. Swietokrzyska 11","bankName":"TEST BANK","bankNameAscii":"TEST BANK",
+			"countryISO2":"PL","isHeadquarter":true,"swiftCode":"ABCDPLPWXXX"
+		}
+	}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetIBANHandler_Unmapped verifies that a valid IBAN without a mapped bank code is returned without a SWIFT code.
+func TestGetIBANHandler_Unmapped(t *testing.T) {
+	t.Log("Testing IBAN lookup with an unmapped bank code")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`FROM national_bank_codes nbc`).
+		WithArgs("DE", "37040044").
+		WillReturnError(sql.ErrNoRows)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/iban/DE89370400440532013000", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetIBANHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"swiftCode":null`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetIBANHandler_Invalid verifies that an invalid IBAN returns a bad request error.
+func TestGetIBANHandler_Invalid(t *testing.T) {
+	t.Log("Testing invalid IBAN returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/iban/DE88370400440532013000", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetIBANHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"Invalid IBAN: IBAN check digits are invalid"}`, w.Body.String())
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"backend/internal/importer"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const importerTSV = "COUNTRY ISO2 CODE\tSWIFT CODE\tCODE TYPE\tNAME\tADDRESS\tTOWN NAME\tCOUNTRY NAME\tTIME ZONE\n" +
+	"PL\tABCDPLPWXXX\tBIC11\tTest Bank\tTest Address\tWarszawa\tPoland\tEurope/Warsaw\n" +
+	"PL\tABCDPLPW001\tBIC11\tTest Bank\t\tKrakow\tPoland\tEurope/Warsaw\n" +
+	"PL\tINVALID\tBIC11\tTest Bank\tTest Address\tWarszawa\tPoland\tEurope/Warsaw\n"
+
+// TestImporterParse_ValidatesRows verifies that rows are validated and normalized while parsing.
+func TestImporterParse_ValidatesRows(t *testing.T) {
+	t.Log("Testing spreadsheet parsing with valid and invalid rows")
+
+	reader := bufio.NewReader(strings.NewReader(importerTSV))
+	assert.Equal(t, '\t', importer.DetectDelimiter(reader))
+
+	rows, rejected, err := importer.Parse(reader, '\t')
+	assert.NoError(t, err)
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, "ABCDPLPWXXX", rows[0].Branch.SwiftCode)
+	assert.Equal(t, "Test Bank", rows[0].Branch.BankName)
+	assert.True(t, *rows[0].Branch.IsHeadquarter)
+	assert.Equal(t, "Warszawa", rows[0].Branch.TownName)
+	assert.Equal(t, "Europe/Warsaw", rows[0].Branch.TimeZone)
+	assert.Equal(t, "Krakow", rows[1].Branch.Address)
+	assert.False(t, *rows[1].Branch.IsHeadquarter)
+
+	assert.Len(t, rejected, 1)
+	assert.Equal(t, 4, rejected[0].Line)
+	assert.Equal(t, importer.StatusRejected, rejected[0].Status)
+	assert.Contains(t, rejected[0].Reason, "Validation errors")
+}
+
+// TestImporterParse_MissingColumn verifies that an export without a required column is refused.
+func TestImporterParse_MissingColumn(t *testing.T) {
+	t.Log("Testing spreadsheet without SWIFT CODE column returns an error")
+
+	_, _, err := importer.Parse(strings.NewReader("COUNTRY ISO2 CODE,NAME\nPL,Test Bank\n"), ',')
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "SWIFT CODE")
+}
+
+// TestImporterImport_Batches verifies that rows are upserted in batches and reported per row.
+func TestImporterImport_Batches(t *testing.T) {
+	t.Log("Testing batched upsert reports inserted, updated and skipped rows")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	rows, _, err := importer.Parse(strings.NewReader(importerTSV), '\t')
+	assert.NoError(t, err)
+	rows = append(rows, rows[0])
+	rows[2].Line = 5
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("importer").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "Warszawa", "Europe/Warsaw").
+		WillReturnRows(sqlmock.NewRows([]string{"inserted"}).AddRow(true))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPW001", false, "Krakow", "Krakow", "Krakow", "Europe/Warsaw").
+		WillReturnRows(sqlmock.NewRows([]string{"inserted"}).AddRow(false))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("importer").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := importer.Import(db, rows, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	assert.Len(t, results, 3)
+	assert.Equal(t, importer.StatusInserted, results[0].Status)
+	assert.Equal(t, importer.StatusUpdated, results[1].Status)
+	assert.Equal(t, importer.StatusSkipped, results[2].Status)
+	assert.Equal(t, "duplicate of line 2", results[2].Reason)
+}
+
+const bankCodesCSV = "COUNTRY ISO2 CODE,NATIONAL BANK CODE,SWIFT CODE\n" +
+	"PL,10901014,ABCDPLPW\n" +
+	"PL,10901014,ABCDPLPW001\n" +
+	"QQ,1234,ABCDPLPWXXX\n" +
+	"DE,37040044,UNKNDEFFXXX\n"
+
+// TestImporterImportBankCodes verifies that national bank code mappings are validated and upserted.
+func TestImporterImportBankCodes(t *testing.T) {
+	t.Log("Testing national bank code mapping import")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	rows, rejected, err := importer.ParseBankCodes(strings.NewReader(bankCodesCSV), ',')
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, "ABCDPLPWXXX", rows[0].SwiftCode)
+	assert.Len(t, rejected, 1)
+	assert.Equal(t, 4, rejected[0].Line)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("importer").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO national_bank_codes`).
+		WithArgs("PL", "10901014", "ABCDPLPWXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "inserted"}).AddRow(true, true))
+	mock.ExpectQuery(`INSERT INTO national_bank_codes`).
+		WithArgs("DE", "37040044", "UNKNDEFFXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "inserted"}).AddRow(false, nil))
+	mock.ExpectCommit()
+
+	results, err := importer.ImportBankCodes(db, rows, 10)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	assert.Len(t, results, 3)
+	assert.Equal(t, importer.StatusInserted, results[0].Status)
+	assert.Equal(t, importer.StatusSkipped, results[1].Status)
+	assert.Equal(t, "duplicate of line 2", results[1].Reason)
+	assert.Equal(t, importer.StatusRejected, results[2].Status)
+	assert.Equal(t, "unknown SWIFT code", results[2].Reason)
+}
+
+// TestImporterParseJSON_ValidatesItems verifies that JSON seed items are validated like spreadsheet rows.
+func TestImporterParseJSON_ValidatesItems(t *testing.T) {
+	t.Log("Testing JSON parsing with valid and invalid items")
+
+	rows, rejected, err := importer.ParseJSON(strings.NewReader(`[
+		{"swiftCode": "abcdplpwxxx", "bankName": "Test Bank", "countryISO2": "pl", "countryName": "Poland", "address": "Test Address"},
+		{"swiftCode": "ABCDPLPW001", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "townName": "Kraków"},
+		{"swiftCode": "ABCDPLPW002", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Test Address", "isHeadquarter": true}
+	]`))
+	assert.NoError(t, err)
+
+	assert.Len(t, rows, 2)
+	assert.Equal(t, 1, rows[0].Line)
+	assert.Equal(t, "ABCDPLPWXXX", rows[0].Branch.SwiftCode)
+	assert.Equal(t, "PL", rows[0].Branch.CountryISO2)
+	assert.True(t, *rows[0].Branch.IsHeadquarter)
+	assert.Equal(t, "Kraków", rows[1].Branch.Address)
+	assert.Equal(t, "Krakow", rows[1].Branch.AddressASCII)
+	assert.False(t, *rows[1].Branch.IsHeadquarter)
+
+	assert.Len(t, rejected, 1)
+	assert.Equal(t, 3, rejected[0].Line)
+	assert.Contains(t, rejected[0].Reason, "Headquarter status")
+}
@@ -0,0 +1,249 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/auth"
+	"backend/internal/handlers"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSecret = []byte("0123456789abcdef0123456789abcdef")
+
+const testIssuer = "https://issuer.example"
+
+// signToken returns a token for the subject expiring in an hour, with extra claims merged in.
+func signToken(t *testing.T, method jwt.SigningMethod, key any, kid string, extra jwt.MapClaims) string {
+	claims := jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()}
+	for name, value := range extra {
+		claims[name] = value
+	}
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+// newTokenHandler returns the SWIFT code handler of an in-memory store accepting tokens signed with testSecret.
+func newTokenHandler(t *testing.T) (http.Handler, *store.Memory) {
+	memory := store.NewMemory()
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+
+	handler := handlers.NewStoreHandler(memory)
+	tokens := auth.NewHMACVerifier(testSecret, auth.TokenConfig{Issuer: testIssuer})
+	return middleware.AuthMiddleware(auth.NewMemory(), tokens, true)(http.HandlerFunc(handler.SwiftHandler)), memory
+}
+
+// serveWithToken sends a request with a bearer token to the handler.
+func serveWithToken(handler http.Handler, method, target, body, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+const tokenTestSwiftCode = `{
+	"address": "New Address",
+	"bankName": "Test Bank",
+	"countryISO2": "PL",
+	"countryName": "Poland",
+	"isHeadquarter": false,
+	"swiftCode": "ABCDPLPW001"
+}`
+
+// TestTokenAuth_Roles verifies that the role of a token decides which methods of the SWIFT code routes it may call.
+func TestTokenAuth_Roles(t *testing.T) {
+	t.Log("Testing per-route permissions of the reader, editor and admin roles")
+	handler, memory := newTokenHandler(t)
+	reader := signToken(t, jwt.SigningMethodHS256, testSecret, "", jwt.MapClaims{"iss": testIssuer, "roles": []string{"reader"}})
+	editor := signToken(t, jwt.SigningMethodHS256, testSecret, "", jwt.MapClaims{"iss": testIssuer, "roles": "reader editor"})
+	admin := signToken(t, jwt.SigningMethodHS512, testSecret, "", jwt.MapClaims{"iss": testIssuer, "roles": []string{"admin"}})
+
+	w := serveWithToken(handler, http.MethodGet, "/v1/swift-codes/ABCDPLPW001", "", reader)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveWithToken(handler, http.MethodPut, "/v1/swift-codes/ABCDPLPW001", tokenTestSwiftCode, reader)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, models.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "the editor role is required")
+
+	w = serveWithToken(handler, http.MethodPut, "/v1/swift-codes/ABCDPLPW001", tokenTestSwiftCode, editor)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveWithToken(handler, http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", "", editor)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "the admin role is required")
+
+	w = serveWithToken(handler, http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", "", admin)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveWithToken(handler, http.MethodPost, "/v1/swift-codes/ABCDPLPW001/restore", "", editor)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	history, err := memory.GetSwiftCodeHistory("ABCDPLPW001")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 4) {
+		assert.Equal(t, "alice", history[1].Actor)
+	}
+}
+
+// TestTokenAuth_InvalidTokens verifies that tokens that are expired, badly signed or from another issuer are rejected.
+func TestTokenAuth_InvalidTokens(t *testing.T) {
+	t.Log("Testing that invalid bearer tokens return 401 Unauthorized")
+	handler, _ := newTokenHandler(t)
+
+	tokens := map[string]string{
+		"expired": signToken(t, jwt.SigningMethodHS256, testSecret, "",
+			jwt.MapClaims{"iss": testIssuer, "exp": time.Now().Add(-time.Hour).Unix(), "roles": "admin"}),
+		"wrong secret": signToken(t, jwt.SigningMethodHS256, []byte("another secret of thirty-two characters"), "",
+			jwt.MapClaims{"iss": testIssuer, "roles": "admin"}),
+		"wrong issuer": signToken(t, jwt.SigningMethodHS256, testSecret, "",
+			jwt.MapClaims{"iss": "https://other.example", "roles": "admin"}),
+		"no expiry": signToken(t, jwt.SigningMethodHS256, testSecret, "",
+			jwt.MapClaims{"iss": testIssuer, "exp": nil, "roles": "admin"}),
+		"malformed": "not.a.token",
+	}
+	for name, token := range tokens {
+		w := serveWithToken(handler, http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", "", token)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, name)
+		assert.Contains(t, w.Body.String(), "Invalid bearer token", name)
+	}
+
+	// a token sent with an anonymous read is still checked
+	w := serveWithToken(handler, http.MethodGet, "/v1/swift-codes/ABCDPLPW001", "", tokens["expired"])
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestTokenAuth_NoRole verifies that a valid token granting no role may read like an anonymous caller
+// while anonymous reads are allowed, and may not even read otherwise.
+func TestTokenAuth_NoRole(t *testing.T) {
+	t.Log("Testing the permissions of a token without roles")
+	handler, memory := newTokenHandler(t)
+	token := signToken(t, jwt.SigningMethodHS256, testSecret, "", jwt.MapClaims{"iss": testIssuer})
+
+	w := serveWithToken(handler, http.MethodGet, "/v1/swift-codes/ABCDPLPW001", "", token)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = serveWithToken(handler, http.MethodPut, "/v1/swift-codes/ABCDPLPW001", tokenTestSwiftCode, token)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	tokens := auth.NewHMACVerifier(testSecret, auth.TokenConfig{Issuer: testIssuer})
+	private := middleware.AuthMiddleware(auth.NewMemory(), tokens, false)(http.HandlerFunc(handlers.NewStoreHandler(memory).SwiftHandler))
+	w = serveWithToken(private, http.MethodGet, "/v1/swift-codes/ABCDPLPW001", "", token)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+// TestAuthorize_RequiresIdentity verifies that handlers deny requests that did not pass an authentication
+// middleware, and that turning authentication off grants every role.
+func TestAuthorize_RequiresIdentity(t *testing.T) {
+	t.Log("Testing that requests without an identity return 403 Forbidden")
+	memory := store.NewMemory()
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+	handler := handlers.NewStoreHandler(memory)
+
+	w := serveWithToken(http.HandlerFunc(handler.SwiftHandler), http.MethodGet, "/v1/swift-codes/ABCDPLPW001", "", "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	open := middleware.NoAuthMiddleware(http.HandlerFunc(handler.SwiftHandler))
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	r.Header.Set("X-Actor", "alice")
+	w = httptest.NewRecorder()
+	open.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	history, err := memory.GetSwiftCodeHistory("ABCDPLPW001")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, middleware.AnonymousActor, history[1].Actor)
+	}
+}
+
+// base64Int encodes an integer as unpadded base64url, as in a JSON Web Key.
+func base64Int(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+// TestTokenVerifier_JWKSFile verifies tokens signed with RSA and EC keys of a JWKS file, with roles mapped from a nested claim.
+func TestTokenVerifier_JWKSFile(t *testing.T) {
+	t.Log("Testing verification against a JWKS loaded from a file")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": base64Int(rsaKey.N), "e": base64Int(big.NewInt(int64(rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": base64Int(ecKey.X), "y": base64Int(ecKey.Y)},
+		{"kty": "oct", "kid": "ignored", "k": "c2VjcmV0"},
+	}})
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, jwks, 0o600))
+
+	loaded, err := auth.LoadJWKS(path)
+	assert.NoError(t, err)
+	tokens, err := auth.NewJWKSVerifier(loaded, auth.TokenConfig{
+		Audience:  "swift-api",
+		RoleClaim: "realm_access.roles",
+		RoleMap:   map[string]auth.Role{"swift-admins": auth.RoleAdmin},
+	})
+	assert.NoError(t, err)
+
+	claims := jwt.MapClaims{"aud": []string{"swift-api"}, "realm_access": map[string]any{"roles": []string{"offline_access", "swift-admins"}}}
+	identity, err := tokens.Verify(signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", claims))
+	assert.NoError(t, err)
+	assert.Equal(t, auth.Identity{Name: "alice", Role: auth.RoleAdmin}, identity)
+
+	claims["realm_access"] = map[string]any{"roles": []string{"editor"}}
+	identity, err = tokens.Verify(signToken(t, jwt.SigningMethodES256, ecKey, "ec-1", claims))
+	assert.NoError(t, err)
+	assert.Equal(t, auth.RoleEditor, identity.Role)
+
+	_, err = tokens.Verify(signToken(t, jwt.SigningMethodRS256, rsaKey, "unknown", claims))
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	// an HMAC token must not be accepted by a public key verifier
+	_, err = tokens.Verify(signToken(t, jwt.SigningMethodHS256, testSecret, "rsa-1", claims))
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+
+	_, err = tokens.Verify(signToken(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", jwt.MapClaims{"aud": "other"}))
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+// TestTokenVerifier_InvalidJWKS verifies that key sets without usable signing keys are rejected.
+func TestTokenVerifier_InvalidJWKS(t *testing.T) {
+	t.Log("Testing rejection of unusable key sets")
+	for _, jwks := range []string{
+		`not json`,
+		`{"keys": []}`,
+		`{"keys": [{"kty": "RSA", "kid": "enc", "use": "enc", "n": "AQAB", "e": "AQAB"}]}`,
+		`{"keys": [{"kty": "EC", "kid": "bad", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`,
+	} {
+		_, err := auth.NewJWKSVerifier([]byte(jwks), auth.TokenConfig{})
+		assert.Error(t, err, jwks)
+	}
+}
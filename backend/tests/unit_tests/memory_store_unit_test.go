@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newMemorySwiftCode returns a valid SWIFT code of a Polish bank.
+func newMemorySwiftCode(swiftCode, bankName string) models.SwiftCodeBranch {
+	isHeadquarter := strings.HasSuffix(swiftCode, "XXX")
+	return models.SwiftCodeBranch{
+		Address:       "Test Address",
+		BankName:      bankName,
+		BankNameASCII: bankName,
+		CountryISO2:   "PL",
+		CountryName:   "POLAND",
+		IsHeadquarter: &isHeadquarter,
+		SwiftCode:     swiftCode,
+	}
+}
+
+// TestMemoryStore_Hierarchy verifies that branches are matched to their headquarter by the 8 character prefix.
+func TestMemoryStore_Hierarchy(t *testing.T) {
+	t.Log("Testing headquarter and branch relations of the in-memory store")
+	memory := store.NewMemory()
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPWXXX", "Test Bank"), "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "TEST BANK"), "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("WXYZPLPW001", "Other Bank"), "test"))
+	assert.ErrorIs(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "test"), store.ErrConflict)
+
+	headquarter, err := memory.GetHeadquarter("ABCDPLPWXXX", false)
+	assert.NoError(t, err)
+	if assert.Len(t, headquarter.Branches, 1) {
+		assert.Equal(t, "ABCDPLPW001", headquarter.Branches[0].SwiftCode)
+		assert.Equal(t, "Test Bank", headquarter.Branches[0].BankName)
+	}
+
+	country, err := memory.GetCountry("PL")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, country.BankCount)
+	assert.Equal(t, 1, country.HeadquarterCount)
+	assert.Equal(t, 2, country.BranchCount)
+}
+
+// TestMemoryStore_SoftDelete verifies that deleted SWIFT codes are hidden, can be restored, and
+// that purging the last SWIFT code removes its bank and country.
+func TestMemoryStore_SoftDelete(t *testing.T) {
+	t.Log("Testing soft delete, restore and purge of the in-memory store")
+	memory := store.NewMemory()
+	clearingCode := models.ClearingCode{Scheme: "PLKNR", Code: "10101010"}
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPWXXX", "Test Bank"), "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "test"))
+	assert.NoError(t, memory.CreateClearingCode("ABCDPLPW001", clearingCode))
+
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPW001", "test"))
+	assert.ErrorIs(t, memory.DeleteSwiftCode("ABCDPLPW001", "test"), store.ErrNotFound)
+	_, err := memory.GetSwiftCodeByClearingCode(clearingCode)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	_, err = memory.GetSwiftCode("ABCDPLPW001", false)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	deleted, err := memory.GetSwiftCode("ABCDPLPW001", true)
+	assert.NoError(t, err)
+	assert.NotNil(t, deleted.DeletedAt)
+	headquarter, err := memory.GetHeadquarter("ABCDPLPWXXX", false)
+	assert.NoError(t, err)
+	assert.Empty(t, headquarter.Branches)
+
+	assert.NoError(t, memory.RestoreSwiftCode("ABCDPLPW001", "test"))
+	assert.ErrorIs(t, memory.RestoreSwiftCode("ABCDPLPW001", "test"), store.ErrConflict)
+	_, err = memory.GetSwiftCodeByClearingCode(clearingCode)
+	assert.NoError(t, err)
+
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPW001", "test"))
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPWXXX", "test"))
+	country, err := memory.GetCountry("PL")
+	assert.NoError(t, err)
+	assert.Zero(t, country.BankCount+country.HeadquarterCount+country.BranchCount)
+
+	purged, err := memory.PurgeSwiftCodes(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Zero(t, purged)
+	purged, err = memory.PurgeSwiftCodes(time.Now().Add(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purged)
+	assert.ErrorIs(t, memory.RestoreSwiftCode("ABCDPLPW001", "test"), store.ErrNotFound)
+	exists, err := memory.CountryExists("PL")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
+
+// TestMemoryStore_HidesBanksWithoutLiveCodes verifies that banks whose SWIFT codes are all deleted, including the
+// bank a deleted code leaves when it is created again under another bank, are hidden from the bank reads.
+func TestMemoryStore_HidesBanksWithoutLiveCodes(t *testing.T) {
+	t.Log("Testing that banks without SWIFT codes that are not deleted are hidden")
+	memory := store.NewMemory()
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPWXXX", "Old Bank"), "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("WXYZPLPWXXX", "Other Bank"), "test"))
+	banks, err := memory.ListBanks("PL", store.Page{Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, banks, 2) {
+		assert.Equal(t, "Old Bank", banks[0].Name)
+	}
+	oldBankID := banks[0].ID
+
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPWXXX", "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPWXXX", "New Bank"), "test"))
+
+	banks, err = memory.ListBanks("", store.Page{Limit: 10})
+	assert.NoError(t, err)
+	names := []string{}
+	for _, bank := range banks {
+		names = append(names, bank.Name)
+	}
+	assert.Equal(t, []string{"New Bank", "Other Bank"}, names)
+
+	_, err = memory.GetBank(oldBankID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+	results, err := memory.SearchBanks("OLD BANK", false, 10)
+	assert.NoError(t, err)
+	for _, result := range results {
+		assert.NotEqual(t, oldBankID, result.BankID)
+	}
+	country, err := memory.GetCountry("PL")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, country.BankCount)
+}
+
+// TestMemoryStore_Handler verifies that the handlers run against the in-memory store.
+func TestMemoryStore_Handler(t *testing.T) {
+	t.Log("Testing creation and retrieval of a SWIFT code through the in-memory store")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(`{
+		"address": "Test Address",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"isHeadquarter": true,
+		"swiftCode": "ABCDPLPWXXX"
+	}`))
+	w := httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/banks/search?q=test", nil)
+	w = httptest.NewRecorder()
+	handler.SearchBanksHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"headquarterSwiftCode":"ABCDPLPWXXX"`)
+	assert.Contains(t, w.Body.String(), `"score":0.9`)
+}
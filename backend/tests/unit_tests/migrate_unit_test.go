@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend/internal/db"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMigrations_Embedded verifies that the embedded migrations are ordered and have both directions.
+func TestMigrations_Embedded(t *testing.T) {
+	t.Log("Testing loading of the embedded migrations")
+
+	migrations, err := db.Migrations()
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, migrations) {
+		assert.Equal(t, 1, migrations[0].Version)
+		assert.Equal(t, "initial_schema", migrations[0].Name)
+		assert.Contains(t, migrations[0].Up, "CREATE TABLE swift_codes")
+		assert.Contains(t, migrations[0].Down, "DROP TABLE IF EXISTS swift_codes")
+		assert.NotContains(t, migrations[0].Up, "name_key")
+	}
+	if assert.Greater(t, len(migrations), 1) {
+		assert.Equal(t, "extended_schema", migrations[1].Name)
+		assert.Contains(t, migrations[1].Up, "ADD COLUMN IF NOT EXISTS name_key")
+	}
+	for i := 1; i < len(migrations); i++ {
+		assert.Less(t, migrations[i-1].Version, migrations[i].Version)
+	}
+}
+
+// expectMigrationLock expects the lock and the creation of schema_migrations in an empty database.
+func expectMigrationLock(mock sqlmock.Sqlmock, legacy bool) {
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_lock($1)`)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`to_regclass`).WillReturnRows(sqlmock.NewRows([]string{"exists", "legacy"}).AddRow(false, legacy))
+	mock.ExpectExec(`CREATE TABLE schema_migrations`).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// expectSearchKeyBackfill expects the Go step of the extended schema reading the given banks and addresses.
+func expectSearchKeyBackfill(mock sqlmock.Sqlmock, banks, addresses *sqlmock.Rows) {
+	mock.ExpectQuery(`SELECT id, name, country_id FROM banks`).WillReturnRows(banks)
+	mock.ExpectQuery(`SELECT id, address FROM swift_codes`).WillReturnRows(addresses)
+	mock.ExpectExec(`ADD CONSTRAINT unique_bank UNIQUE \(name_key, country_id\)`).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+// TestMigrateUp_EmptyDatabase verifies that all migrations are applied and recorded in order.
+func TestMigrateUp_EmptyDatabase(t *testing.T) {
+	t.Log("Testing migration of an empty database")
+	database, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer database.Close()
+
+	migrations, err := db.Migrations()
+	assert.NoError(t, err)
+
+	expectMigrationLock(mock, false)
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}))
+	for _, migration := range migrations {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(migration.Up)).WillReturnResult(sqlmock.NewResult(0, 0))
+		if migration.Step != nil {
+			expectSearchKeyBackfill(mock, sqlmock.NewRows([]string{"id", "name", "country_id"}), sqlmock.NewRows([]string{"id", "address"}))
+		}
+		mock.ExpectExec(`INSERT INTO schema_migrations`).
+			WithArgs(migration.Version, migration.Name).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	applied, err := db.MigrateUp(database)
+	assert.NoError(t, err)
+	assert.Len(t, applied, len(migrations))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestMigrationStatuses_LegacyDatabase verifies that a database created by the pg_dump script is baselined.
+func TestMigrationStatuses_LegacyDatabase(t *testing.T) {
+	t.Log("Testing migration status of a database created by the former init script")
+	database, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer database.Close()
+
+	appliedAt := time.Date(2025, 2, 6, 20, 48, 0, 0, time.UTC)
+	expectMigrationLock(mock, true)
+	mock.ExpectExec(`INSERT INTO schema_migrations \(version, name\) VALUES \(1, 'initial_schema'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, appliedAt))
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	statuses, err := db.MigrationStatuses(database)
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, statuses) && assert.NotNil(t, statuses[0].AppliedAt) {
+		assert.Equal(t, appliedAt, *statuses[0].AppliedAt)
+	}
+	for _, status := range statuses[1:] {
+		assert.Nil(t, status.AppliedAt)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestMigrateUp_BackfillsSearchKeys verifies that the banks and addresses of a legacy database get the search keys
+// and ASCII forms computed by the API, merging the banks whose names only differ in accents.
+func TestMigrateUp_BackfillsSearchKeys(t *testing.T) {
+	t.Log("Testing the search keys of a legacy database with accented bank names")
+	database, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer database.Close()
+
+	migrations, err := db.Migrations()
+	assert.NoError(t, err)
+
+	expectMigrationLock(mock, true)
+	mock.ExpectExec(`INSERT INTO schema_migrations \(version, name\) VALUES \(1, 'initial_schema'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT version, applied_at FROM schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "applied_at"}).AddRow(1, time.Now()))
+
+	extended := migrations[1]
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(extended.Up)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT id, name, country_id FROM banks`).WillReturnRows(sqlmock.NewRows([]string{"id", "name", "country_id"}).
+		AddRow("b1", "Banco de Crédito", "cl").
+		AddRow("b2", "BANCO DE CREDITO", "cl"))
+	mock.ExpectExec(`UPDATE banks SET name_key = \$2, name_ascii = \$3`).
+		WithArgs("b1", "BANCO DE CREDITO", "Banco de Credito").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE swift_codes SET bank_id = \$1 WHERE bank_id = \$2`).
+		WithArgs("b1", "b2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM banks WHERE id = \$1`).WithArgs("b2").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT id, address FROM swift_codes`).WillReturnRows(sqlmock.NewRows([]string{"id", "address"}).
+		AddRow("s1", "Agustinas 1161 & Bandera"))
+	mock.ExpectExec(`UPDATE swift_codes SET address_ascii = \$2`).
+		WithArgs("s1", "Agustinas 1161 + Bandera").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`ADD CONSTRAINT unique_bank UNIQUE \(name_key, country_id\)`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`INSERT INTO schema_migrations`).WithArgs(extended.Version, extended.Name).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// the later migrations fail so that the test stops after the extended schema
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(migrations[2].Up)).WillReturnError(errors.New("stop"))
+	mock.ExpectRollback()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	applied, err := db.MigrateUp(database)
+	assert.Error(t, err)
+	if assert.Len(t, applied, 1) {
+		assert.Equal(t, "extended_schema", applied[0].Name)
+	}
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
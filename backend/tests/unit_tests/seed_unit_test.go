@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"testing"
+
+	"backend/internal/importer"
+	"backend/internal/seed"
+	"backend/internal/store"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSeedFixture_Valid verifies that every SWIFT code of the embedded fixture passes the API validation.
+func TestSeedFixture_Valid(t *testing.T) {
+	t.Log("Testing validation of the embedded seed fixture")
+
+	rows, rejected, err := seed.Load("")
+
+	assert.NoError(t, err)
+	assert.Empty(t, rejected)
+	assert.Len(t, rows, 1061)
+
+	// codes without an address in the original dump are located by their country
+	for _, row := range rows {
+		if row.Branch.SwiftCode == "BCECCLRMXXX" {
+			assert.Equal(t, "CHILE", row.Branch.Address)
+		}
+	}
+}
+
+// TestSeedApply_Idempotent verifies that seeding twice changes nothing and that changed SWIFT codes are restored.
+func TestSeedApply_Idempotent(t *testing.T) {
+	t.Log("Testing repeated seeding of the in-memory store")
+	rows, _, err := seed.Load("")
+	assert.NoError(t, err)
+	memory := store.NewMemory()
+
+	results, err := seed.Apply(memory, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, importer.Summary{Inserted: len(rows)}, importer.Summarize(results))
+
+	changed := rows[0].Branch
+	changed.Address = "CHANGED ADDRESS"
+	assert.NoError(t, memory.UpdateSwiftCode(changed, "test"))
+
+	results, err = seed.Apply(memory, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, importer.Summary{Updated: 1, Skipped: len(rows) - 1}, importer.Summarize(results))
+
+	stored, err := memory.GetSwiftCode(rows[0].Branch.SwiftCode, false)
+	assert.NoError(t, err)
+	assert.Equal(t, rows[0].Branch.Address, stored.Address)
+}
+
+// TestSeedApply_SkipsDeleted verifies that seeding does not restore soft-deleted SWIFT codes.
+func TestSeedApply_SkipsDeleted(t *testing.T) {
+	t.Log("Testing that seeding skips soft-deleted SWIFT codes")
+	rows, _, err := seed.Load("")
+	assert.NoError(t, err)
+	memory := store.NewMemory()
+
+	_, err = seed.Apply(memory, rows)
+	assert.NoError(t, err)
+	assert.NoError(t, memory.DeleteSwiftCode(rows[0].Branch.SwiftCode, "test"))
+
+	results, err := seed.Apply(memory, rows)
+	assert.NoError(t, err)
+	assert.Equal(t, importer.Summary{Skipped: len(rows)}, importer.Summarize(results))
+	assert.Equal(t, importer.StatusSkipped, results[0].Status)
+	assert.Contains(t, results[0].Reason, "deleted")
+
+	_, err = memory.GetSwiftCode(rows[0].Branch.SwiftCode, false)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,233 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const batchArrayBody = `[
+	{"swiftCode": "ABCDPLPWXXX", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Test Address", "isHeadquarter": true},
+	{"swiftCode": "ABCDPLPW001", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Branch Address", "isHeadquarter": false}
+]`
+
+// TestPostSwiftCodesBatchHandler_AtomicSuccess verifies that a JSON array is inserted in one transaction.
+func TestPostSwiftCodesBatchHandler_AtomicSuccess(t *testing.T) {
+	t.Log("Testing atomic batch insertion of a JSON array")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPW001", false, "Branch Address", "Branch Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(batchArrayBody))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	var response models.SwiftCodeBatchResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "atomic", response.Mode)
+	assert.Equal(t, 2, response.Created)
+	assert.Equal(t, "created", response.Results[0].Status)
+	assert.Equal(t, "created", response.Results[1].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodesBatchHandler_AtomicConflict verifies that a conflict rolls back the whole batch.
+func TestPostSwiftCodesBatchHandler_AtomicConflict(t *testing.T) {
+	t.Log("Testing atomic batch with a duplicate SWIFT code returns 409 Conflict")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPW001", false, "Branch Address", "Branch Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(batchArrayBody))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response models.SwiftCodeBatchResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 0, response.Created)
+	assert.Equal(t, 1, response.Failed)
+	assert.Equal(t, "notApplied", response.Results[0].Status)
+	assert.Equal(t, "conflict", response.Results[1].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodesBatchHandler_BestEffortNDJSON verifies that an NDJSON stream is inserted item by item.
+func TestPostSwiftCodesBatchHandler_BestEffortNDJSON(t *testing.T) {
+	t.Log("Testing best-effort batch insertion of an NDJSON stream")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	body := `{"swiftCode": "ABCDPLPWXXX", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Test Address", "isHeadquarter": true}
+{"swiftCode": "INVALID", "bankName": "Test Bank", "countryISO2": "PL", "countryName": "Poland", "address": "Test Address", "isHeadquarter": true}
+`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch?mode=bestEffort", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/x-ndjson")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusMultiStatus, w.Code)
+
+	var response models.SwiftCodeBatchResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Created)
+	assert.Equal(t, 1, response.Failed)
+	assert.Equal(t, "created", response.Results[0].Status)
+	assert.Equal(t, "invalid", response.Results[1].Status)
+	assert.Contains(t, response.Results[1].Error, "Invalid fields: swiftCode")
+	assert.Equal(t, "swiftCode", response.Results[1].Errors[0].Field)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodesBatchHandler_ParticipantTypes verifies that test BICs are rejected and passive ones reported.
+func TestPostSwiftCodesBatchHandler_ParticipantTypes(t *testing.T) {
+	t.Log("Testing the location code rules of batch items")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	body := `[
+	{"swiftCode": "ABCDPLP1XXX", "bankName": "Test Bank", "countryISO2": "PL", "address": "Test Address", "isHeadquarter": true},
+	{"swiftCode": "ABCDPLP0XXX", "bankName": "Test Bank", "countryISO2": "PL", "address": "Test Address", "isHeadquarter": true}
+]`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch?mode=bestEffort", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusMultiStatus, w.Code)
+
+	var response models.SwiftCodeBatchResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	if assert.Len(t, response.Results, 2) {
+		assert.Equal(t, "created", response.Results[0].Status)
+		assert.Equal(t, "passive", response.Results[0].ParticipantType)
+		assert.Equal(t, "invalid", response.Results[1].Status)
+		assert.Contains(t, response.Results[1].Errors[0].Message, "Location code P0")
+	}
+}
+
+// TestPostSwiftCodesBatchHandler_InvalidMode verifies that an unknown mode returns a bad request error.
+func TestPostSwiftCodesBatchHandler_InvalidMode(t *testing.T) {
+	t.Log("Testing unknown batch mode returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch?mode=sometimes", strings.NewReader(batchArrayBody))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid mode")
+}
+
+// TestPostSwiftCodesBatchHandler_Empty verifies that an empty batch returns a bad request error.
+func TestPostSwiftCodesBatchHandler_Empty(t *testing.T) {
+	t.Log("Testing empty batch returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(`[]`))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "at least one SWIFT code")
+}
+
+// TestPostSwiftCodesBatchHandler_TooManyItems verifies that a batch over the item limit is rejected.
+func TestPostSwiftCodesBatchHandler_TooManyItems(t *testing.T) {
+	t.Log("Testing batch with more than 1000 items returns 413 Request Entity Too Large")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := "[" + strings.TrimSuffix(strings.Repeat(`{},`, 1001), ",") + "]"
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "at most 1000 SWIFT codes")
+}
+
+// TestPostSwiftCodesBatchHandler_BodyTooLarge verifies that an oversized body is rejected without reading it whole.
+func TestPostSwiftCodesBatchHandler_BodyTooLarge(t *testing.T) {
+	t.Log("Testing oversized batch body returns 413 Request Entity Too Large")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `[{"address": "` + strings.Repeat("A", 5<<20) + `"}]`
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/batch", strings.NewReader(body))
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodesBatchHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "Batch body must be at most")
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"backend/internal/handlers"
+	"backend/internal/models"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -21,9 +22,12 @@ func TestDeleteSwiftCodeHandler_Success(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(`SELECT delete_swift_code\(\$1\)`).
 		WithArgs("ABCDEFGHXXX").
 		WillReturnRows(sqlmock.NewRows([]string{"delete_swift_code"}).AddRow(true))
+	mock.ExpectCommit()
 
 	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDEFGHXXX", nil)
 	w := httptest.NewRecorder()
@@ -31,7 +35,7 @@ func TestDeleteSwiftCodeHandler_Success(t *testing.T) {
 	handler.DeleteSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.JSONEq(t, `{"message":"SWIFT code deleted successfully"}`, w.Body.String())
+	assert.JSONEq(t, `{"message":"SWIFT code deleted successfully","swiftCode":"ABCDEFGHXXX"}`, w.Body.String())
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -44,9 +48,12 @@ func TestDeleteSwiftCodeHandler_NotFound(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(`SELECT delete_swift_code\(\$1\)`).
 		WithArgs("NONEXISTENT").
 		WillReturnRows(sqlmock.NewRows([]string{"delete_swift_code"}).AddRow(false))
+	mock.ExpectRollback()
 
 	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/NONEXISTENT", nil)
 	w := httptest.NewRecorder()
@@ -54,7 +61,8 @@ func TestDeleteSwiftCodeHandler_NotFound(t *testing.T) {
 	handler.DeleteSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
-	assert.JSONEq(t, `{"message":"SWIFT code not found, nothing to delete"}`, w.Body.String())
+	assert.Equal(t, models.ProblemContentType, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"SWIFT code not found, nothing to delete"}`, w.Body.String())
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -73,7 +81,7 @@ func TestDeleteSwiftCodeHandler_InvalidFormat(t *testing.T) {
 	handler.DeleteSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "Invalid SWIFT code format – it must be exactly 11 letters or digits.")
+	assert.Contains(t, w.Body.String(), "Invalid SWIFT code format – it must be 8 or 11 letters or digits.")
 }
 
 // TestDeleteSwiftCodeHandler_DatabaseError verifies that a database error results in a 500 response.
@@ -85,9 +93,12 @@ func TestDeleteSwiftCodeHandler_DatabaseError(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(`SELECT delete_swift_code\(\$1\)`).
 		WithArgs("ABCDEFGHXXX").
 		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
 
 	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDEFGHXXX", nil)
 	w := httptest.NewRecorder()
@@ -98,3 +109,29 @@ func TestDeleteSwiftCodeHandler_DatabaseError(t *testing.T) {
 	assert.Contains(t, w.Body.String(), "Database query failed")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+// TestDeleteSwiftCodeHandler_BIC8 verifies that deleting by an 8 character BIC removes the primary office.
+func TestDeleteSwiftCodeHandler_BIC8(t *testing.T) {
+	t.Log("Testing SWIFT code deletion by an 8 character BIC")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT delete_swift_code\(\$1\)`).
+		WithArgs("ABCDEFGHXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"delete_swift_code"}).AddRow(true))
+	mock.ExpectCommit()
+
+	r := httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDEFGH", nil)
+	w := httptest.NewRecorder()
+
+	handler.DeleteSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"message":"SWIFT code deleted successfully","swiftCode":"ABCDEFGHXXX"}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,12 +2,15 @@ package tests
 
 import (
 	"database/sql"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/store"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -25,10 +28,10 @@ func TestGetSwiftCodesByCountryHandler_InvalidFormat(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/INVALID!@#", nil)
 	w := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(w, r)
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.JSONEq(t, `{"error":"Invalid Country ISO2 Code format – it must be exactly 2 letters."}`, w.Body.String())
+	assert.JSONEq(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"Invalid Country ISO2 Code format – it must be exactly 2 letters."}`, w.Body.String())
 }
 
 // TestGetSwiftCodesByCountryHandler_NotFound verifies that requesting a non-existent country returns a 404 error.
@@ -44,14 +47,20 @@ func TestGetSwiftCodesByCountryHandler_NotFound(t *testing.T) {
 		SELECT c.name AS country_name,
 		COALESCE(json_agg(json_build_object(
 			'bankName', b.name,
+			'bankNameAscii', b.name_ascii,
 			'address', sc.address,
+			'addressAscii', sc.address_ascii,
+			'townName', sc.town_name,
+			'postalCode', sc.postal_code,
+			'street', sc.street,
+			'timeZone', sc.time_zone,
 			'countryISO2', c.iso2_code,
 			'isHeadquarter', sc.is_headquarter,
 			'swiftCode', sc.swift_code
-		)), '[]'::json) AS swift_codes
+		)) FILTER (WHERE sc.id IS NOT NULL), '[]'::json) AS swift_codes
 		FROM countries c
 		LEFT JOIN banks b ON b.country_id = c.id
-		LEFT JOIN swift_codes sc ON sc.bank_id = b.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
 		WHERE c.iso2_code = $1
 		GROUP BY c.name;
 	`)).WithArgs("XX").WillReturnError(sql.ErrNoRows)
@@ -59,7 +68,7 @@ func TestGetSwiftCodesByCountryHandler_NotFound(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/XX", nil)
 	w := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(w, r)
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
@@ -77,14 +86,20 @@ func TestGetSwiftCodesByCountryHandler_Success(t *testing.T) {
 		SELECT c.name AS country_name,
 		COALESCE(json_agg(json_build_object(
 			'bankName', b.name,
+			'bankNameAscii', b.name_ascii,
 			'address', sc.address,
+			'addressAscii', sc.address_ascii,
+			'townName', sc.town_name,
+			'postalCode', sc.postal_code,
+			'street', sc.street,
+			'timeZone', sc.time_zone,
 			'countryISO2', c.iso2_code,
 			'isHeadquarter', sc.is_headquarter,
 			'swiftCode', sc.swift_code
-		)), '[]'::json) AS swift_codes
+		)) FILTER (WHERE sc.id IS NOT NULL), '[]'::json) AS swift_codes
 		FROM countries c
 		LEFT JOIN banks b ON b.country_id = c.id
-		LEFT JOIN swift_codes sc ON sc.bank_id = b.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL
 		WHERE c.iso2_code = $1
 		GROUP BY c.name;
 	`)).WithArgs("PL").WillReturnRows(sqlmock.NewRows([]string{"country_name", "swift_codes"}).AddRow("Poland", `[
@@ -94,8 +109,122 @@ func TestGetSwiftCodesByCountryHandler_Success(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL", nil)
 	w := httptest.NewRecorder()
 
-	handler.GetSwiftCodesByCountryHandler(w, r)
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusOK, w.Code)
-	assert.JSONEq(t, `{"countryISO2":"PL","countryName":"Poland","swiftCodes":[{"bankName":"Test Bank","address":"Test Address","countryISO2":"PL","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"}]}`, w.Body.String())
+	assert.JSONEq(t, `{"countryISO2":"PL","countryISO3":"POL","countryNumeric":"616","countryName":"Poland","swiftCodes":[{"bankName":"Test Bank","address":"Test Address","countryISO2":"PL","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"}]}`, w.Body.String())
+}
+
+// TestGetSwiftCodesByCountryHandler_Paginated verifies that paging parameters return a sorted page with total and next cursor.
+func TestGetSwiftCodesByCountryHandler_Paginated(t *testing.T) {
+	t.Log("Testing paginated retrieval of SWIFT codes sorted by bank name")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT c.name AS country_name, COUNT(sc.id) AS total
+		FROM countries c
+		LEFT JOIN banks b ON b.country_id = c.id
+		LEFT JOIN swift_codes sc ON sc.bank_id = b.id AND sc.deleted_at IS NULL AND sc.is_headquarter = $2
+		WHERE c.iso2_code = $1
+		GROUP BY c.name;
+	`)).WithArgs("PL", false).WillReturnRows(sqlmock.NewRows([]string{"country_name", "total"}).AddRow("POLAND", 3))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE c.iso2_code = $1 AND sc.deleted_at IS NULL AND sc.is_headquarter = $2
+		ORDER BY b.name_key COLLATE "C", sc.swift_code
+		LIMIT $3 OFFSET $4;
+	`)).WithArgs("PL", false, 3, 0).WillReturnRows(sqlmock.NewRows([]string{
+		"address", "address_ascii", "town_name", "postal_code", "street", "time_zone", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code", "deleted_at",
+	}).
+		AddRow("Address A", "Address A", "", "", "", "", "BANK A", "BANK A", "PL", false, "ZZZZPLPW001", nil).
+		AddRow("Address B", "Address B", "", "", "", "", "BANK B", "BANK B", "PL", false, "AAAAPLPW001", nil).
+		AddRow("Address C", "Address C", "", "", "", "", "BANK C", "BANK C", "PL", false, "BBBBPLPW001", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?sort=bankName&isHeadquarter=false&limit=2", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response models.SwiftCodeByCountryISO2
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, "POLAND", response.CountryName)
+	assert.Len(t, response.SwiftCodes, 2)
+	assert.Equal(t, 3, *response.Total)
+	assert.NotNil(t, response.Next)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetSwiftCodesByCountryHandler_OffsetAndCursor verifies that offset and cursor cannot be combined.
+func TestGetSwiftCodesByCountryHandler_OffsetAndCursor(t *testing.T) {
+	t.Log("Testing offset combined with cursor returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?offset=10&cursor=WyJBIl0", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Offset and cursor cannot be used together")
+}
+
+// TestGetSwiftCodesByCountryHandler_InvalidSort verifies that an unknown sort field returns a bad request error.
+func TestGetSwiftCodesByCountryHandler_InvalidSort(t *testing.T) {
+	t.Log("Testing unknown sort field returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?sort=address", nil)
+	w := httptest.NewRecorder()
+
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid sort")
+}
+
+// TestGetSwiftCodesByCountryHandler_BankNameCursor verifies that the in-memory store pages by the search key of the
+// bank name like PostgreSQL, so that mixed case and accented names keep their order across pages.
+func TestGetSwiftCodesByCountryHandler_BankNameCursor(t *testing.T) {
+	t.Log("Testing paging of SWIFT codes sorted by bank names in mixed case")
+	memory := store.NewMemory()
+	for swiftCode, bankName := range map[string]string{"AAAAPLPWXXX": "bank Ć", "BBBBPLPWXXX": "Bank B", "CCCCPLPWXXX": "BANK D"} {
+		assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode(swiftCode, bankName), "test"))
+	}
+	handler := handlers.NewStoreHandler(memory)
+
+	var bankNames []string
+	target := "/v1/swift-codes/country/PL?sort=bankName&limit=1"
+	for target != "" {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+		handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+		if !assert.Equal(t, http.StatusOK, w.Code) {
+			return
+		}
+
+		var response models.SwiftCodeByCountryISO2
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		for _, swiftCode := range response.SwiftCodes {
+			bankNames = append(bankNames, swiftCode.BankName)
+		}
+		target = ""
+		if response.Next != nil {
+			target = "/v1/swift-codes/country/PL?sort=bankName&limit=1&cursor=" + *response.Next
+		}
+	}
+	assert.Equal(t, []string{"Bank B", "bank Ć", "BANK D"}, bankNames)
 }
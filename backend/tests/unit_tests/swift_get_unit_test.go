@@ -7,7 +7,9 @@ import (
 	"regexp"
 	"testing"
 
+	"backend/internal/auth"
 	"backend/internal/handlers"
+	"backend/internal/middleware"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -25,10 +27,10 @@ func TestGetSwiftCodeDetailsHandler_InvalidFormat(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/INVALID!@#", nil)
 	w := httptest.NewRecorder()
 
-	handler.SwiftHandler(w, r)
+	handler.SwiftHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.JSONEq(t, `{"error":"Invalid SWIFT code format – it must be exactly 11 letters or digits."}`, w.Body.String())
+	assert.JSONEq(t, `{"type":"about:blank","title":"Bad Request","status":400,"detail":"Invalid SWIFT code format – it must be 8 or 11 letters or digits."}`, w.Body.String())
 }
 
 // TestGetSwiftCodeDetailsHandler_HeadquarterFound verifies that retrieving a headquarter SWIFT code works correctly.
@@ -41,15 +43,23 @@ func TestGetSwiftCodeDetailsHandler_HeadquarterFound(t *testing.T) {
 	handler := handlers.NewHandler(db)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT sc.address, b.name AS bank_name, c.iso2_code AS country_iso2,
-		c.name AS country_name, sc.is_headquarter, sc.swift_code,
+		SELECT sc.address, sc.address_ascii, sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+		b.name AS bank_name, b.name_ascii AS bank_name_ascii, c.iso2_code AS country_iso2,
+		c.name AS country_name, sc.is_headquarter, sc.swift_code, sc.deleted_at,
 		(
 			SELECT COALESCE(json_agg(json_build_object(
 				'bankName', b2.name,
+				'bankNameAscii', b2.name_ascii,
 				'address', sw.address,
+				'addressAscii', sw.address_ascii,
+				'townName', sw.town_name,
+				'postalCode', sw.postal_code,
+				'street', sw.street,
+				'timeZone', sw.time_zone,
 				'countryISO2', c2.iso2_code,
 				'isHeadquarter', sw.is_headquarter,
-				'swiftCode', sw.swift_code
+				'swiftCode', sw.swift_code,
+				'deletedAt', sw.deleted_at
 			)), '[]'::json)
 			FROM swift_codes sw
 			JOIN banks b2 ON sw.bank_id = b2.id
@@ -57,19 +67,21 @@ func TestGetSwiftCodeDetailsHandler_HeadquarterFound(t *testing.T) {
 			WHERE LEFT(sw.swift_code, 8) = LEFT($1, 8)
 			AND sw.swift_code != $1
 			AND sw.is_headquarter = false
+			AND ($2 OR sw.deleted_at IS NULL)
 		) AS branches
 		FROM swift_codes sc
 		JOIN banks b ON sc.bank_id = b.id
 		JOIN countries c ON b.country_id = c.id
-		WHERE sc.swift_code = $1;
-	`)).WithArgs("ABCDEFGHXXX").WillReturnRows(sqlmock.NewRows([]string{
-		"address", "bank_name", "country_iso2", "country_name", "is_headquarter", "swift_code", "branches",
-	}).AddRow("Test Address", "Test Bank", "PL", "Poland", true, "ABCDEFGHXXX", "[]"))
+		WHERE sc.swift_code = $1 AND ($2 OR sc.deleted_at IS NULL);
+	`)).WithArgs("ABCDEFGHXXX", false).WillReturnRows(sqlmock.NewRows([]string{
+		"address", "address_ascii", "town_name", "postal_code", "street", "time_zone",
+		"bank_name", "bank_name_ascii", "country_iso2", "country_name", "is_headquarter", "swift_code", "deleted_at", "branches",
+	}).AddRow("Test Address", "Test Address", "Warszawa", "00-950", "Test Street 1", "Europe/Warsaw", "Test Bank", "Test Bank", "PL", "Poland", true, "ABCDEFGHXXX", nil, "[]"))
 
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDEFGHXXX", nil)
 	w := httptest.NewRecorder()
 
-	handler.SwiftHandler(w, r)
+	handler.SwiftHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
@@ -84,20 +96,22 @@ func TestGetSwiftCodeDetailsHandler_BranchFound(t *testing.T) {
 	handler := handlers.NewHandler(db)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT sc.swift_code, b.name AS bank_name, sc.address,
-		c.iso2_code AS country_iso2, c.name AS country_name, sc.is_headquarter
+		SELECT sc.swift_code, b.name AS bank_name, b.name_ascii AS bank_name_ascii, sc.address, sc.address_ascii,
+		sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+		c.iso2_code AS country_iso2, c.name AS country_name, sc.is_headquarter, sc.deleted_at
 		FROM swift_codes sc
 		JOIN banks b ON sc.bank_id = b.id
 		JOIN countries c ON b.country_id = c.id
-		WHERE sc.swift_code = $1 AND sc.is_headquarter = false;
-	`)).WithArgs("ABCDEFGH001").WillReturnRows(sqlmock.NewRows([]string{
-		"swift_code", "bank_name", "address", "country_iso2", "country_name", "is_headquarter",
-	}).AddRow("ABCDEFGH001", "Test Bank", "Branch Address", "PL", "Poland", false))
+		WHERE sc.swift_code = $1 AND ($2 OR sc.deleted_at IS NULL);
+	`)).WithArgs("ABCDEFGH001", false).WillReturnRows(sqlmock.NewRows([]string{
+		"swift_code", "bank_name", "bank_name_ascii", "address", "address_ascii", "town_name", "postal_code", "street", "time_zone",
+		"country_iso2", "country_name", "is_headquarter", "deleted_at",
+	}).AddRow("ABCDEFGH001", "Test Bank", "Test Bank", "Branch Address", "Branch Address", "", "", "", "", "PL", "Poland", false, nil))
 
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDEFGH001", nil)
 	w := httptest.NewRecorder()
 
-	handler.SwiftHandler(w, r)
+	handler.SwiftHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusOK, w.Code)
 }
@@ -112,19 +126,87 @@ func TestGetSwiftCodeDetailsHandler_NotFound(t *testing.T) {
 	handler := handlers.NewHandler(db)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT sc.swift_code, b.name AS bank_name, sc.address,
-		c.iso2_code AS country_iso2, c.name AS country_name, sc.is_headquarter
+		SELECT sc.swift_code, b.name AS bank_name, b.name_ascii AS bank_name_ascii, sc.address, sc.address_ascii,
+		sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+		c.iso2_code AS country_iso2, c.name AS country_name, sc.is_headquarter, sc.deleted_at
 		FROM swift_codes sc
 		JOIN banks b ON sc.bank_id = b.id
 		JOIN countries c ON b.country_id = c.id
-		WHERE sc.swift_code = $1 AND sc.is_headquarter = false;
-	`)).WithArgs("NONEXISTENT").WillReturnError(sql.ErrNoRows)
+		WHERE sc.swift_code = $1 AND ($2 OR sc.deleted_at IS NULL);
+	`)).WithArgs("NONEXISTENT", false).WillReturnError(sql.ErrNoRows)
 
 	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/NONEXISTENT", nil)
 	w := httptest.NewRecorder()
 
-	handler.SwiftHandler(w, r)
+	handler.SwiftHandler(w, asAdmin(r))
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
-	assert.JSONEq(t, `{"error":"Resource not found"}`, w.Body.String())
+	assert.JSONEq(t, `{"type":"about:blank","title":"Not Found","status":404,"detail":"Resource not found"}`, w.Body.String())
+}
+
+// TestGetSwiftCodeDetailsHandler_BIC8 verifies that an 8 character BIC is looked up as its primary office.
+func TestGetSwiftCodeDetailsHandler_BIC8(t *testing.T) {
+	t.Log("Testing retrieval of a headquarter SWIFT code by its 8 character BIC")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`WHERE sc.swift_code = \$1 AND \(\$2 OR sc.deleted_at IS NULL\);`).
+		WithArgs("ABCDEFGHXXX", false).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"address", "address_ascii", "town_name", "postal_code", "street", "time_zone",
+			"bank_name", "bank_name_ascii", "country_iso2", "country_name", "is_headquarter", "swift_code", "deleted_at", "branches",
+		}).AddRow("Test Address", "Test Address", "", "", "", "", "Test Bank", "Test Bank", "PL", "Poland", true, "ABCDEFGHXXX", nil, "[]"))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/abcdefgh", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"swiftCode":"ABCDEFGHXXX"`)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestGetSwiftCodeDetailsHandler_IncludeDeletedRequiresAdmin verifies that only admins may read deleted SWIFT codes.
+func TestGetSwiftCodeDetailsHandler_IncludeDeletedRequiresAdmin(t *testing.T) {
+	t.Log("Testing that includeDeleted=true returns 403 Forbidden to anonymous and reader callers")
+	handler, memory, keys, adminKey := newAuthenticatedHandler(t, true)
+	readerKey, _, err := keys.CreateKey("bob", auth.RoleReader)
+	assert.NoError(t, err)
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPW001", "seed"))
+
+	for _, target := range []string{"/v1/swift-codes/ABCDPLPW001?includeDeleted=true", "/v1/swift-codes?includeDeleted=true"} {
+		for _, key := range []string{"", readerKey} {
+			r := httptest.NewRequest(http.MethodGet, target, nil)
+			if key != "" {
+				r.Header.Set(middleware.APIKeyHeader, key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, http.StatusForbidden, w.Code, target)
+		}
+
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		r.Header.Set(middleware.APIKeyHeader, adminKey)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code, target)
+		assert.Contains(t, w.Body.String(), "ABCDPLPW001")
+	}
+
+	countryHandler := handlers.NewStoreHandler(memory)
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?includeDeleted=true", nil)
+	w := httptest.NewRecorder()
+	countryHandler.GetSwiftCodesByCountryHandler(w, middleware.WithIdentity(r, auth.Identity{Role: auth.RoleReader}))
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?includeDeleted=true", nil)
+	w = httptest.NewRecorder()
+	countryHandler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "ABCDPLPW001")
 }
@@ -0,0 +1,215 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/internal/auth"
+	"backend/internal/handlers"
+	"backend/internal/middleware"
+	"backend/internal/models"
+	"backend/internal/store"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSwiftCodeHistoryHandler_RecordsChanges verifies that every change of a SWIFT code is listed with its actor.
+func TestSwiftCodeHistoryHandler_RecordsChanges(t *testing.T) {
+	t.Log("Testing the history of a created, updated, deleted and restored SWIFT code")
+	memory := store.NewMemory()
+	handler := handlers.NewStoreHandler(memory)
+
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+
+	r := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/ABCDPLPW001", strings.NewReader(`{
+		"address": "New Address",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"isHeadquarter": false,
+		"swiftCode": "ABCDPLPW001"
+	}`))
+	w := httptest.NewRecorder()
+	handler.SwiftHandler(w, middleware.WithIdentity(r, auth.Identity{Name: "alice", Role: auth.RoleAdmin}))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	r = httptest.NewRequest(http.MethodDelete, "/v1/swift-codes/ABCDPLPW001", nil)
+	w = httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.NoError(t, memory.RestoreSwiftCode("ABCDPLPW001", "bob"))
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/abcdplpw001/history", nil)
+	w = httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var history models.SwiftCodeHistory
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &history))
+	assert.Equal(t, "ABCDPLPW001", history.SwiftCode)
+	if assert.Len(t, history.Changes, 4) {
+		assert.Equal(t, []string{"create", "update", "delete", "restore"}, []string{
+			history.Changes[0].Operation, history.Changes[1].Operation, history.Changes[2].Operation, history.Changes[3].Operation,
+		})
+		assert.Equal(t, []string{"seed", "alice", "anonymous", "bob"}, []string{
+			history.Changes[0].Actor, history.Changes[1].Actor, history.Changes[2].Actor, history.Changes[3].Actor,
+		})
+		assert.Nil(t, history.Changes[0].Before)
+		assert.Equal(t, "Test Address", history.Changes[1].Before.Address)
+		assert.Equal(t, "New Address", history.Changes[1].After.Address)
+		assert.Nil(t, history.Changes[2].After)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/WXYZPLPW001/history", nil)
+	w = httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestSwiftCodeHistory_BankRename verifies that renaming a bank records an update of each of its SWIFT codes
+// before the other changes of the SWIFT code being replaced.
+func TestSwiftCodeHistory_BankRename(t *testing.T) {
+	t.Log("Testing the history of SWIFT codes whose bank is renamed")
+	memory := store.NewMemory()
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "seed"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW002", "Test Bank"), "seed"))
+
+	renamed := newMemorySwiftCode("ABCDPLPW001", "TEST BANK")
+	renamed.Address = "New Address"
+	assert.NoError(t, memory.UpdateSwiftCode(renamed, "alice"))
+
+	history, err := memory.GetSwiftCodeHistory("ABCDPLPW001")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 3) {
+		assert.Equal(t, "Test Bank", history[1].Before.BankName)
+		assert.Equal(t, "TEST BANK", history[1].After.BankName)
+		assert.Equal(t, "Test Address", history[1].After.Address)
+		assert.Equal(t, "TEST BANK", history[2].Before.BankName)
+		assert.Equal(t, "New Address", history[2].After.Address)
+	}
+
+	history, err = memory.GetSwiftCodeHistory("ABCDPLPW002")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 2) {
+		assert.Equal(t, store.OperationUpdate, history[1].Operation)
+		assert.Equal(t, "alice", history[1].Actor)
+		assert.Equal(t, "Test Bank", history[1].Before.BankName)
+		assert.Equal(t, "TEST BANK", history[1].After.BankName)
+	}
+
+	// a rename without other changes is recorded as well
+	assert.NoError(t, memory.UpdateSwiftCode(newMemorySwiftCode("ABCDPLPW002", "Test Bank"), "bob"))
+	history, err = memory.GetSwiftCodeHistory("ABCDPLPW002")
+	assert.NoError(t, err)
+	if assert.Len(t, history, 3) {
+		assert.Equal(t, "Test Bank", history[2].After.BankName)
+	}
+}
+
+// TestGetSwiftCodeDetailsHandler_AsOf verifies that asOf returns SWIFT codes as they were at that time.
+func TestGetSwiftCodeDetailsHandler_AsOf(t *testing.T) {
+	t.Log("Testing point-in-time retrieval of a headquarter and a country")
+	memory := store.NewMemory()
+	handler := handlers.NewStoreHandler(memory)
+
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPWXXX", "Test Bank"), "test"))
+	assert.NoError(t, memory.CreateSwiftCode(newMemorySwiftCode("ABCDPLPW001", "Test Bank"), "test"))
+	time.Sleep(time.Millisecond)
+	before := time.Now().UTC()
+	time.Sleep(time.Millisecond)
+
+	assert.NoError(t, memory.DeleteSwiftCode("ABCDPLPW001", "test"))
+	changed := newMemorySwiftCode("ABCDPLPWXXX", "Test Bank")
+	changed.Address = "New Address"
+	assert.NoError(t, memory.UpdateSwiftCode(changed, "test"))
+
+	asOf := before.Format(time.RFC3339Nano)
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPWXXX?asOf="+asOf, nil)
+	w := httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var headquarter models.SwiftCodeHeadquarter
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &headquarter))
+	assert.Equal(t, "Test Address", headquarter.Address)
+	if assert.Len(t, headquarter.Branches, 1) {
+		assert.Equal(t, "ABCDPLPW001", headquarter.Branches[0].SwiftCode)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?asOf="+asOf, nil)
+	w = httptest.NewRecorder()
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"swiftCode":"ABCDPLPW001"`)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPW001", nil)
+	w = httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPWXXX?asOf=2000-01-01", nil)
+	w = httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestGetSwiftCodeDetailsHandler_InvalidAsOf verifies that a malformed asOf is rejected.
+func TestGetSwiftCodeDetailsHandler_InvalidAsOf(t *testing.T) {
+	t.Log("Testing an invalid asOf returns 400 Bad Request")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDPLPWXXX?asOf=yesterday", nil)
+	w := httptest.NewRecorder()
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid asOf")
+
+	r = httptest.NewRequest(http.MethodGet, "/v1/swift-codes/country/PL?asOf=2024-05-31&limit=10", nil)
+	w = httptest.NewRecorder()
+	handler.GetSwiftCodesByCountryHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "asOf cannot be used together with limit")
+}
+
+// TestSwiftCodeHistoryHandler_Postgres verifies that the recorded changes are read from the history table.
+func TestSwiftCodeHistoryHandler_Postgres(t *testing.T) {
+	t.Log("Testing retrieval of the history of a SWIFT code from the database")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	changedAt := time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM swift_code_history
+		WHERE swift_code = $1
+		ORDER BY changed_at, id;`)).
+		WithArgs("ABCDEFGHXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "swift_code", "operation", "before", "after", "actor", "changed_at"}).
+			AddRow(1, "ABCDEFGHXXX", "create", nil, []byte(`{"address":"Test Address","bankName":"Test Bank","countryISO2":"PL","countryName":"POLAND","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"}`), "migration", changedAt).
+			AddRow(2, "ABCDEFGHXXX", "delete", []byte(`{"address":"Test Address","bankName":"Test Bank","countryISO2":"PL","countryName":"POLAND","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"}`), nil, "alice", changedAt))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDEFGH/history", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"swiftCode":"ABCDEFGHXXX","changes":[
+		{"id":1,"swiftCode":"ABCDEFGHXXX","operation":"create","before":null,
+		 "after":{"address":"Test Address","bankName":"Test Bank","countryISO2":"PL","countryName":"POLAND","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"},
+		 "actor":"migration","changedAt":"2024-05-31T12:00:00Z"},
+		{"id":2,"swiftCode":"ABCDEFGHXXX","operation":"delete",
+		 "before":{"address":"Test Address","bankName":"Test Bank","countryISO2":"PL","countryName":"POLAND","isHeadquarter":true,"swiftCode":"ABCDEFGHXXX"},
+		 "after":null,"actor":"alice","changedAt":"2024-05-31T12:00:00Z"}]}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
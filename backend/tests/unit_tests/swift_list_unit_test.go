@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"backend/internal/handlers"
+	"backend/internal/models"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestListSwiftCodesHandler_FirstPage verifies that the first page is returned with a cursor to the next one.
+func TestListSwiftCodesHandler_FirstPage(t *testing.T) {
+	t.Log("Testing listing of the first page of SWIFT codes")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT sc.address, sc.address_ascii, sc.town_name, sc.postal_code, sc.street, sc.time_zone,
+			b.name AS bank_name, b.name_ascii AS bank_name_ascii, c.iso2_code AS country_iso2, sc.is_headquarter, sc.swift_code, sc.deleted_at
+		FROM swift_codes sc
+		JOIN banks b ON sc.bank_id = b.id
+		JOIN countries c ON b.country_id = c.id
+		WHERE sc.deleted_at IS NULL AND c.iso2_code = $1 AND sc.is_headquarter = $2
+		ORDER BY sc.swift_code
+		LIMIT $3;
+	`)).WithArgs("PL", true, 3).WillReturnRows(sqlmock.NewRows([]string{
+		"address", "address_ascii", "town_name", "postal_code", "street", "time_zone", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code", "deleted_at",
+	}).
+		AddRow("Address A", "Address A", "", "", "", "", "Bank A", "Bank A", "PL", true, "AAAAPLPWXXX", nil).
+		AddRow("Address B", "Address B", "", "", "", "", "Bank B", "Bank B", "PL", true, "BBBBPLPWXXX", nil).
+		AddRow("Address C", "Address C", "", "", "", "", "Bank C", "Bank C", "PL", true, "CCCCPLPWXXX", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes?country=pl&isHeadquarter=true&limit=2", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response models.SwiftCodeList
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.SwiftCodes, 2)
+	assert.Equal(t, "BBBBPLPWXXX", response.SwiftCodes[1].SwiftCode)
+	assert.NotNil(t, response.Next)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestListSwiftCodesHandler_LastPage verifies that the cursor continues after the last code and ends with a null cursor.
+func TestListSwiftCodesHandler_LastPage(t *testing.T) {
+	t.Log("Testing listing of the last page of SWIFT codes")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	// cursor of the page ending at BBBBPLPWXXX
+	cursor := "WyJCQkJCUExQV1hYWCJd"
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE sc.deleted_at IS NULL AND b.name_key LIKE '%' || $1 || '%' AND sc.swift_code LIKE $2 || '%' AND sc.swift_code > $3
+		ORDER BY sc.swift_code
+		LIMIT $4;
+	`)).WithArgs("BANK-A", "CCCC", "BBBBPLPWXXX", 51).WillReturnRows(sqlmock.NewRows([]string{
+		"address", "address_ascii", "town_name", "postal_code", "street", "time_zone", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code", "deleted_at",
+	}).AddRow("Address C", "Address C", "", "", "", "", "Bank C", "Bank C", "PL", true, "CCCCPLPWXXX", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes?bank=bank_a&prefix=cccc&cursor="+cursor, nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"swiftCodes":[{"address":"Address C","addressAscii":"Address C","bankName":"Bank C","bankNameAscii":"Bank C","countryISO2":"PL","isHeadquarter":true,"swiftCode":"CCCCPLPWXXX"}],"next":null}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestListSwiftCodesHandler_TownFilter verifies that SWIFT codes are filtered by their town name, ignoring case.
+func TestListSwiftCodesHandler_TownFilter(t *testing.T) {
+	t.Log("Testing listing of SWIFT codes in a single town")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		WHERE sc.deleted_at IS NULL AND upper(sc.town_name) = $1 AND sc.is_headquarter = $2
+		ORDER BY sc.swift_code
+		LIMIT $3;
+	`)).WithArgs("ŁÓDŹ", false, 51).WillReturnRows(sqlmock.NewRows([]string{
+		"address", "address_ascii", "town_name", "postal_code", "street", "time_zone", "bank_name", "bank_name_ascii", "country_iso2", "is_headquarter", "swift_code", "deleted_at",
+	}).AddRow("ul. Piotrkowska 1, 90-001 Łódź", "ul. Piotrkowska 1, 90-001 Lodz", "Łódź", "90-001", "ul. Piotrkowska 1",
+		"Europe/Warsaw", "Bank A", "Bank A", "PL", false, "AAAAPLPW001", nil))
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes?town=%C5%82%C3%B3d%C5%BA&isHeadquarter=false", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response models.SwiftCodeList
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Len(t, response.SwiftCodes, 1)
+	assert.Equal(t, "Łódź", response.SwiftCodes[0].TownName)
+	assert.Equal(t, "ul. Piotrkowska 1, 90-001 Lodz", response.SwiftCodes[0].AddressASCII)
+	assert.Equal(t, "Europe/Warsaw", response.SwiftCodes[0].TimeZone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestListSwiftCodesHandler_InvalidParameters verifies that malformed query parameters return a bad request error.
+func TestListSwiftCodesHandler_InvalidParameters(t *testing.T) {
+	t.Log("Testing malformed listing parameters return 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	for _, target := range []string{
+		"/v1/swift-codes?limit=0",
+		"/v1/swift-codes?limit=abc",
+		"/v1/swift-codes?country=POL",
+		"/v1/swift-codes?isHeadquarter=maybe",
+		"/v1/swift-codes?prefix=AB-CD",
+		"/v1/swift-codes?town=Warszawa%21",
+		"/v1/swift-codes?cursor=not-a-cursor",
+	} {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		w := httptest.NewRecorder()
+
+		handler.SwiftHandler(w, asAdmin(r))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+}
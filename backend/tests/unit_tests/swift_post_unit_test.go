@@ -2,6 +2,7 @@ package tests
 
 import (
 	"database/sql"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
@@ -9,6 +10,8 @@ import (
 	"testing"
 
 	"backend/internal/handlers"
+	"backend/internal/models"
+	"backend/internal/store"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
@@ -41,36 +44,44 @@ func TestPostSwiftCodeHandler_Success(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		WITH country_ins AS (
 			INSERT INTO countries (iso2_code, name)
 			VALUES ($1, $2)
-			ON CONFLICT (iso2_code) DO NOTHING
+			ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+			WHERE countries.name IS DISTINCT FROM EXCLUDED.name
 			RETURNING id
 		), country_sel AS (
 			SELECT id FROM countries WHERE iso2_code = $1
 			UNION ALL
 			SELECT id FROM country_ins LIMIT 1
 		), bank_ins AS (
-			INSERT INTO banks (name, country_id)
-			SELECT $3, id FROM country_sel
-			ON CONFLICT (name, country_id) DO NOTHING
+			INSERT INTO banks (name, name_key, name_ascii, country_id)
+			SELECT $3, $4, $5, id FROM country_sel
+			ON CONFLICT (name_key, country_id) DO NOTHING
 			RETURNING id
 		), bank_sel AS (
-			SELECT id FROM banks WHERE name = $3 AND country_id = (SELECT id FROM country_sel)
+			SELECT id FROM banks WHERE name_key = $4 AND country_id = (SELECT id FROM country_sel)
 			UNION ALL
 			SELECT id FROM bank_ins LIMIT 1
 		), swift_ins AS (
-			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address)
-			SELECT $4, (SELECT id FROM bank_sel), $5, $6
-			ON CONFLICT (swift_code) DO NOTHING
+			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii, town_name, postal_code, street, time_zone)
+			SELECT $6, (SELECT id FROM bank_sel), $7, $8, $9, $10, $11, $12, $13
+			ON CONFLICT (swift_code) DO UPDATE
+			SET bank_id = EXCLUDED.bank_id, is_headquarter = EXCLUDED.is_headquarter, address = EXCLUDED.address,
+				address_ascii = EXCLUDED.address_ascii, town_name = EXCLUDED.town_name, postal_code = EXCLUDED.postal_code,
+				street = EXCLUDED.street, time_zone = EXCLUDED.time_zone, deleted_at = NULL
+			WHERE swift_codes.deleted_at IS NOT NULL
 			RETURNING swift_code
 		)
 		SELECT COUNT(*) FROM swift_ins;
-	`)).WithArgs("PL", "POLAND", "TEST BANK", "ABCDEFGHXXX", true, "TEST ADDRESS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	`)).WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
 
 	body := `{
-		"swiftCode": "ABCDEFGHXXX",
+		"swiftCode": "ABCDPLPWXXX",
 		"bankName": "Test Bank",
 		"countryISO2": "PL",
 		"countryName": "Poland",
@@ -97,36 +108,44 @@ func TestPostSwiftCodeHandler_Conflict(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		WITH country_ins AS (
 			INSERT INTO countries (iso2_code, name)
 			VALUES ($1, $2)
-			ON CONFLICT (iso2_code) DO NOTHING
+			ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+			WHERE countries.name IS DISTINCT FROM EXCLUDED.name
 			RETURNING id
 		), country_sel AS (
 			SELECT id FROM countries WHERE iso2_code = $1
 			UNION ALL
 			SELECT id FROM country_ins LIMIT 1
 		), bank_ins AS (
-			INSERT INTO banks (name, country_id)
-			SELECT $3, id FROM country_sel
-			ON CONFLICT (name, country_id) DO NOTHING
+			INSERT INTO banks (name, name_key, name_ascii, country_id)
+			SELECT $3, $4, $5, id FROM country_sel
+			ON CONFLICT (name_key, country_id) DO NOTHING
 			RETURNING id
 		), bank_sel AS (
-			SELECT id FROM banks WHERE name = $3 AND country_id = (SELECT id FROM country_sel)
+			SELECT id FROM banks WHERE name_key = $4 AND country_id = (SELECT id FROM country_sel)
 			UNION ALL
 			SELECT id FROM bank_ins LIMIT 1
 		), swift_ins AS (
-			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address)
-			SELECT $4, (SELECT id FROM bank_sel), $5, $6
-			ON CONFLICT (swift_code) DO NOTHING
+			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii, town_name, postal_code, street, time_zone)
+			SELECT $6, (SELECT id FROM bank_sel), $7, $8, $9, $10, $11, $12, $13
+			ON CONFLICT (swift_code) DO UPDATE
+			SET bank_id = EXCLUDED.bank_id, is_headquarter = EXCLUDED.is_headquarter, address = EXCLUDED.address,
+				address_ascii = EXCLUDED.address_ascii, town_name = EXCLUDED.town_name, postal_code = EXCLUDED.postal_code,
+				street = EXCLUDED.street, time_zone = EXCLUDED.time_zone, deleted_at = NULL
+			WHERE swift_codes.deleted_at IS NOT NULL
 			RETURNING swift_code
 		)
 		SELECT COUNT(*) FROM swift_ins;
-	`)).WithArgs("PL", "POLAND", "TEST BANK", "ABCDEFGHXXX", true, "TEST ADDRESS").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	`)).WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectRollback()
 
 	body := `{
-		"swiftCode": "ABCDEFGHXXX",
+		"swiftCode": "ABCDPLPWXXX",
 		"bankName": "Test Bank",
 		"countryISO2": "PL",
 		"countryName": "Poland",
@@ -141,7 +160,7 @@ func TestPostSwiftCodeHandler_Conflict(t *testing.T) {
 	handler.PostSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusConflict, w.Code)
-	assert.JSONEq(t, `{"error":"SWIFT code already exists"}`, w.Body.String())
+	assert.JSONEq(t, `{"type":"about:blank","title":"Conflict","status":409,"detail":"SWIFT code already exists"}`, w.Body.String())
 }
 
 // TestPostSwiftCodeHandler_MissingFields verifies that missing required fields return a bad request error.
@@ -153,7 +172,7 @@ func TestPostSwiftCodeHandler_MissingFields(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
-	body := `{ "swiftCode": "ABCDEFGHXXX" }`
+	body := `{ "swiftCode": "ABCDPLPWXXX" }`
 	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
 	r.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
@@ -161,7 +180,12 @@ func TestPostSwiftCodeHandler_MissingFields(t *testing.T) {
 	handler.PostSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "Missing required fields")
+	assert.Equal(t, "application/problem+json", w.Header().Get("Content-Type"))
+
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "Missing required fields: bankName, countryISO2, address, isHeadquarter", problem.Detail)
+	assert.Equal(t, models.FieldError{Field: "bankName", Code: "required", Message: "Bank name is required"}, problem.Errors[0])
 }
 
 // TestPostSwiftCodeHandler_InvalidData verifies that invalid field values return a bad request error.
@@ -189,7 +213,36 @@ func TestPostSwiftCodeHandler_InvalidData(t *testing.T) {
 	handler.PostSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "Validation errors")
+
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, "/problems/validation-error", problem.Type)
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, "Invalid fields: swiftCode, bankName, countryISO2, address", problem.Detail)
+	assert.Len(t, problem.Errors, 4)
+}
+
+// TestPostSwiftCodeHandler_WrongType verifies that a value of the wrong JSON type is reported for its field.
+func TestPostSwiftCodeHandler_WrongType(t *testing.T) {
+	t.Log("Testing a value of the wrong type returns a field error")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{"swiftCode": "ABCDPLPWXXX", "isHeadquarter": "yes"}`
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	assert.Equal(t, []models.FieldError{{Field: "isHeadquarter", Code: "type", Message: "Value must be of type bool"}}, problem.Errors)
 }
 
 // TestPostSwiftCodeHandler_DatabaseError verifies that a database error results in a 500 response.
@@ -201,36 +254,44 @@ func TestPostSwiftCodeHandler_DatabaseError(t *testing.T) {
 
 	handler := handlers.NewHandler(db)
 
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
 	mock.ExpectQuery(regexp.QuoteMeta(`
 		WITH country_ins AS (
 			INSERT INTO countries (iso2_code, name)
 			VALUES ($1, $2)
-			ON CONFLICT (iso2_code) DO NOTHING
+			ON CONFLICT (iso2_code) DO UPDATE SET name = EXCLUDED.name
+			WHERE countries.name IS DISTINCT FROM EXCLUDED.name
 			RETURNING id
 		), country_sel AS (
 			SELECT id FROM countries WHERE iso2_code = $1
 			UNION ALL
 			SELECT id FROM country_ins LIMIT 1
 		), bank_ins AS (
-			INSERT INTO banks (name, country_id)
-			SELECT $3, id FROM country_sel
-			ON CONFLICT (name, country_id) DO NOTHING
+			INSERT INTO banks (name, name_key, name_ascii, country_id)
+			SELECT $3, $4, $5, id FROM country_sel
+			ON CONFLICT (name_key, country_id) DO NOTHING
 			RETURNING id
 		), bank_sel AS (
-			SELECT id FROM banks WHERE name = $3 AND country_id = (SELECT id FROM country_sel)
+			SELECT id FROM banks WHERE name_key = $4 AND country_id = (SELECT id FROM country_sel)
 			UNION ALL
 			SELECT id FROM bank_ins LIMIT 1
 		), swift_ins AS (
-			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address)
-			SELECT $4, (SELECT id FROM bank_sel), $5, $6
-			ON CONFLICT (swift_code) DO NOTHING
+			INSERT INTO swift_codes (swift_code, bank_id, is_headquarter, address, address_ascii, town_name, postal_code, street, time_zone)
+			SELECT $6, (SELECT id FROM bank_sel), $7, $8, $9, $10, $11, $12, $13
+			ON CONFLICT (swift_code) DO UPDATE
+			SET bank_id = EXCLUDED.bank_id, is_headquarter = EXCLUDED.is_headquarter, address = EXCLUDED.address,
+				address_ascii = EXCLUDED.address_ascii, town_name = EXCLUDED.town_name, postal_code = EXCLUDED.postal_code,
+				street = EXCLUDED.street, time_zone = EXCLUDED.time_zone, deleted_at = NULL
+			WHERE swift_codes.deleted_at IS NOT NULL
 			RETURNING swift_code
 		)
 		SELECT COUNT(*) FROM swift_ins;
-	`)).WithArgs("PL", "POLAND", "TEST BANK", "ABCDEFGHXXX", true, "TEST ADDRESS").WillReturnError(sql.ErrConnDone)
+	`)).WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "Test Address", "Test Address", "", "", "", "").WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
 
 	body := `{
-		"swiftCode": "ABCDEFGHXXX",
+		"swiftCode": "ABCDPLPWXXX",
 		"bankName": "Test Bank",
 		"countryISO2": "PL",
 		"countryName": "Poland",
@@ -258,7 +319,7 @@ func TestPostSwiftCodeHandler_MissingIsHeadquarter(t *testing.T) {
 	handler := handlers.NewHandler(db)
 
 	body := `{
-		"swiftCode": "ABCDEFGHXXX",
+		"swiftCode": "ABCDPLPWXXX",
 		"bankName": "Test Bank",
 		"countryISO2": "PL",
 		"countryName": "Poland",
@@ -272,5 +333,355 @@ func TestPostSwiftCodeHandler_MissingIsHeadquarter(t *testing.T) {
 	handler.PostSwiftCodeHandler(w, r)
 
 	assert.Equal(t, http.StatusBadRequest, w.Code)
-	assert.Contains(t, w.Body.String(), "Missing required fields: [is_headquarter]")
+	assert.Contains(t, w.Body.String(), "Missing required fields: isHeadquarter")
+}
+
+// TestPostSwiftCodeHandler_InvalidBICStructure verifies that a SWIFT code violating ISO 9362 is rejected with the failing components named.
+func TestPostSwiftCodeHandler_InvalidBICStructure(t *testing.T) {
+	t.Log("Testing SWIFT code with an invalid ISO 9362 structure returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "12345678901",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"address": "Test Address",
+		"isHeadquarter": false
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Institution code (characters 1-4)")
+	assert.Contains(t, w.Body.String(), "Country code (characters 5-6)")
+}
+
+// TestPostSwiftCodeHandler_TestBIC verifies that a test and training BIC is rejected naming its location code.
+func TestPostSwiftCodeHandler_TestBIC(t *testing.T) {
+	t.Log("Testing that a SWIFT code with a 0 in second place of the location code returns 400 Bad Request")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	body := `{
+		"swiftCode": "ABCDPLP0XXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"address": "Test Address",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	if assert.Len(t, problem.Errors, 1) {
+		assert.Equal(t, "swiftCode", problem.Errors[0].Field)
+		assert.Contains(t, problem.Errors[0].Message, "Location code P0 (characters 7-8)")
+	}
+}
+
+// TestPostSwiftCodeHandler_ParticipantType verifies that passive and reverse billing BICs are accepted and
+// reported as such.
+func TestPostSwiftCodeHandler_ParticipantType(t *testing.T) {
+	t.Log("Testing that the participant type of passive and reverse billing SWIFT codes is returned")
+	handler := handlers.NewStoreHandler(store.NewMemory())
+
+	tests := map[string]string{
+		"ABCDPLP1XXX": `{"message":"SWIFT code added successfully","participantType":"passive"}`,
+		"ABCDPLP2XXX": `{"message":"SWIFT code added successfully","participantType":"reverseBilling"}`,
+		"ABCDPLPWXXX": `{"message":"SWIFT code added successfully"}`,
+	}
+	for swiftCode, expected := range tests {
+		body := `{
+			"swiftCode": "` + swiftCode + `",
+			"bankName": "Test Bank",
+			"countryISO2": "PL",
+			"address": "Test Address",
+			"isHeadquarter": true
+		}`
+
+		r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		handler.PostSwiftCodeHandler(w, r)
+
+		assert.Equal(t, http.StatusCreated, w.Code, swiftCode)
+		assert.JSONEq(t, expected, w.Body.String(), swiftCode)
+	}
+}
+
+// TestPostSwiftCodeHandler_CountryMismatch verifies that the country inside the SWIFT code must match countryISO2.
+func TestPostSwiftCodeHandler_CountryMismatch(t *testing.T) {
+	t.Log("Testing SWIFT code country that differs from countryISO2 returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "ABCDDEFFXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Poland",
+		"address": "Test Address",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "must match the country ISO2 code")
+}
+
+// TestPostSwiftCodeHandler_CanonicalCountryName verifies that the registered country name is stored when countryName is omitted.
+func TestPostSwiftCodeHandler_CanonicalCountryName(t *testing.T) {
+	t.Log("Testing omitted countryName is filled in from the ISO 3166 registry")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("DE", "GERMANY", "Test Bank", "TEST BANK", "Test Bank", "ABCDDEFFXXX", true, "Test Address", "Test Address", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	body := `{
+		"swiftCode": "ABCDDEFFXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "DE",
+		"address": "Test Address",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodeHandler_CountryNameMismatch verifies that a country name that does not belong to countryISO2 is rejected.
+func TestPostSwiftCodeHandler_CountryNameMismatch(t *testing.T) {
+	t.Log("Testing countryName that does not match countryISO2 returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "ABCDPLPWXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"countryName": "Polland",
+		"address": "Test Address",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Country name does not match country ISO2 PL (POLAND)")
+}
+
+// TestPostSwiftCodeHandler_StructuredAddress verifies that the address is derived from the structured
+// address fields when omitted and that all of them are stored.
+func TestPostSwiftCodeHandler_StructuredAddress(t *testing.T) {
+	t.Log("Testing structured address is stored and the address derived from it")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "ul. Świętokrzyska 11, 00-919 Łódź", "ul. Swietokrzyska 11, 00-919 Lodz", "Łódź", "00-919", "ul. Świętokrzyska 11", "Europe/Warsaw").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	body := `{
+		"swiftCode": "ABCDPLPWXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"street": "ul. Świętokrzyska 11",
+		"postalCode": "00-919",
+		"townName": "Łódź",
+		"timeZone": "Europe/Warsaw",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodeHandler_DerivedAddressTooLong verifies that structured address fields too long to be combined
+// into the address are reported on those fields rather than on the omitted address.
+func TestPostSwiftCodeHandler_DerivedAddressTooLong(t *testing.T) {
+	t.Log("Testing that an over-long derived address is reported on street and townName")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "ABCDPLPWXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"street": "` + strings.Repeat("a", 200) + `",
+		"townName": "` + strings.Repeat("b", 80) + `",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	fields := []string{}
+	for _, fieldError := range problem.Errors {
+		fields = append(fields, fieldError.Field)
+	}
+	assert.Equal(t, []string{"street", "townName"}, fields)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodeHandler_TransliterationTooLong verifies that a Cyrillic bank name and address of 255 characters
+// are rejected when their transliteration no longer fits the 255 characters of the ASCII columns.
+func TestPostSwiftCodeHandler_TransliterationTooLong(t *testing.T) {
+	t.Log("Testing that a 255 character Cyrillic name growing when transliterated returns 400 Bad Request")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "ABCDBGSFXXX",
+		"bankName": "` + strings.Repeat("Щ", 255) + `",
+		"address": "` + strings.Repeat("ж", 255) + `",
+		"countryISO2": "BG",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	var problem models.Problem
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+	fields := []string{}
+	for _, fieldError := range problem.Errors {
+		fields = append(fields, fieldError.Field)
+	}
+	assert.Equal(t, []string{"bankName", "address"}, fields)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodeHandler_UnicodeBankName verifies that a bank name with accents and punctuation keeps its
+// casing and is stored together with its search key and ASCII form.
+func TestPostSwiftCodeHandler_UnicodeBankName(t *testing.T) {
+	t.Log("Testing creation of a SWIFT code for a bank with a non-ASCII name")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`WITH country_ins AS`).
+		WithArgs("PL", "POLAND", "Banco de Crédito & Cía", "BANCO DE CREDITO + CIA", "Banco de Credito + Cia", "ABCDPLPWXXX", true, "Aleje Jerozolimskie 1", "Aleje Jerozolimskie 1", "", "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectCommit()
+
+	body := `{
+		"swiftCode": "ABCDPLPWXXX",
+		"bankName": "  Banco de  Cre\u0301dito & Cía ",
+		"countryISO2": "PL",
+		"address": "Aleje Jerozolimskie 1",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPostSwiftCodeHandler_InvalidTimeZone verifies that a time zone outside the IANA database is rejected.
+func TestPostSwiftCodeHandler_InvalidTimeZone(t *testing.T) {
+	t.Log("Testing unknown time zone returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := `{
+		"swiftCode": "ABCDPLPWXXX",
+		"bankName": "Test Bank",
+		"countryISO2": "PL",
+		"address": "Test Address",
+		"timeZone": "Europe/Atlantis",
+		"isHeadquarter": true
+	}`
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.PostSwiftCodeHandler(w, r)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Time zone must be an IANA time zone")
 }
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"backend/internal/handlers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const putSwiftCodeBody = `{
+	"swiftCode": "ABCDPLPWXXX",
+	"bankName": "Test Bank",
+	"countryISO2": "PL",
+	"countryName": "Poland",
+	"address": "New Address",
+	"isHeadquarter": true
+}`
+
+// TestPutSwiftCodeHandler_Success verifies that an existing SWIFT code is replaced successfully.
+func TestPutSwiftCodeHandler_Success(t *testing.T) {
+	t.Log("Testing successful SWIFT code replacement")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT bank_id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL FOR UPDATE`)).
+		WithArgs("ABCDPLPWXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"bank_id"}).AddRow("bank-1"))
+	mock.ExpectExec(`WITH country_ups AS`).
+		WithArgs("PL", "POLAND", "Test Bank", "TEST BANK", "Test Bank", "ABCDPLPWXXX", true, "New Address", "New Address", "", "", "", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM banks`).
+		WithArgs("bank-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	r := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/ABCDPLPWXXX", strings.NewReader(putSwiftCodeBody))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"message":"SWIFT code updated successfully"}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPutSwiftCodeHandler_NotFound verifies that replacing a non-existent SWIFT code returns 404.
+func TestPutSwiftCodeHandler_NotFound(t *testing.T) {
+	t.Log("Testing replacement of a non-existent SWIFT code returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT bank_id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL FOR UPDATE`)).
+		WithArgs("ABCDPLPWXXX").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	r := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/ABCDPLPWXXX", strings.NewReader(putSwiftCodeBody))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPutSwiftCodeHandler_HeadquarterMismatch verifies that a headquarter flag not matching the suffix returns 409.
+func TestPutSwiftCodeHandler_HeadquarterMismatch(t *testing.T) {
+	t.Log("Testing headquarter flag mismatch returns 409 Conflict")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	body := strings.Replace(putSwiftCodeBody, `"isHeadquarter": true`, `"isHeadquarter": false`, 1)
+	r := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/ABCDPLPWXXX", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "Mismatch between SWIFT code format and headquarter status")
+}
+
+// TestPutSwiftCodeHandler_CodeMismatch verifies that a body SWIFT code different from the URL returns 400.
+func TestPutSwiftCodeHandler_CodeMismatch(t *testing.T) {
+	t.Log("Testing body SWIFT code different from the URL returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodPut, "/v1/swift-codes/ZYXWPLPWXXX", strings.NewReader(putSwiftCodeBody))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "does not match")
+}
+
+// TestPatchSwiftCodeHandler_Success verifies that a partial update merges the stored SWIFT code with the patch.
+func TestPatchSwiftCodeHandler_Success(t *testing.T) {
+	t.Log("Testing successful partial SWIFT code update")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectQuery(`SELECT\s+sc.swift_code, b.name AS bank_name`).
+		WithArgs("ABCDPLPW001", false).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"swift_code", "bank_name", "bank_name_ascii", "address", "address_ascii", "town_name", "postal_code", "street", "time_zone",
+			"country_iso2", "country_name", "is_headquarter", "deleted_at",
+		}).AddRow("ABCDPLPW001", "TEST BANK", "TEST BANK", "OLD ADDRESS", "OLD ADDRESS", "", "", "", "", "PL", "POLAND", false, nil))
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT bank_id FROM swift_codes WHERE swift_code = $1 AND deleted_at IS NULL FOR UPDATE`)).
+		WithArgs("ABCDPLPW001").
+		WillReturnRows(sqlmock.NewRows([]string{"bank_id"}).AddRow("bank-1"))
+	mock.ExpectExec(`WITH country_ups AS`).
+		WithArgs("PL", "POLAND", "TEST BANK", "TEST BANK", "TEST BANK", "ABCDPLPW001", false, "New Address", "New Address", "", "", "", "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM banks`).
+		WithArgs("bank-1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	r := httptest.NewRequest(http.MethodPatch, "/v1/swift-codes/ABCDPLPW001", strings.NewReader(`{"address": "New Address"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestPatchSwiftCodeHandler_Empty verifies that a patch without any field returns 400.
+func TestPatchSwiftCodeHandler_Empty(t *testing.T) {
+	t.Log("Testing empty patch returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodPatch, "/v1/swift-codes/ABCDPLPW001", strings.NewReader(`{}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "At least one of")
+}
+
+// TestPatchSwiftCodeHandler_UnknownField verifies that fields other than address, bankName and countryName are rejected.
+func TestPatchSwiftCodeHandler_UnknownField(t *testing.T) {
+	t.Log("Testing patch of the headquarter flag returns 400 Bad Request")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodPatch, "/v1/swift-codes/ABCDPLPW001", strings.NewReader(`{"isHeadquarter": true}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "Invalid JSON")
+}
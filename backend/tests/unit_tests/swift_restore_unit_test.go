@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/internal/handlers"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRestoreSwiftCodeHandler_Success verifies that a deleted SWIFT code is restored successfully.
+func TestRestoreSwiftCodeHandler_Success(t *testing.T) {
+	t.Log("Testing successful restore of a deleted SWIFT code")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE swift_codes SET deleted_at = NULL`).
+		WithArgs("ABCDEFGHXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "restored"}).AddRow(true, true))
+	mock.ExpectCommit()
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/ABCDEFGHXXX/restore", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"message":"SWIFT code restored successfully","swiftCode":"ABCDEFGHXXX"}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestRestoreSwiftCodeHandler_NotDeleted verifies that restoring a SWIFT code that is not deleted returns 409.
+func TestRestoreSwiftCodeHandler_NotDeleted(t *testing.T) {
+	t.Log("Testing restore of a SWIFT code that is not deleted returns 409 Conflict")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE swift_codes SET deleted_at = NULL`).
+		WithArgs("ABCDEFGHXXX").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "restored"}).AddRow(true, false))
+	mock.ExpectRollback()
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/ABCDEFGHXXX/restore", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.JSONEq(t, `{"type":"about:blank","title":"Conflict","status":409,"detail":"SWIFT code is not deleted"}`, w.Body.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestRestoreSwiftCodeHandler_NotFound verifies that restoring an unknown SWIFT code returns 404.
+func TestRestoreSwiftCodeHandler_NotFound(t *testing.T) {
+	t.Log("Testing restore of a non-existent SWIFT code returns 404 Not Found")
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`SELECT set_config`).WithArgs("anonymous").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE swift_codes SET deleted_at = NULL`).
+		WithArgs("NONEXISTENT").
+		WillReturnRows(sqlmock.NewRows([]string{"exists", "restored"}).AddRow(false, false))
+	mock.ExpectRollback()
+
+	r := httptest.NewRequest(http.MethodPost, "/v1/swift-codes/NONEXISTENT/restore", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// TestRestoreSwiftCodeHandler_MethodNotAllowed verifies that only POST restores a SWIFT code.
+func TestRestoreSwiftCodeHandler_MethodNotAllowed(t *testing.T) {
+	t.Log("Testing restore with GET returns 405 Method Not Allowed")
+	db, _, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	handler := handlers.NewHandler(db)
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/swift-codes/ABCDEFGHXXX/restore", nil)
+	w := httptest.NewRecorder()
+
+	handler.SwiftHandler(w, asAdmin(r))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
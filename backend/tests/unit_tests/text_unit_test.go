@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"testing"
+
+	"backend/internal/validation"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalizeText verifies that text is composed to NFC and its whitespace collapsed.
+func TestNormalizeText(t *testing.T) {
+	t.Log("Testing NFC normalization and whitespace collapsing")
+
+	assert.Equal(t, "Banco de Crédito", validation.NormalizeText("  Banco de Crédito "))
+	assert.Equal(t, "Łódź Bank", validation.NormalizeText("Łódź \t\n Bank"))
+}
+
+// TestIsValidText verifies that letters and numbers of any script are accepted together with the allowed punctuation.
+func TestIsValidText(t *testing.T) {
+	t.Log("Testing Unicode aware validation of names and addresses")
+
+	assert.True(t, validation.IsValidText("BANCO DE CRÉDITO & CÍA", validation.NamePunctuation))
+	assert.True(t, validation.IsValidText("O'Brien's Bank", validation.NamePunctuation))
+	assert.True(t, validation.IsValidText("Банка ДСК", validation.NamePunctuation))
+	assert.True(t, validation.IsValidText("ul. Świętokrzyska 11/13", validation.AddressPunctuation))
+
+	assert.False(t, validation.IsValidText("Bank #1", validation.NamePunctuation))
+	assert.False(t, validation.IsValidText("Bank!", validation.NamePunctuation))
+	assert.False(t, validation.IsValidText("& - .", validation.NamePunctuation))
+	assert.True(t, validation.IsValidText("Bank!", "!"))
+}
+
+// TestTransliterate verifies that text is converted to the SWIFT X character set keeping its casing.
+func TestTransliterate(t *testing.T) {
+	t.Log("Testing transliteration to the SWIFT character set")
+
+	tests := map[string]string{
+		"Banco de Crédito & Cía": "Banco de Credito + Cia",
+		"Шумен":                  "Shumen",
+		"ЖЕЛЕЗНИЦА":              "ZHELEZNITSA",
+		"Straße 5":               "Strasse 5",
+		"Łódź":                   "Lodz",
+		"O’Brien":                "O'Brien",
+		"東京":                     "..",
+	}
+	for value, expected := range tests {
+		assert.Equal(t, expected, validation.Transliterate(value), value)
+	}
+}
+
+// TestSearchKey verifies that names differing only in case or accents share the same search key.
+func TestSearchKey(t *testing.T) {
+	t.Log("Testing case and accent insensitive search keys")
+
+	assert.Equal(t, "BANCO DE CREDITO", validation.SearchKey("Banco de Crédito"))
+	assert.Equal(t, validation.SearchKey("BANCO  DE CREDITO"), validation.SearchKey("banco de crédito"))
+}